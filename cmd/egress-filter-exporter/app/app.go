@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/exporter"
+)
+
+// Name is the name of the blocked connection exporter.
+const Name = constants.ExporterName
+
+// pollInterval is the interval in which the kernel log is checked for new lines if it is a regular file.
+const pollInterval = time.Second
+
+// exporterOptions are the options of the blocked connection exporter.
+type exporterOptions struct {
+	kernelLogPath string
+	entriesPath   string
+	eventInterval time.Duration
+	topOffenders  int
+	nodeName      string
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *exporterOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.kernelLogPath, "kernel-log-path", "/dev/kmsg", "Path of the kernel log with the entries of blocked connections, /dev/kmsg or a file")
+	fs.StringVar(&o.entriesPath, "filter-entries-path", "/"+constants.ExporterEntriesPath+"/"+constants.KeyFilterEntries, "Path of the file with the filter entries and their tags")
+	fs.DurationVar(&o.eventInterval, "event-interval", exporter.DefaultEventInterval, "Interval in which the top offenders are reported in Events")
+	fs.IntVar(&o.topOffenders, "top-offenders", exporter.DefaultTopOffenders, "Number of source pods with the most blocked connections reported in Events per interval")
+}
+
+// Complete implements Completer.Complete.
+func (o *exporterOptions) Complete() error {
+	if o.nodeName == "" {
+		return fmt.Errorf("missing env variable %q", exporter.EnvNodeName)
+	}
+	if o.eventInterval <= 0 {
+		return fmt.Errorf("event interval must be positive")
+	}
+	if o.topOffenders < 0 {
+		return fmt.Errorf("number of top offenders must not be negative")
+	}
+	return nil
+}
+
+// NewExporterCommand creates a new command that is used to start the blocked connection exporter, which exports the
+// connections blocked by the egress filter on its node as metrics and Events.
+func NewExporterCommand() *cobra.Command {
+	var (
+		restOpts     = &extensionscmdcontroller.RESTOptions{}
+		exporterOpts = &exporterOptions{nodeName: os.Getenv(exporter.EnvNodeName)}
+		mgrOpts      = &extensionscmdcontroller.ManagerOptions{
+			// These are default values.
+			LeaderElection:     false,
+			MetricsBindAddress: ":8080",
+			HealthBindAddress:  ":8081",
+		}
+
+		aggOption = extensionscmdcontroller.NewOptionAggregator(
+			restOpts,
+			exporterOpts,
+			mgrOpts,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use:           Name,
+		Short:         "Blocked connection exporter exports the connections blocked by the egress filter on its node as metrics and Events.",
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verflag.PrintAndExitIfRequested()
+
+			if err := aggOption.Complete(); err != nil {
+				return fmt.Errorf("error completing options: %w", err)
+			}
+			cmd.SilenceUsage = true
+
+			return run(cmd.Context(), restOpts, exporterOpts, mgrOpts)
+		},
+	}
+
+	verflag.AddFlags(cmd.Flags())
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, restOpts *extensionscmdcontroller.RESTOptions, exporterOpts *exporterOptions, mgrOpts *extensionscmdcontroller.ManagerOptions) error {
+	managerOptions := mgrOpts.Completed().Options()
+	// Restrict the cache for pods to the node to keep the load on the API server and the memory usage low.
+	managerOptions.Cache = cache.Options{
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Pod{}: {Field: fields.OneTermEqualSelector("spec.nodeName", exporterOpts.nodeName)},
+		},
+	}
+
+	mgr, err := manager.New(restOpts.Completed().Config, managerOptions)
+	if err != nil {
+		return fmt.Errorf("could not instantiate manager: %w", err)
+	}
+
+	if err := exporter.IndexPodIPs(ctx, mgr.GetFieldIndexer()); err != nil {
+		return fmt.Errorf("could not add pod index: %w", err)
+	}
+
+	e := exporter.New(logf.Log.WithName(Name), exporter.NewPodResolver(mgr.GetClient()), mgr.GetEventRecorder(Name), exporter.Options{
+		EntriesPath:   exporterOpts.entriesPath,
+		EventInterval: exporterOpts.eventInterval,
+		TopOffenders:  exporterOpts.topOffenders,
+	})
+	if err := mgr.Add(e); err != nil {
+		return fmt.Errorf("could not add exporter: %w", err)
+	}
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return followKernelLog(ctx, exporterOpts.kernelLogPath, e)
+	})); err != nil {
+		return fmt.Errorf("could not add kernel log follower: %w", err)
+	}
+
+	informer, err := mgr.GetCache().GetInformer(ctx, &corev1.Pod{})
+	if err != nil {
+		return fmt.Errorf("could not get pod informer: %w", err)
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if pod, ok := obj.(*corev1.Pod); ok {
+				e.DeletePod(pod.Namespace, pod.Name)
+			}
+		},
+	}); err != nil {
+		return fmt.Errorf("could not add pod event handler: %w", err)
+	}
+
+	if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+		return fmt.Errorf("could not add readycheck for informers: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("could not add healthcheck: %w", err)
+	}
+
+	return mgr.Start(ctx)
+}
+
+// followKernelLog passes the kernel log entries written from now on to the exporter.
+func followKernelLog(ctx context.Context, path string, e *exporter.Exporter) error {
+	file, err := os.Open(path) // #nosec G304 -- Path is configured by the operator.
+	if err != nil {
+		return fmt.Errorf("could not open kernel log: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	// /dev/kmsg starts with the oldest record in the ring buffer, which was reported by earlier instances already
+	if _, err := file.Seek(0, io.SeekEnd); err != nil {
+		return fmt.Errorf("could not seek to the end of the kernel log: %w", err)
+	}
+	return exporter.Follow(ctx, file, pollInterval, func(line string) {
+		e.Handle(ctx, line)
+	})
+}
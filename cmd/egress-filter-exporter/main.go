@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/gardener/gardener/pkg/logger"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/cmd/egress-filter-exporter/app"
+)
+
+func main() {
+	logf.SetLogger(logger.MustNewZapLogger(logger.InfoLevel, logger.FormatJSON))
+
+	ctx := signals.SetupSignalHandler()
+	if err := app.NewExporterCommand().ExecuteContext(ctx); err != nil {
+		logf.Log.Error(err, "Error executing the blocked connection exporter command")
+		os.Exit(1)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	admissioncmd "github.com/gardener/gardener-extension-shoot-networking-filter/pkg/admission/cmd"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/admission/validator"
 )
 
 // ExtensionName is the name of the extension.
@@ -54,8 +55,9 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		webhookServerOptions = &extensionscmdwebhook.ServerOptions{
 			Namespace: os.Getenv("WEBHOOK_CONFIG_NAMESPACE"),
 		}
-		webhookSwitches = admissioncmd.GardenWebhookSwitchOptions()
-		webhookOptions  = extensionscmdwebhook.NewAddToManagerOptions(
+		admissionOptions = &admissioncmd.AdmissionOptions{}
+		webhookSwitches  = admissioncmd.GardenWebhookSwitchOptions()
+		webhookOptions   = extensionscmdwebhook.NewAddToManagerOptions(
 			Name,
 			"",
 			nil,
@@ -67,6 +69,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		aggOption = extensionscmdcontroller.NewOptionAggregator(
 			restOpts,
 			mgrOpts,
+			admissionOptions,
 			webhookOptions,
 		)
 	)
@@ -93,6 +96,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			if err := aggOption.Complete(); err != nil {
 				return fmt.Errorf("error completing options: %w", err)
 			}
+			admissionOptions.Completed().Apply(&validator.DefaultAddOptions)
 
 			util.ApplyClientConnectionConfigurationToRESTConfig(&componentbaseconfigv1alpha1.ClientConnectionConfiguration{
 				QPS:   100.0,
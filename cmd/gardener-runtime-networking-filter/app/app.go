@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	pfcmd "github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cmd"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/controller/lifecycle"
+)
+
+// Name is the name of the runtime networking filter.
+const Name = "runtime-networking-filter"
+
+// runtimeOptions are the options of the ManagedResource deploying the egress filter.
+type runtimeOptions struct {
+	resourceClass string
+	namespace     string
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *runtimeOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.resourceClass, "resource-class", "seed", "Resource class of the gardener-resource-manager responsible for the ManagedResource")
+}
+
+// Complete implements Completer.Complete.
+func (o *runtimeOptions) Complete() error {
+	if o.namespace == "" {
+		return fmt.Errorf("missing env variable %q", constants.FilterNamespaceEnvName)
+	}
+	return nil
+}
+
+// NewRuntimeNetworkingFilterCommand creates a new command that is used to start the runtime networking filter, which
+// deploys the egress filter to the cluster it runs in.
+func NewRuntimeNetworkingFilterCommand() *cobra.Command {
+	var (
+		namespace   = os.Getenv(constants.FilterNamespaceEnvName)
+		restOpts    = &extensionscmdcontroller.RESTOptions{}
+		pfOpts      = &pfcmd.PolicyFilterOptions{}
+		runtimeOpts = &runtimeOptions{namespace: namespace}
+		mgrOpts     = &extensionscmdcontroller.ManagerOptions{
+			// These are default values.
+			LeaderElection:          true,
+			LeaderElectionID:        extensionscmdcontroller.LeaderElectionNameID(Name),
+			LeaderElectionNamespace: namespace,
+			MetricsBindAddress:      ":8080",
+			HealthBindAddress:       ":8081",
+		}
+
+		aggOption = extensionscmdcontroller.NewOptionAggregator(
+			restOpts,
+			pfOpts,
+			runtimeOpts,
+			mgrOpts,
+		)
+	)
+
+	cmd := &cobra.Command{
+		Use:           "gardener-runtime-networking-filter",
+		Short:         "Runtime networking filter deploys the egress filter to the seed or garden runtime cluster it runs in.",
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			verflag.PrintAndExitIfRequested()
+
+			if err := aggOption.Complete(); err != nil {
+				return fmt.Errorf("error completing options: %w", err)
+			}
+			cmd.SilenceUsage = true
+
+			return run(cmd.Context(), restOpts, pfOpts, runtimeOpts, mgrOpts)
+		},
+	}
+
+	verflag.AddFlags(cmd.Flags())
+	aggOption.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, restOpts *extensionscmdcontroller.RESTOptions, pfOpts *pfcmd.PolicyFilterOptions, runtimeOpts *runtimeOptions, mgrOpts *extensionscmdcontroller.ManagerOptions) error {
+	util.ApplyClientConnectionConfigurationToRESTConfig(&componentbaseconfigv1alpha1.ClientConnectionConfiguration{
+		QPS:   100.0,
+		Burst: 130,
+	}, restOpts.Completed().Config)
+
+	managerOptions := mgrOpts.Completed().Options()
+	// Restrict the cache for ManagedResources to the namespace to avoid the need for cluster-wide list/watch permissions.
+	managerOptions.Cache = cache.Options{
+		ByObject: map[client.Object]cache.ByObject{
+			&resourcesv1alpha1.ManagedResource{}: {Namespaces: map[string]cache.Config{runtimeOpts.namespace: {}}},
+		},
+	}
+	managerOptions.Client = client.Options{
+		Cache: &client.CacheOptions{
+			DisableFor: []client.Object{
+				&corev1.Secret{}, // applied for ManagedResources and the last known good filter list
+			},
+		},
+	}
+
+	mgr, err := manager.New(restOpts.Completed().Config, managerOptions)
+	if err != nil {
+		return fmt.Errorf("could not instantiate manager: %w", err)
+	}
+
+	if err := resourcesv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		return fmt.Errorf("could not update manager scheme: %w", err)
+	}
+
+	opts := lifecycle.RuntimeAddOptions{
+		Namespace:     runtimeOpts.namespace,
+		ResourceClass: runtimeOpts.resourceClass,
+	}
+	pfOpts.Completed().Apply(&opts.ServiceConfig)
+	if err := lifecycle.AddRuntimeToManager(mgr, opts); err != nil {
+		return fmt.Errorf("could not add controller to manager: %w", err)
+	}
+
+	if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
+		return fmt.Errorf("could not add readycheck for informers: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return fmt.Errorf("could not add healthcheck: %w", err)
+	}
+
+	return mgr.Start(ctx)
+}
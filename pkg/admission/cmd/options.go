@@ -4,9 +4,19 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+
 	extensionscmdwebhook "github.com/gardener/gardener/extensions/pkg/webhook/cmd"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/admission/validator"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/install"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/validation"
 )
 
 // GardenWebhookSwitchOptions are the extensionscmdwebhook.SwitchOptions for the admission webhooks.
@@ -15,3 +25,57 @@ func GardenWebhookSwitchOptions() *extensionscmdwebhook.SwitchOptions {
 		extensionscmdwebhook.Switch(validator.Name, validator.New),
 	)
 }
+
+// AdmissionOptions holds options related to the admission webhooks.
+type AdmissionOptions struct {
+	ConfigLocation string
+	config         *AdmissionConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *AdmissionOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ConfigLocation, "config", "", "Path to the extension configuration containing the locked entries, project permissions and enforcement backend")
+}
+
+// Complete implements Completer.Complete.
+func (o *AdmissionOptions) Complete() error {
+	o.config = &AdmissionConfig{}
+	if o.ConfigLocation == "" {
+		return nil
+	}
+	data, err := os.ReadFile(o.ConfigLocation)
+	if err != nil {
+		return err
+	}
+
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+	if _, _, err := serializer.NewCodecFactory(scheme).UniversalDecoder().Decode(data, nil, &o.config.config); err != nil {
+		return err
+	}
+	if egressFilter := o.config.config.EgressFilter; egressFilter != nil {
+		if errs := validation.ValidateProjectPermissions(egressFilter.ProjectPermissions, field.NewPath("egressFilter", "projectPermissions")); len(errs) > 0 {
+			return fmt.Errorf("invalid project permissions: %w", errs.ToAggregate())
+		}
+	}
+	return nil
+}
+
+// Completed returns the decoded AdmissionConfig instance. Only call this if `Complete` was successful.
+func (o *AdmissionOptions) Completed() *AdmissionConfig {
+	return o.config
+}
+
+// AdmissionConfig contains the configuration of the admission webhooks.
+type AdmissionConfig struct {
+	config config.Configuration
+}
+
+// Apply applies the AdmissionOptions to the passed validator AddOptions instance.
+func (c *AdmissionConfig) Apply(opts *validator.AddOptions) {
+	if c.config.EgressFilter != nil {
+		opts.LockedEntries = c.config.EgressFilter.LockedEntries
+		opts.ProjectPermissions = c.config.EgressFilter.ProjectPermissions
+		opts.EnforcementBackend = c.config.EgressFilter.EnforcementBackend
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/validation"
+)
+
+// permissionDescriptions describe the overrides requiring the permissions in denial messages.
+var permissionDescriptions = map[config.Permission]string{
+	config.PermissionAllowEntries:       "allow access to blocked networks with ALLOW_ACCESS entries",
+	config.PermissionCustomSources:      "reference custom filter list sources",
+	config.PermissionTagPolicyOverrides: "override the policy or enforcement mode of tagged entries or drop them",
+	config.PermissionOptOut:             "opt out of the shoot networking filter",
+}
+
+// projectPermissions contains the rules of the restricted permissions.
+type projectPermissions map[config.Permission][]projectRule
+
+// projectRule selects the projects a permission is granted to.
+type projectRule struct {
+	namespaces []string
+	selector   labels.Selector
+}
+
+// newProjectPermissions parses the project permission rules of the extension configuration.
+func newProjectPermissions(rules []config.ProjectPermission) (projectPermissions, error) {
+	permissions := projectPermissions{}
+	for _, rule := range rules {
+		selector := labels.Nothing()
+		if rule.ProjectSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(rule.ProjectSelector); err != nil {
+				return nil, fmt.Errorf("invalid project selector of permission %s: %w", rule.Permission, err)
+			}
+		}
+		permissions[rule.Permission] = append(permissions[rule.Permission], projectRule{namespaces: rule.Namespaces, selector: selector})
+	}
+	return permissions, nil
+}
+
+// granted returns whether the permission is granted to the project of the namespace. Permissions without rules are
+// granted to all projects.
+func (p projectPermissions) granted(permission config.Permission, namespace string, project *gardencorev1beta1.Project) bool {
+	rules, ok := p[permission]
+	if !ok {
+		return true
+	}
+	for _, rule := range rules {
+		if slices.Contains(rule.namespaces, namespace) || project != nil && rule.selector.Matches(labels.Set(project.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// permissionUsages returns the overrides of the shoot requiring a permission.
+func permissionUsages(extension *core.Extension, shootConfig *config.Configuration, fldPath *field.Path) map[config.Permission][]validation.PermissionUsage {
+	usages := validation.PermissionUsages(shootConfig.EgressFilter, fldPath.Child("providerConfig", "egressFilter"))
+	if ptr.Deref(extension.Disabled, false) {
+		usages[config.PermissionOptOut] = append(usages[config.PermissionOptOut], validation.PermissionUsage{Path: fldPath.Child("disabled"), Value: true})
+	}
+	return usages
+}
+
+// validatePermissions forbids overrides requiring permissions which are not granted to the project of the shoot.
+// Overrides used by the old shoot with the same field path and value are kept on updates, so that restricting a
+// permission does not block updates of existing shoots.
+func (s *shootValidator) validatePermissions(ctx context.Context, shoot, oldShoot *core.Shoot, usages map[config.Permission][]validation.PermissionUsage) error {
+	if len(s.projectPermissions) == 0 {
+		return nil
+	}
+
+	var oldUsages map[config.Permission][]validation.PermissionUsage
+	if oldShoot != nil {
+		if oldExtension, index := s.findExtension(oldShoot); oldExtension != nil {
+			if oldShootConfig, err := s.decodeProviderConfig(oldExtension); err == nil {
+				oldUsages = permissionUsages(oldExtension, oldShootConfig, field.NewPath("spec", "extensions").Index(index))
+			}
+		}
+	}
+
+	var (
+		project       *gardencorev1beta1.Project
+		projectLoaded bool
+		allErrs       field.ErrorList
+	)
+	for _, permission := range slices.Sorted(maps.Keys(usages)) {
+		if _, restricted := s.projectPermissions[permission]; !restricted {
+			continue
+		}
+		newUsages := slices.DeleteFunc(slices.Clone(usages[permission]), func(usage validation.PermissionUsage) bool {
+			return usage.Used(oldUsages[permission])
+		})
+		if len(newUsages) == 0 {
+			continue
+		}
+		if !projectLoaded {
+			var err error
+			if project, err = gardenerutils.ProjectForNamespaceFromReader(ctx, s.reader, shoot.Namespace); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to get project of namespace %s: %w", shoot.Namespace, err)
+			}
+			projectLoaded = true
+		}
+		if s.projectPermissions.granted(permission, shoot.Namespace, project) {
+			continue
+		}
+
+		projectName := shoot.Namespace
+		if project != nil {
+			projectName = project.Name
+		}
+		for _, usage := range newUsages {
+			allErrs = append(allErrs, field.Forbidden(usage.Path, fmt.Sprintf("project %s is not permitted to %s", projectName, permissionDescriptions[permission])))
+		}
+	}
+	return allErrs.ToAggregate()
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Project permissions", func() {
+	const (
+		allowEntry      = `{"staticFilterList":[{"network":"198.51.100.0/24","policy":"ALLOW_ACCESS"}]}`
+		otherAllowEntry = `{"staticFilterList":[{"network":"192.0.2.0/24","policy":"ALLOW_ACCESS"}]}`
+		twoAllowEntries = `{"staticFilterList":[{"network":"198.51.100.0/24","policy":"ALLOW_ACCESS"},{"network":"192.0.2.0/24","policy":"ALLOW_ACCESS"}]}`
+		blockEntry      = `{"staticFilterList":[{"network":"198.51.100.0/24","policy":"BLOCK_ACCESS"}]}`
+		audited         = `{"enforcementBackend":"calico","enforcementMode":"audit"}`
+		excludedTag     = `{"tagFilters":[{"name":"category","values":["adware"],"action":"exclude"}]}`
+	)
+
+	var (
+		ctx = context.Background()
+
+		validator extensionswebhook.Validator
+	)
+
+	// newValidator returns a validator restricting the allow entries and opting out to projects labelled as trusted
+	// and the namespace garden-ops. The project of the namespace garden-dev has the given labels.
+	newValidator := func(projectLabels map[string]string) extensionswebhook.Validator {
+		c := fake.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden-dev", Labels: map[string]string{"project.gardener.cloud/name": "dev"}}},
+			&gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: projectLabels}},
+		).Build()
+
+		selector := &metav1.LabelSelector{MatchLabels: map[string]string{"trusted": "true"}}
+		validator, err := NewShootValidator(c, AddOptions{
+			ProjectPermissions: []config.ProjectPermission{
+				{Permission: config.PermissionAllowEntries, Namespaces: []string{"garden-ops"}, ProjectSelector: selector},
+				{Permission: config.PermissionOptOut, ProjectSelector: selector},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return validator
+	}
+
+	// disabled returns the given shoot with the shoot networking filter extension disabled.
+	disabled := func(shoot *core.Shoot) *core.Shoot {
+		shoot.Spec.Extensions[0].Disabled = new(true)
+		return shoot
+	}
+
+	Context("permitted project", func() {
+		BeforeEach(func() {
+			validator = newValidator(map[string]string{"trusted": "true"})
+		})
+
+		It("should allow overrides requiring a granted permission", func() {
+			Expect(validator.Validate(ctx, newShoot(allowEntry), nil)).To(Succeed())
+			Expect(validator.Validate(ctx, newShoot(twoAllowEntries), newShoot(allowEntry))).To(Succeed())
+		})
+
+		It("should allow opting out", func() {
+			Expect(validator.Validate(ctx, disabled(newShoot(blockEntry)), newShoot(blockEntry))).To(Succeed())
+		})
+	})
+
+	Context("denied project", func() {
+		BeforeEach(func() {
+			validator = newValidator(nil)
+		})
+
+		It("should allow shoots without overrides", func() {
+			Expect(validator.Validate(ctx, newShoot(blockEntry), nil)).To(Succeed())
+		})
+
+		It("should reject overrides requiring a permission which is not granted", func() {
+			err := validator.Validate(ctx, newShoot(allowEntry), nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.extensions[0].providerConfig.egressFilter.staticFilterList[0].policy: Forbidden: project dev is not permitted to allow access to blocked networks with ALLOW_ACCESS entries")))
+		})
+
+		It("should allow namespaces named by the rule", func() {
+			shoot := newShoot(allowEntry)
+			shoot.Namespace = "garden-ops"
+			Expect(validator.Validate(ctx, shoot, nil)).To(Succeed())
+		})
+
+		It("should keep unchanged overrides of the old shoot", func() {
+			Expect(validator.Validate(ctx, newShoot(allowEntry), newShoot(allowEntry))).To(Succeed())
+			Expect(validator.Validate(ctx, disabled(newShoot(blockEntry)), disabled(newShoot(blockEntry)))).To(Succeed())
+		})
+
+		It("should reject overrides added to the old shoot", func() {
+			err := validator.Validate(ctx, newShoot(twoAllowEntries), newShoot(allowEntry))
+			Expect(err).To(MatchError(ContainSubstring("staticFilterList[1].policy: Forbidden")))
+			Expect(err).NotTo(MatchError(ContainSubstring("staticFilterList[0].policy")))
+		})
+
+		It("should reject changed overrides of the old shoot", func() {
+			err := validator.Validate(ctx, newShoot(otherAllowEntry), newShoot(allowEntry))
+			Expect(err).To(MatchError(ContainSubstring("staticFilterList[0].policy: Forbidden")))
+		})
+
+		It("should reject opting out", func() {
+			err := validator.Validate(ctx, disabled(newShoot(blockEntry)), newShoot(blockEntry))
+			Expect(err).To(MatchError(ContainSubstring("spec.extensions[0].disabled: Forbidden: project dev is not permitted to opt out of the shoot networking filter")))
+		})
+
+		It("should reject auditing the filter entries as opting out", func() {
+			shoot := newShoot(audited)
+			shoot.Spec.Networking = &core.Networking{Type: new("calico")}
+			err := validator.Validate(ctx, shoot, newShoot(blockEntry))
+			Expect(err).To(MatchError(ContainSubstring("spec.extensions[0].providerConfig.egressFilter.enforcementMode: Forbidden: project dev is not permitted to opt out of the shoot networking filter")))
+		})
+
+		It("should allow tag filters dropping entries without a rule for tag policy overrides", func() {
+			Expect(validator.Validate(ctx, newShoot(excludedTag), nil)).To(Succeed())
+		})
+	})
+})
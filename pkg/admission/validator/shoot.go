@@ -14,31 +14,48 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/install"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/validation"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 )
 
-// NewShootValidator returns a new instance of a shootValidator.
-func NewShootValidator() extensionswebhook.Validator {
+// NewShootValidator returns a new instance of a shootValidator. If locked entries are given, shoot configurations
+// attempting to allow access to them are rejected. If project permissions are given, overrides requiring them are
+// rejected for projects the permissions are not granted to. Shoot configurations are rejected if the enforcement
+// backend resolved with the given default of the extension configuration does not support them.
+func NewShootValidator(reader client.Reader, options AddOptions) (extensionswebhook.Validator, error) {
+	projectPermissions, err := newProjectPermissions(options.ProjectPermissions)
+	if err != nil {
+		return nil, err
+	}
 
 	scheme := runtime.NewScheme()
 	install.Install(scheme)
 
 	decoder := serializer.NewCodecFactory(scheme, serializer.EnableStrict).UniversalDecoder()
 	return &shootValidator{
-		scheme:  scheme,
-		decoder: decoder,
-	}
+		reader:             reader,
+		scheme:             scheme,
+		decoder:            decoder,
+		lockedEntries:      options.LockedEntries,
+		projectPermissions: projectPermissions,
+		enforcementBackend: options.EnforcementBackend,
+	}, nil
 }
 
 type shootValidator struct {
-	decoder runtime.Decoder
-	scheme  *runtime.Scheme
+	reader             client.Reader
+	decoder            runtime.Decoder
+	scheme             *runtime.Scheme
+	lockedEntries      *config.LockedEntries
+	projectPermissions projectPermissions
+	enforcementBackend config.EnforcementBackend
 }
 
 // Validate validates the given shoot object.
@@ -52,33 +69,69 @@ func (s *shootValidator) Validate(ctx context.Context, new, old client.Object) e
 		return nil
 	}
 
-	return s.validateShoot(ctx, shoot)
+	oldShoot, _ := old.(*core.Shoot)
+	return s.validateShoot(ctx, shoot, oldShoot)
 }
 
-func (s *shootValidator) validateShoot(_ context.Context, shoot *core.Shoot) error {
+func (s *shootValidator) validateShoot(ctx context.Context, shoot, oldShoot *core.Shoot) error {
 	networkFilterExtension, extensionIndex := s.findExtension(shoot)
 	if networkFilterExtension == nil {
 		return nil
 	}
 
+	internalShootConfig, err := s.decodeProviderConfig(networkFilterExtension)
+	if err != nil {
+		return err
+	}
+
+	extensionPath := field.NewPath("spec", "extensions").Index(extensionIndex)
+	fldPath := extensionPath.Child("providerConfig")
+	validationErrors := validation.ValidateProviderConfig(internalShootConfig, fldPath)
+	validationErrors = append(validationErrors, validation.ValidateLockedEntries(internalShootConfig.EgressFilter, s.lockedEntries, fldPath.Child("egressFilter"))...)
+	validationErrors = append(validationErrors, s.validateEnforcementBackend(shoot, internalShootConfig.EgressFilter, fldPath.Child("egressFilter"))...)
+	if len(validationErrors) > 0 {
+		return field.Invalid(fldPath, networkFilterExtension.ProviderConfig, validationErrors.ToAggregate().Error())
+	}
+
+	return s.validatePermissions(ctx, shoot, oldShoot, permissionUsages(networkFilterExtension, internalShootConfig, extensionPath))
+}
+
+// validateEnforcementBackend validates the shoot configuration against the enforcement backend resolved like by the
+// Extension controller. A default backend of the extension configuration not supported by the networking type of the
+// shoot is not reported, as the shoot owner cannot fix it.
+func (s *shootValidator) validateEnforcementBackend(shoot *core.Shoot, egressFilter *config.EgressFilter, fldPath *field.Path) field.ErrorList {
+	if egressFilter == nil {
+		return nil
+	}
+
+	var networkingType string
+	if shoot.Spec.Networking != nil {
+		networkingType = ptr.Deref(shoot.Spec.Networking.Type, "")
+	}
+	backend, err := helper.EnforcementBackend(&config.EgressFilter{EnforcementBackend: s.enforcementBackend}, egressFilter, networkingType)
+	if err != nil {
+		if egressFilter.EnforcementBackend == "" {
+			return nil
+		}
+		return field.ErrorList{field.Invalid(fldPath.Child("enforcementBackend"), egressFilter.EnforcementBackend, err.Error())}
+	}
+	return validation.ValidateEnforcementBackend(egressFilter, backend, fldPath)
+}
+
+// decodeProviderConfig decodes the provider config of the extension into the internal configuration.
+func (s *shootValidator) decodeProviderConfig(extension *core.Extension) (*config.Configuration, error) {
 	shootConfig := &v1alpha1.Configuration{}
-	if networkFilterExtension.ProviderConfig != nil {
-		if _, _, err := s.decoder.Decode(networkFilterExtension.ProviderConfig.Raw, nil, shootConfig); err != nil {
-			return fmt.Errorf("failed to decode provider config: %w", err)
+	if extension.ProviderConfig != nil {
+		if _, _, err := s.decoder.Decode(extension.ProviderConfig.Raw, nil, shootConfig); err != nil {
+			return nil, fmt.Errorf("failed to decode provider config: %w", err)
 		}
 	}
 
 	internalShootConfig := &config.Configuration{}
 	if err := s.scheme.Convert(shootConfig, internalShootConfig, nil); err != nil {
-		return fmt.Errorf("failed to convert shoot config: %w", err)
-	}
-
-	fldPath := field.NewPath("spec", "extensions").Index(extensionIndex).Child("providerConfig")
-	validationErrors := validation.ValidateProviderConfig(internalShootConfig, fldPath)
-	if len(validationErrors) > 0 {
-		return field.Invalid(fldPath, networkFilterExtension.ProviderConfig, validationErrors.ToAggregate().Error())
+		return nil, fmt.Errorf("failed to convert shoot config: %w", err)
 	}
-	return nil
+	return internalShootConfig, nil
 }
 
 func (s *shootValidator) findExtension(shoot *core.Shoot) (*core.Extension, int) {
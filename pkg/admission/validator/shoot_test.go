@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// newShoot returns a shoot with the shoot networking filter extension and the given egress filter configuration.
+func newShoot(egressFilter string) *core.Shoot {
+	return &core.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+		Spec: core.ShootSpec{
+			Provider: core.Provider{Workers: []core.Worker{{Name: "worker"}}},
+			Extensions: []core.Extension{{
+				Type: constants.ExtensionType,
+				ProviderConfig: &runtime.RawExtension{Raw: fmt.Appendf(nil,
+					`{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"Configuration","egressFilter":%s}`, egressFilter)},
+			}},
+		},
+	}
+}
+
+var _ = Describe("Shoot validator", func() {
+	var (
+		ctx = context.Background()
+
+		validator extensionswebhook.Validator
+	)
+
+	Describe("locked entries", func() {
+		const (
+			allowedNetwork     = `{"staticFilterList":[{"network":"198.51.100.0/24","policy":"ALLOW_ACCESS"}]}`
+			lockedNetwork      = `{"staticFilterList":[{"network":"203.0.113.0/28","policy":"ALLOW_ACCESS"}]}`
+			scopedLocked       = `{"enforcementBackend":"cilium","staticFilterList":[{"network":"203.0.113.0/28","policy":"ALLOW_ACCESS","protocol":"TCP","ports":[{"port":443}]}]}`
+			lockedTag          = `{"tagFilters":[{"name":"threat","values":["botnet"],"policy":"ALLOW_ACCESS"}]}`
+			lockedTagExclusion = `{"tagFilters":[{"name":"threat","values":["botnet"],"action":"exclude"}]}`
+		)
+
+		BeforeEach(func() {
+			var err error
+			validator, err = NewShootValidator(fake.NewClientBuilder().Build(), AddOptions{
+				LockedEntries: &config.LockedEntries{
+					Networks: []string{"203.0.113.0/24"},
+					Tags:     []config.TagRequirement{{Name: "threat", Values: []string{"botnet"}}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should allow shoots not allowing locked entries", func() {
+			Expect(validator.Validate(ctx, newShoot(allowedNetwork), nil)).To(Succeed())
+
+			shoot := newShoot(scopedLocked)
+			shoot.Spec.Networking = &core.Networking{Type: new("cilium")}
+			Expect(validator.Validate(ctx, shoot, nil)).To(Succeed())
+		})
+
+		It("should reject the creation of shoots allowing a locked network", func() {
+			err := validator.Validate(ctx, newShoot(lockedNetwork), nil)
+			Expect(err).To(MatchError(ContainSubstring("access to locked network 203.0.113.0/24 cannot be allowed")))
+		})
+
+		It("should reject the update of shoots allowing a locked network", func() {
+			err := validator.Validate(ctx, newShoot(lockedNetwork), newShoot(allowedNetwork))
+			Expect(err).To(MatchError(ContainSubstring("access to locked network 203.0.113.0/24 cannot be allowed")))
+		})
+
+		It("should reject the creation of shoots allowing or excluding a locked tag", func() {
+			Expect(validator.Validate(ctx, newShoot(lockedTag), nil)).To(MatchError(ContainSubstring("entries with locked tag threat cannot be allowed or excluded")))
+			Expect(validator.Validate(ctx, newShoot(lockedTagExclusion), nil)).To(MatchError(ContainSubstring("entries with locked tag threat cannot be allowed or excluded")))
+		})
+
+		It("should reject the update of shoots allowing a locked tag", func() {
+			err := validator.Validate(ctx, newShoot(lockedTag), newShoot(allowedNetwork))
+			Expect(err).To(MatchError(ContainSubstring("entries with locked tag threat cannot be allowed or excluded")))
+		})
+	})
+
+	Describe("enforcement backends", func() {
+		const (
+			workloads       = `{"workloads":{"exempted":[{"podSelector":{"matchLabels":{"app":"scanner"}}}]}}`
+			scopedEntry     = `{"staticFilterList":[{"network":"0.0.0.0/0","policy":"BLOCK_ACCESS","protocol":"TCP","ports":[{"port":25}]}]}`
+			audited         = `{"enforcementMode":"audit"}`
+			ciliumWorkloads = `{"enforcementBackend":"cilium","workloads":{"exempted":[{"podSelector":{"matchLabels":{"app":"scanner"}}}]}}`
+		)
+
+		// newValidator returns a validator with the given default enforcement backend.
+		newValidator := func(enforcementBackend config.EnforcementBackend) extensionswebhook.Validator {
+			validator, err := NewShootValidator(fake.NewClientBuilder().Build(), AddOptions{EnforcementBackend: enforcementBackend})
+			Expect(err).NotTo(HaveOccurred())
+			return validator
+		}
+		// withNetworking returns the given shoot with the given networking type.
+		withNetworking := func(shoot *core.Shoot, networkingType string) *core.Shoot {
+			shoot.Spec.Networking = &core.Networking{Type: &networkingType}
+			return shoot
+		}
+
+		It("should allow workloads if the resolved backend selects them", func() {
+			Expect(newValidator(config.EnforcementBackendAuto).Validate(ctx, withNetworking(newShoot(workloads), "calico"), nil)).To(Succeed())
+			Expect(newValidator(config.EnforcementBackendCilium).Validate(ctx, withNetworking(newShoot(workloads), "cilium"), nil)).To(Succeed())
+			Expect(newValidator("").Validate(ctx, withNetworking(newShoot(ciliumWorkloads), "cilium"), nil)).To(Succeed())
+		})
+
+		It("should reject workloads if the resolved backend is the egress filter applier", func() {
+			err := newValidator("").Validate(ctx, withNetworking(newShoot(workloads), "cilium"), nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.extensions[0].providerConfig.egressFilter.workloads: Forbidden: workloads cannot be selected with enforcement backend applier")))
+
+			err = newValidator(config.EnforcementBackendAuto).Validate(ctx, withNetworking(newShoot(workloads), "kindnet"), nil)
+			Expect(err).To(MatchError(ContainSubstring("workloads cannot be selected with enforcement backend applier")))
+		})
+
+		It("should reject port-scoped entries if the resolved backend is the egress filter applier", func() {
+			Expect(newValidator(config.EnforcementBackendAuto).Validate(ctx, withNetworking(newShoot(scopedEntry), "calico"), nil)).To(Succeed())
+
+			err := newValidator(config.EnforcementBackendAuto).Validate(ctx, withNetworking(newShoot(scopedEntry), "kindnet"), nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.extensions[0].providerConfig.egressFilter.staticFilterList[0].protocol: Forbidden: port- and protocol-scoped entries are not supported with enforcement backend applier")))
+		})
+
+		It("should reject auditing entries if the resolved backend cannot audit them", func() {
+			Expect(newValidator(config.EnforcementBackendAuto).Validate(ctx, withNetworking(newShoot(audited), "calico"), nil)).To(Succeed())
+
+			err := newValidator(config.EnforcementBackendAuto).Validate(ctx, withNetworking(newShoot(audited), "cilium"), nil)
+			Expect(err).To(MatchError(ContainSubstring("spec.extensions[0].providerConfig.egressFilter.enforcementMode: Forbidden: entries cannot be audited with enforcement backend cilium")))
+		})
+
+		It("should reject backends not supported by the networking type of the shoot", func() {
+			err := newValidator("").Validate(ctx, withNetworking(newShoot(ciliumWorkloads), "calico"), nil)
+			Expect(err).To(MatchError(ContainSubstring(`spec.extensions[0].providerConfig.egressFilter.enforcementBackend: Invalid value: "cilium": enforcement backend cilium is not supported for networking type "calico"`)))
+		})
+
+		It("should not reject shoots for the default backend of the extension configuration", func() {
+			Expect(newValidator(config.EnforcementBackendCilium).Validate(ctx, withNetworking(newShoot(`{}`), "calico"), nil)).To(Succeed())
+		})
+	})
+})
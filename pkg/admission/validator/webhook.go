@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 )
 
@@ -22,14 +23,32 @@ const (
 
 var logger = log.Log.WithName("shoot-networking-filter-webhook")
 
+// DefaultAddOptions contains configuration for the validation webhook.
+var DefaultAddOptions = AddOptions{}
+
+// AddOptions are options to apply when adding the validation webhook to the manager.
+type AddOptions struct {
+	// LockedEntries are the entries locked by the extension configuration.
+	LockedEntries *config.LockedEntries
+	// ProjectPermissions are the project permission rules of the extension configuration.
+	ProjectPermissions []config.ProjectPermission
+	// EnforcementBackend is the enforcement backend of the extension configuration used by shoots not configuring one.
+	EnforcementBackend config.EnforcementBackend
+}
+
 // New creates a new webhook that validates Shoot resources.
 func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	logger.Info("Setting up webhook", "name", Name)
+	shootValidator, err := NewShootValidator(mgr.GetClient(), DefaultAddOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	return extensionswebhook.New(mgr, extensionswebhook.Args{
 		Name: Name,
 		Path: "/webhooks/validate",
 		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{
-			NewShootValidator(): {{Obj: &core.Shoot{}}},
+			shootValidator: {{Obj: &core.Shoot{}}},
 		},
 		Target: extensionswebhook.TargetSeed,
 		ObjectSelector: &metav1.LabelSelector{
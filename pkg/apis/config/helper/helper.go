@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// networkingTypeBackends are the enforcement backends selected by backend `auto` for the networking types of the shoot.
+// Shoots with other networking types use the egress filter applier.
+var networkingTypeBackends = map[string]config.EnforcementBackend{
+	"cilium": config.EnforcementBackendCilium,
+	"calico": config.EnforcementBackendCalico,
+}
+
+// EnforcementBackend returns the enforcement backend of the shoot configuration if set, otherwise the one of the
+// extension configuration. Backend `auto` is resolved by the networking type of the shoot. The network policies of the
+// cilium and calico backends require the respective networking type.
+func EnforcementBackend(serviceConfig, shootConfig *config.EgressFilter, networkingType string) (config.EnforcementBackend, error) {
+	result := config.EnforcementBackendApplier
+	if serviceConfig != nil && serviceConfig.EnforcementBackend != "" {
+		result = serviceConfig.EnforcementBackend
+	}
+	if shootConfig != nil && shootConfig.EnforcementBackend != "" {
+		result = shootConfig.EnforcementBackend
+	}
+
+	switch result {
+	case config.EnforcementBackendAuto:
+		if networkingBackend, ok := networkingTypeBackends[networkingType]; ok {
+			return networkingBackend, nil
+		}
+		return config.EnforcementBackendApplier, nil
+	case config.EnforcementBackendCilium, config.EnforcementBackendCalico:
+		if networkingTypeBackends[networkingType] != result {
+			return "", fmt.Errorf("enforcement backend %s is not supported for networking type %q", result, networkingType)
+		}
+	}
+	return result, nil
+}
+
+// AuditSupported returns true if the enforcement backend can log connections to audited networks without dropping
+// them. The egress filter applier does not support it yet and Cilium has no log action for policies, so only the
+// calico backend audits entries.
+func AuditSupported(backend config.EnforcementBackend) bool {
+	return backend == config.EnforcementBackendCalico
+}
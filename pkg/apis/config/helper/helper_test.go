@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Helper", func() {
+	DescribeTable("#EnforcementBackend", func(serviceConfig, shootConfig *config.EgressFilter, networkingType string, expected config.EnforcementBackend) {
+		Expect(EnforcementBackend(serviceConfig, shootConfig, networkingType)).To(Equal(expected))
+	},
+		Entry("default", nil, nil, "cilium", config.EnforcementBackendApplier),
+		Entry("extension configuration", &config.EgressFilter{EnforcementBackend: config.EnforcementBackendCilium}, &config.EgressFilter{}, "cilium", config.EnforcementBackendCilium),
+		Entry("shoot configuration", &config.EgressFilter{EnforcementBackend: config.EnforcementBackendCilium}, &config.EgressFilter{EnforcementBackend: config.EnforcementBackendApplier}, "cilium", config.EnforcementBackendApplier),
+		Entry("auto with cilium", &config.EgressFilter{EnforcementBackend: config.EnforcementBackendAuto}, nil, "cilium", config.EnforcementBackendCilium),
+		Entry("auto with calico", nil, &config.EgressFilter{EnforcementBackend: config.EnforcementBackendAuto}, "calico", config.EnforcementBackendCalico),
+		Entry("auto with other networking", &config.EgressFilter{EnforcementBackend: config.EnforcementBackendAuto}, nil, "kindnet", config.EnforcementBackendApplier),
+	)
+
+	It("should reject backends not matching the networking type", func() {
+		_, err := EnforcementBackend(nil, &config.EgressFilter{EnforcementBackend: config.EnforcementBackendCalico}, "cilium")
+		Expect(err).To(MatchError(`enforcement backend calico is not supported for networking type "cilium"`))
+	})
+
+	DescribeTable("#AuditSupported", func(backend config.EnforcementBackend, expected bool) {
+		Expect(AuditSupported(backend)).To(Equal(expected))
+	},
+		Entry("applier", config.EnforcementBackendApplier, false),
+		Entry("cilium", config.EnforcementBackendCilium, false),
+		Entry("calico", config.EnforcementBackendCalico, true),
+	)
+})
@@ -36,6 +36,7 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Configuration{},
+		&EgressFilterStatus{},
 	)
 	return nil
 }
@@ -27,9 +27,46 @@ type EgressFilter struct {
 	// BlackholingEnabled is a flag to set blackholing or firewall approach.
 	BlackholingEnabled bool
 
+	// Mode is the filter mode. In mode `blockList` the blocked networks of the filter list are blocked, in mode
+	// `allowList` all public networks are blocked except the allowed networks of the filter list.
+	// Defaults to `blockList`.
+	Mode FilterMode
+
+	// PolicyEvaluation defines how overlapping `ALLOW_ACCESS` and `BLOCK_ACCESS` entries are evaluated. With
+	// `allowPrecedence`, all allowed networks are carved out of the blocked networks. With `longestPrefixMatch`, the
+	// policy of the most specific entry applies, e.g. a blocked /32 network within an allowed /8 network stays blocked.
+	// Defaults to `allowPrecedence`.
+	PolicyEvaluation PolicyEvaluation
+
+	// EnforcementMode is the enforcement mode of the filter lists. In mode `enforce` the connections to blocked
+	// networks are dropped, in mode `audit` they are only logged, e.g. to measure the impact of new filter lists before
+	// enforcing them. Tag filters may override it for the entries they match. Only the calico enforcement backend can
+	// audit entries, the other backends enforce all entries.
+	// Defaults to `enforce`.
+	EnforcementMode EnforcementMode
+
+	// EnforcementBackend is the way the filter lists are enforced in the shoot cluster. Backend `applier` deploys
+	// the egress filter applier, which applies them as iptables rules or blackhole routes on the nodes. Backends
+	// `cilium` and `calico` render them into deny policies of the respective network plugin instead, and `auto`
+	// selects the backend by the networking type of the shoot. The seed and garden runtime clusters always use the
+	// applier.
+	// Defaults to `applier`.
+	EnforcementBackend EnforcementBackend
+
+	// Workloads selects the pods whose egress traffic is filtered. Selecting pods requires the enforcement
+	// backends `cilium` or `calico`, it is rejected with blackholing and worker-specific block modes.
+	// Only supported in the shoot configuration.
+	Workloads *Workloads
+
 	// Workers contains worker-specific block modes
 	Workers *Workers
 
+	// Profiles contains filter profiles for the nodes of selected worker pools, which extend the filter list of
+	// the other nodes with additional entries and tag filters or use another mode. Every profile is applied by its
+	// own egress filter applier DaemonSet. Mutually exclusive with Workers.
+	// Only supported in the shoot configuration with enforcement backend `applier`.
+	Profiles []FilterProfile
+
 	// SleepDuration is the time interval between policy updates.
 	SleepDuration *metav1.Duration
 
@@ -45,9 +82,30 @@ type EgressFilter struct {
 	// Only used for provider type `download`.
 	DownloaderConfig *DownloaderConfig
 
+	// DownloadSources contains named filter list sources which are downloaded and merged into one filter list.
+	// Only used for provider type `download`. Mutually exclusive with DownloaderConfig.
+	DownloadSources []DownloadSource
+
+	// FilterListRollout configures how changes of the downloaded filter list and of the addresses of FQDN entries
+	// are rolled out to the shoots. Only supported in the extension configuration.
+	FilterListRollout *FilterListRollout
+
 	// EnsureConnectivity configures the removal of seed and/or shoot load balancers IPs from the filter list.
 	EnsureConnectivity *EnsureConnectivity
 
+	// SignatureVerification configures the verification of detached filter list signatures.
+	// If set, filter lists without a valid signature of one of the trusted keys are rejected.
+	SignatureVerification *SignatureVerification
+
+	// FQDNResolution configures the resolution of FQDN filter entries.
+	FQDNResolution *FQDNResolution
+
+	// AllowListSafeguards configures networks and FQDNs which are always allowed in mode `allowList`.
+	AllowListSafeguards *AllowListSafeguards
+
+	// BlockedConnectionExporter configures the node agent exporting metrics and Events for blocked connections.
+	BlockedConnectionExporter *BlockedConnectionExporter
+
 	// TagFilters contains filters to select entries based on tags.
 	// Only used with v2 format filter lists.
 	TagFilters []TagFilter
@@ -59,6 +117,19 @@ type EgressFilter struct {
 	// ShootFilterListSource references a Secret in the shoot cluster containing additional filter entries.
 	// Mutually exclusive with ProjectFilterListSource.
 	ShootFilterListSource *SecretRef
+
+	// MergeStrategyLimits limits the merge strategies of the project and shoot filter list sources.
+	// Only supported in the extension configuration.
+	MergeStrategyLimits *MergeStrategyLimits
+
+	// LockedEntries selects blocked entries which shoot owners cannot allow access to.
+	// Only supported in the extension configuration.
+	LockedEntries *LockedEntries
+
+	// ProjectPermissions restricts overrides of the extension configuration in the shoot configuration to selected
+	// projects. Permissions without rules are granted to all projects. Enforced on admission.
+	// Only supported in the extension configuration.
+	ProjectPermissions []ProjectPermission
 }
 
 // SecretRef references a Secret containing filter list data.
@@ -67,26 +138,166 @@ type EgressFilter struct {
 type SecretRef struct {
 	// Name is the name of the Secret.
 	Name string
-	// Key is the data key containing the filter list in JSON format.
+	// Key is the data key containing the filter list.
 	Key string
+	// Format is the format of the filter list.
+	// Defaults to `json`.
+	Format FilterListFormat
+	// SignatureKey is the data key containing the detached signature of the filter list.
+	// Defaults to the key with suffix `.sig`. Only used if signature verification is enabled.
+	SignatureKey string
 	// Namespace is the namespace of the Secret in the shoot cluster.
 	// Only used for ShootFilterListSource.
 	Namespace string
+	// MergeStrategy defines how the entries of the Secret are combined with the filter list of the extension
+	// configuration.
+	// Defaults to `replace`, the operator can restrict it with mergeStrategyLimits.
+	MergeStrategy MergeStrategy
 }
 
-// TagFilter specifies a tag-based filter criterion.
+// MergeStrategy defines how the entries of a filter list source are combined with the filter lists of lower priority.
+type MergeStrategy string
+
+const (
+	// MergeStrategyReplace replaces the filter lists of lower priority with the entries of the source.
+	MergeStrategyReplace MergeStrategy = "replace"
+	// MergeStrategyMerge adds the entries of the source to the filter lists of lower priority.
+	MergeStrategyMerge MergeStrategy = "merge"
+	// MergeStrategyAppendBlockOnly only adds the entries of the source with policy `BLOCK_ACCESS` to the filter lists of
+	// lower priority, so that the source can block further networks, but cannot allow blocked ones.
+	MergeStrategyAppendBlockOnly MergeStrategy = "appendBlockOnly"
+)
+
+// MergeStrategyLimits contains the most permissive merge strategies of the filter list sources. From the most to the
+// least permissive one, the merge strategies are `replace`, `merge` and `appendBlockOnly`. A source with a more
+// permissive merge strategy than its limit uses the limit instead.
+type MergeStrategyLimits struct {
+	// Project is the most permissive merge strategy of project filter list sources.
+	// Defaults to `replace`.
+	Project MergeStrategy
+	// Shoot is the most permissive merge strategy of shoot filter list sources. It limits the static filter lists of
+	// the shoot configuration as well.
+	// Defaults to `replace`.
+	Shoot MergeStrategy
+}
+
+// TagFilter specifies a tag-based filter criterion. An entry is matched by the requirement of Name and Values and
+// the Requirements, combined according to Match. The matching tag filter with the highest Priority decides on the
+// entry, of several ones with the same priority the one listed last.
 type TagFilter struct {
 	// Name is the tag name to filter on.
 	Name string
 	// Values is the list of allowed tag values.
 	// An entry matches if it has this tag with any of these values.
 	Values []string
+	// Operator is the operator of the requirement of Name and Values, `in` (default) or `notIn`.
+	Operator TagOperator
+	// Requirements are further requirements on the tags of matching entries.
+	Requirements []TagRequirement
+	// Match defines whether an entry has to match `all` (default) or `any` of the requirements.
+	Match TagMatch
+	// Action is the action for matching entries. With `override` (default), matching entries are kept and their
+	// policy and enforcement mode are overridden. If any tag filter has the action `include`, only entries matching
+	// one of them are kept. Entries are dropped if the tag filter deciding on them has the action `exclude`.
+	Action TagFilterAction
+	// Priority is the priority of the tag filter if several ones match an entry. Defaults to 0.
+	Priority int32
 	// Policy is an optional access policy to override for matching entries.
 	// If specified, matching entries will have their policy changed to this value.
 	// If omitted, entries keep their original policy from the source filter list.
 	Policy *Policy
+	// EnforcementMode is an optional enforcement mode to override for matching entries, e.g. to audit the entries
+	// of newly introduced tags while the other entries are enforced. Only used in mode `blockList`.
+	EnforcementMode EnforcementMode
+}
+
+// TagRequirement is a requirement on the tags of an entry.
+type TagRequirement struct {
+	// Name is the tag name.
+	Name string
+	// Operator is `in` (default) if the entry has to have the tag with any of the values, or `notIn` if it must not.
+	Operator TagOperator
+	// Values are the tag values.
+	Values []string
 }
 
+// TagOperator is the operator of a tag requirement.
+type TagOperator string
+
+const (
+	// TagOperatorIn matches entries which have the tag with any of the values.
+	TagOperatorIn TagOperator = "in"
+	// TagOperatorNotIn matches entries which do not have the tag with any of the values, including untagged ones.
+	TagOperatorNotIn TagOperator = "notIn"
+)
+
+// TagMatch defines how the requirements of a tag filter are combined.
+type TagMatch string
+
+const (
+	// TagMatchAll matches entries matching all requirements.
+	TagMatchAll TagMatch = "all"
+	// TagMatchAny matches entries matching any requirement.
+	TagMatchAny TagMatch = "any"
+)
+
+// TagFilterAction is the action of a tag filter for matching entries.
+type TagFilterAction string
+
+const (
+	// TagFilterActionOverride keeps matching entries and overrides their policy and enforcement mode.
+	TagFilterActionOverride TagFilterAction = "override"
+	// TagFilterActionInclude keeps only the entries matching a tag filter with this action.
+	TagFilterActionInclude TagFilterAction = "include"
+	// TagFilterActionExclude drops matching entries.
+	TagFilterActionExclude TagFilterAction = "exclude"
+)
+
+// FilterMode is the mode of the egress filter.
+type FilterMode string
+
+const (
+	// FilterModeBlockList blocks the blocked networks of the filter list.
+	FilterModeBlockList FilterMode = "blockList"
+	// FilterModeAllowList blocks all public networks except the allowed networks of the filter list.
+	FilterModeAllowList FilterMode = "allowList"
+)
+
+// PolicyEvaluation defines how overlapping `ALLOW_ACCESS` and `BLOCK_ACCESS` entries are evaluated.
+type PolicyEvaluation string
+
+const (
+	// PolicyEvaluationAllowPrecedence carves all allowed networks out of the blocked networks.
+	PolicyEvaluationAllowPrecedence PolicyEvaluation = "allowPrecedence"
+	// PolicyEvaluationLongestPrefixMatch applies the policy of the most specific entry. Of entries for the same
+	// network, the one of the filter list source with the higher priority applies.
+	PolicyEvaluationLongestPrefixMatch PolicyEvaluation = "longestPrefixMatch"
+)
+
+// EnforcementMode is the enforcement mode of the egress filter.
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce drops the connections to blocked networks.
+	EnforcementModeEnforce EnforcementMode = "enforce"
+	// EnforcementModeAudit only logs the connections to blocked networks.
+	EnforcementModeAudit EnforcementMode = "audit"
+)
+
+// EnforcementBackend is the way the filter lists are enforced in the shoot cluster.
+type EnforcementBackend string
+
+const (
+	// EnforcementBackendAuto selects the backend by the networking type of the shoot.
+	EnforcementBackendAuto EnforcementBackend = "auto"
+	// EnforcementBackendApplier applies the filter lists with the egress filter applier on the nodes.
+	EnforcementBackendApplier EnforcementBackend = "applier"
+	// EnforcementBackendCilium renders the filter lists into a CiliumClusterwideNetworkPolicy.
+	EnforcementBackendCilium EnforcementBackend = "cilium"
+	// EnforcementBackendCalico renders the filter lists into Calico GlobalNetworkSets and a GlobalNetworkPolicy.
+	EnforcementBackendCalico EnforcementBackend = "calico"
+)
+
 type FilterListProviderType string
 
 const (
@@ -96,6 +307,23 @@ const (
 	FilterListProviderTypeDownload FilterListProviderType = "download"
 )
 
+// FilterListFormat is the format of a filter list.
+type FilterListFormat string
+
+const (
+	// FilterListFormatJSON is the JSON format of filter lists in v1 or v2 format.
+	FilterListFormatJSON FilterListFormat = "json"
+	// FilterListFormatText is a plain text format with one network or IP address per line.
+	// Comments starting with `#` or `;` are ignored.
+	FilterListFormatText FilterListFormat = "text"
+	// FilterListFormatCSV is a CSV format with a header line. The `network` column is required,
+	// the optional `policy` column contains the access policy and all other columns are converted to tags.
+	FilterListFormatCSV FilterListFormat = "csv"
+	// FilterListFormatSTIX is a STIX 2.1 bundle or TAXII 2.1 envelope containing indicators with
+	// `ipv4-addr` or `ipv6-addr` patterns.
+	FilterListFormatSTIX FilterListFormat = "stix"
+)
+
 // Policy is the access policy
 type Policy string
 
@@ -114,8 +342,17 @@ const (
 type Filter struct {
 	// Network is the network CIDR of the filter.
 	Network string
+	// FQDN is the fully qualified domain name of the filter. It is resolved periodically into the
+	// addresses of its A and AAAA records. Mutually exclusive with Network.
+	FQDN string
 	// Policy is the access policy (`BLOCK_ACCESS` or `ALLOW_ACCESS`).
 	Policy Policy
+	// Protocol restricts the filter to a protocol (`TCP`, `UDP` or `SCTP`).
+	// If not set, the filter applies to all protocols.
+	Protocol Protocol
+	// Ports restricts the filter to destination ports of the protocol.
+	// If not set, the filter applies to all ports. Requires Protocol.
+	Ports []PortRange
 	// Tags contains metadata tags for the entry (preserved from v2 format).
 	Tags []Tag
 }
@@ -129,12 +366,36 @@ type FilterListV2 struct {
 
 // FilterEntryV2 represents a single filter entry in the v2 format.
 type FilterEntryV2 struct {
-	// Target is the network CIDR of the filter.
+	// Target is the network CIDR or the FQDN of the filter.
 	Target string `json:"target"`
 	// Tags contains metadata tags for the entry.
 	Tags []Tag `json:"tags,omitempty"`
 	// Policy is the access policy (`BLOCK` or `ALLOW`).
 	Policy Policy `json:"policy"`
+	// Protocol restricts the entry to a protocol (`TCP`, `UDP` or `SCTP`).
+	Protocol Protocol `json:"protocol,omitempty"`
+	// Ports restricts the entry to destination ports of the protocol.
+	Ports []PortRange `json:"ports,omitempty"`
+}
+
+// Protocol is the protocol of a port- or protocol-scoped filter.
+type Protocol string
+
+const (
+	// ProtocolTCP is the `TCP` protocol.
+	ProtocolTCP Protocol = "TCP"
+	// ProtocolUDP is the `UDP` protocol.
+	ProtocolUDP Protocol = "UDP"
+	// ProtocolSCTP is the `SCTP` protocol.
+	ProtocolSCTP Protocol = "SCTP"
+)
+
+// PortRange is a destination port or a range of destination ports.
+type PortRange struct {
+	// Port is the destination port or the first port of the range.
+	Port int32
+	// EndPort is the last port of the range. If not set, only Port is matched.
+	EndPort *int32
 }
 
 // Tag represents a metadata tag with a name and values.
@@ -149,12 +410,54 @@ type Tag struct {
 type DownloaderConfig struct {
 	// Endpoint is the endpoint URL for downloading the filter list.
 	Endpoint string
+	// Format is the format of the filter list.
+	// Defaults to `json`.
+	Format FilterListFormat
+	// SignatureEndpoint is the endpoint URL for downloading the detached signature of the filter list.
+	// Defaults to the endpoint with suffix `.sig`. Only used if signature verification is enabled.
+	SignatureEndpoint string
 	// OAuth2Endpoint contains the optional OAuth endpoint for fetching the access token.
 	// If specified, the OAuth2Secret must be provided, too.
 	OAuth2Endpoint *string
 	// RefreshPeriod is interval for refreshing the filter list.
 	// If unset, the filter list is only fetched on startup.
 	RefreshPeriod *metav1.Duration
+	// Timeout is the timeout of a single download attempt.
+	// Defaults to 10s.
+	Timeout *metav1.Duration
+	// Retries is the number of retries of a failed download.
+	// Defaults to 3.
+	Retries *int32
+	// RetryBackoff is the initial wait time between download retries which is doubled for every retry.
+	// Defaults to 5s.
+	RetryBackoff *metav1.Duration
+}
+
+// DownloadSource is a named filter list source which is downloaded independently of other sources.
+type DownloadSource struct {
+	// Name is the unique name of the source.
+	// It is used in metrics and to look up the OAuth2 credentials of the source.
+	Name string
+	// DownloaderConfig contains the configuration for downloading the filter list of the source.
+	DownloaderConfig
+	// DefaultTags are added to all entries of the source which do not have a tag with the same name.
+	DefaultTags []Tag
+}
+
+// FilterListRollout configures how changes of the downloaded filter list are rolled out to the shoots. When the
+// filter list changes, the reconciliation of all Extensions is triggered one after the other at the configured rate,
+// each delayed by a random jitter, so that the egress filter appliers of all shoots are not updated at the same time.
+// Changes of the addresses of FQDN entries are rolled out at the same rate.
+type FilterListRollout struct {
+	// Disabled disables the rollout of filter list changes, shoots pick up changes of the filter list with their
+	// next reconciliation. Changes of the addresses of FQDN entries are still rolled out.
+	Disabled bool
+	// ExtensionsPerMinute is the number of Extensions whose reconciliation is triggered per minute.
+	// Defaults to 20.
+	ExtensionsPerMinute *int32
+	// MaxJitter is the maximum random delay of the reconciliation of an Extension.
+	// Defaults to 10s.
+	MaxJitter *metav1.Duration
 }
 
 // OAuth2Secret contains the secret data for the optional oauth2 authorisation.
@@ -173,6 +476,156 @@ type OAuth2Secret struct {
 type EnsureConnectivity struct {
 	// SeedNamespaces contains the seed namespaces to check for load balancers.
 	SeedNamespaces []string
+	// Registries contains the host names of container registries which must stay reachable, e.g. `europe-docker.pkg.dev`.
+	// They are resolved like FQDN filter entries and carved out of the filter lists like the endpoints of the cluster.
+	Registries []string
+}
+
+// AllowListSafeguards configures networks and FQDNs which are always allowed in mode `allowList`, e.g. container
+// registries and Gardener endpoints. The API server of the shoot and the node, pod and service networks of the shoot
+// and the seed are always allowed.
+type AllowListSafeguards struct {
+	// Networks contains the network CIDRs which are always allowed.
+	Networks []string
+	// FQDNs contains the fully qualified domain names which are always allowed.
+	// They are resolved like FQDN filter entries.
+	FQDNs []string
+}
+
+// LockedEntries selects blocked entries which are enforced for all shoots. Neither `ALLOW_ACCESS` entries, tag
+// filters, the merge strategy nor the enforcement mode of the shoot configuration can allow access to them. Only the
+// endpoints required to keep the cluster operable are carved out of them.
+type LockedEntries struct {
+	// Networks contains the network CIDRs which are always blocked.
+	Networks []string
+	// Tags selects the blocked entries of the filter list of the extension configuration matching any of the
+	// requirements, i.e. of the downloaded or static filter list. Port- and protocol-scoped entries are not locked.
+	Tags []TagRequirement
+}
+
+// ProjectPermission grants a permission to the projects selected by their namespaces or labels. Once a rule exists for
+// a permission, it is denied to all projects not selected by any of its rules.
+type ProjectPermission struct {
+	// Permission is the granted permission.
+	Permission Permission
+	// Namespaces contains the namespaces of the selected projects.
+	Namespaces []string
+	// ProjectSelector selects the projects by their labels. An empty selector selects all projects.
+	ProjectSelector *metav1.LabelSelector
+}
+
+// Permission is a permission to override the extension configuration in the shoot configuration.
+type Permission string
+
+const (
+	// PermissionAllowEntries permits `ALLOW_ACCESS` entries in the static filter lists.
+	PermissionAllowEntries Permission = "allowEntries"
+	// PermissionCustomSources permits referencing filter list secrets of the project or the shoot cluster.
+	PermissionCustomSources Permission = "customSources"
+	// PermissionTagPolicyOverrides permits tag filters overriding the policy or enforcement mode of the entries they
+	// match or dropping entries.
+	PermissionTagPolicyOverrides Permission = "tagPolicyOverrides"
+	// PermissionOptOut permits disabling the extension for a shoot and exempting traffic from the egress filter with
+	// the mode `blockList`, the enforcement mode `audit` or the selection of workloads.
+	PermissionOptOut Permission = "optOut"
+)
+
+// BlockedConnectionExporter configures the node agent which parses the kernel log entries of blocked connections on
+// each node, maps them to the source pods and the tags of the matched filter entries and exports them as metrics and
+// Events.
+type BlockedConnectionExporter struct {
+	// Enabled deploys the exporter alongside the egress filter applier.
+	Enabled bool
+	// EventInterval is the interval in which the top offenders are reported in Events.
+	// Defaults to 10m.
+	EventInterval *metav1.Duration
+	// TopOffenders is the number of source pods with the most blocked connections reported in Events per interval.
+	// No Events are reported if set to 0.
+	// Defaults to 5.
+	TopOffenders *int32
+}
+
+// FQDNResolution configures the resolution of FQDN filter entries.
+type FQDNResolution struct {
+	// Nameservers contains the addresses (`host:port`) of the DNS servers used to resolve FQDN entries.
+	// Defaults to the nameservers of `/etc/resolv.conf`.
+	Nameservers []string
+	// MaxAddressesPerName is the maximum number of addresses used per FQDN.
+	// Defaults to 16.
+	MaxAddressesPerName *int32
+	// MinTTL is the minimum time resolved addresses are cached. It is also used for failed resolutions.
+	// Defaults to 30s.
+	MinTTL *metav1.Duration
+	// MaxTTL is the maximum time resolved addresses are cached.
+	// Defaults to 1h.
+	MaxTTL *metav1.Duration
+	// MinChangeInterval is the minimum time between two changes of the addresses used for an FQDN. Changes of
+	// the resolved addresses within the interval are deferred, so that names whose DNS answers rotate quickly do not
+	// trigger the reconciliation of the shoots on every resolution.
+	// Defaults to 5m.
+	MinChangeInterval *metav1.Duration
+}
+
+// SignatureVerification configures the verification of detached filter list signatures.
+type SignatureVerification struct {
+	// TrustedKeys contains the public keys trusted to sign filter lists.
+	TrustedKeys []TrustedKey
+}
+
+// TrustedKey is a named public key trusted to sign filter lists.
+type TrustedKey struct {
+	// Name is the name of the key.
+	Name string
+	// PublicKey is the PEM encoded ed25519, ECDSA or RSA public key.
+	PublicKey string
+}
+
+// Workloads selects the pods whose egress traffic is filtered.
+type Workloads struct {
+	// Filtered selects the pods which are exclusively subject to the egress filter.
+	// If empty, all pods are subject to it.
+	Filtered []WorkloadSelector
+
+	// Exempted selects the pods which are exempted from the egress filter, also if they are selected by Filtered.
+	Exempted []WorkloadSelector
+}
+
+// WorkloadSelector selects pods by the labels of their namespace and their own labels. Both selectors have to match,
+// an unset selector matches all namespaces or pods respectively.
+type WorkloadSelector struct {
+	// NamespaceSelector selects the namespaces of the pods by their labels.
+	NamespaceSelector *metav1.LabelSelector
+
+	// PodSelector selects the pods by their labels.
+	PodSelector *metav1.LabelSelector
+}
+
+// FilterProfile is a filter for the nodes of selected worker pools which differs from the one of the other nodes.
+// A node selected by several profiles uses the first one.
+type FilterProfile struct {
+	// Name is the name of the profile.
+	Name string
+
+	// WorkerPools are the names of the worker pools whose nodes use the profile.
+	WorkerPools []string
+
+	// NodeSelector selects the nodes using the profile by their labels. If WorkerPools is set as well, the nodes
+	// have to match both.
+	NodeSelector *metav1.LabelSelector
+
+	// Mode is the filter mode of the profile.
+	// Defaults to the mode of the egress filter.
+	Mode FilterMode
+
+	// BlackholingEnabled is a flag to set blackholing or firewall approach for the nodes of the profile.
+	// Defaults to BlackholingEnabled of the egress filter.
+	BlackholingEnabled *bool
+
+	// StaticFilterList contains filter entries which are added to the filter list of the profile.
+	StaticFilterList []Filter
+
+	// TagFilters contains tag filters which are added to the ones of the egress filter for the profile.
+	TagFilters []TagFilter
 }
 
 // Workers allows to specify block modes per worker group.
@@ -183,3 +636,134 @@ type Workers struct {
 	// Names is a list of worker groups to use the specified blocking mode.
 	Names []string
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressFilterStatus contains the effective filter list state which is reported in the Extension's providerStatus.
+type EgressFilterStatus struct {
+	metav1.TypeMeta
+
+	// Source is the filter list source selected during the last reconciliation.
+	Source FilterListSource
+	// MergeStrategy is the merge strategy of the project or shoot filter list source selected during the last
+	// reconciliation.
+	MergeStrategy MergeStrategy
+	// Checksum is the checksum of the rendered filter lists.
+	Checksum string
+	// IPv4 contains statistics about the rendered IPv4 filter list.
+	IPv4 FilterListStatistics
+	// IPv6 contains statistics about the rendered IPv6 filter list.
+	IPv6 FilterListStatistics
+	// DroppedPrivateEntries contains blocked networks which were dropped because they overlap with private or reserved ranges.
+	// The list is truncated, see DroppedPrivateEntriesCount for the total number.
+	DroppedPrivateEntries []string
+	// DroppedPrivateEntriesCount is the total number of dropped private or reserved networks.
+	DroppedPrivateEntriesCount int
+	// LockedEntries is the number of locked entries of the extension configuration, which are blocked regardless of
+	// the shoot configuration.
+	LockedEntries int
+	// SignatureVerification contains the result of the filter list signature verification.
+	// It is only set if signature verification is enabled.
+	SignatureVerification *SignatureVerificationStatus
+	// PortScopedRules is the number of rendered port- or protocol-scoped rules.
+	PortScopedRules int
+	// PortScopedEntriesIgnored is true if port- or protocol-scoped entries were ignored, because the egress filter
+	// applier does not support them, blackholing is enabled for all nodes or mode `allowList` is used, which cannot
+	// express them.
+	PortScopedEntriesIgnored bool
+	// Mode is the filter mode used during the last reconciliation.
+	Mode FilterMode
+	// PolicyEvaluation is the policy evaluation used during the last reconciliation.
+	PolicyEvaluation PolicyEvaluation
+	// EnforcementMode is the enforcement mode used during the last reconciliation.
+	EnforcementMode EnforcementMode
+	// EnforcementBackend is the enforcement backend used during the last reconciliation.
+	EnforcementBackend EnforcementBackend
+	// Audit contains statistics about the rendered filter lists which are only logged instead of enforced.
+	// It is only set if any entries are audited.
+	Audit *AuditStatistics
+	// IgnoredAuditedEntries is the number of audited networks and port-scoped rules which were neither enforced
+	// nor logged, because the enforcement backend cannot audit entries.
+	IgnoredAuditedEntries int
+	// Profiles contains the filter lists rendered for the filter profiles.
+	Profiles []ProfileStatus
+	// ConnectivityCarveOuts contains the blocked networks which were split or removed to keep endpoints of the cluster
+	// or configured registries reachable.
+	ConnectivityCarveOuts []ConnectivityCarveOut
+}
+
+// AuditStatistics contains statistics about the rendered filter lists which are only logged instead of enforced.
+type AuditStatistics struct {
+	// IPv4Entries is the number of audited IPv4 networks.
+	IPv4Entries int
+	// IPv6Entries is the number of audited IPv6 networks.
+	IPv6Entries int
+	// PortScopedRules is the number of audited port- or protocol-scoped rules.
+	PortScopedRules int
+}
+
+// ProfileStatus contains the filter lists rendered for a filter profile.
+type ProfileStatus struct {
+	// Name is the name of the profile.
+	Name string
+	// Checksum is the checksum of the rendered filter lists of the profile.
+	Checksum string
+	// IPv4Entries is the number of networks in the rendered IPv4 filter list of the profile.
+	IPv4Entries int
+	// IPv6Entries is the number of networks in the rendered IPv6 filter list of the profile.
+	IPv6Entries int
+}
+
+// ConnectivityCarveOut describes a blocked network which was split or removed to keep an endpoint reachable.
+type ConnectivityCarveOut struct {
+	// Network is the blocked network or port-scoped rule which was split or removed.
+	Network string
+	// Endpoint is the protected endpoint, e.g. `apiServer api.shoot.example.com` or `shootNodes 10.250.0.0/16`.
+	Endpoint string
+}
+
+// SignatureVerificationStatus contains the result of the filter list signature verification.
+type SignatureVerificationStatus struct {
+	// Failures contains the filter list sources whose latest filter list was rejected because of a missing or invalid signature.
+	Failures []SignatureVerificationFailure
+}
+
+// SignatureVerificationFailure describes a filter list rejected because of a missing or invalid signature.
+type SignatureVerificationFailure struct {
+	// Source is the filter list source, i.e. the name of the download source, `download`, `project` or `shoot`.
+	Source string
+	// Reason is the reason for the rejection.
+	Reason string
+}
+
+// FilterListSource is the source of the filter list entries.
+type FilterListSource string
+
+const (
+	// FilterListSourceNone is used if no filter list is configured for the extension.
+	FilterListSourceNone FilterListSource = "none"
+	// FilterListSourceStatic is used if the filter list is taken from the static filter list of the extension configuration.
+	FilterListSourceStatic FilterListSource = "static"
+	// FilterListSourceDownload is used if the filter list is taken from the downloaded filter list.
+	FilterListSourceDownload FilterListSource = "download"
+	// FilterListSourceProject is used if the filter list is taken from the project filter list secret.
+	FilterListSourceProject FilterListSource = "project"
+	// FilterListSourceShoot is used if the filter list is taken from the filter list secret in the shoot cluster.
+	FilterListSourceShoot FilterListSource = "shoot"
+)
+
+// FilterListStatistics contains statistics about a rendered filter list of one IP family.
+type FilterListStatistics struct {
+	// Entries is the number of networks in the rendered filter list.
+	Entries int
+	// AllowCarveOuts is the number of blocked networks which were split or removed by `ALLOW_ACCESS` entries.
+	AllowCarveOuts int
+	// LoadBalancerCarveOuts is the number of blocked networks which were split to keep load balancer IPs reachable.
+	LoadBalancerCarveOuts int
+	// Added is the number of networks added compared to the previous reconciliation.
+	// It is unset if the previous filter list is unknown.
+	Added *int
+	// Removed is the number of networks removed compared to the previous reconciliation.
+	// It is unset if the previous filter list is unknown.
+	Removed *int
+}
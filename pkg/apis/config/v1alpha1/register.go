@@ -43,6 +43,7 @@ func init() {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Configuration{},
+		&EgressFilterStatus{},
 	)
 	return nil
 }
@@ -30,10 +30,53 @@ type EgressFilter struct {
 	// BlackholingEnabled is a flag to set blackholing or firewall approach.
 	BlackholingEnabled bool `json:"blackholingEnabled"`
 
+	// Mode is the filter mode. In mode `blockList` the blocked networks of the filter list are blocked, in mode
+	// `allowList` all public networks are blocked except the allowed networks of the filter list.
+	// Defaults to `blockList`.
+	// +optional
+	Mode FilterMode `json:"mode,omitempty"`
+
+	// PolicyEvaluation defines how overlapping `ALLOW_ACCESS` and `BLOCK_ACCESS` entries are evaluated. With
+	// `allowPrecedence`, all allowed networks are carved out of the blocked networks. With `longestPrefixMatch`, the
+	// policy of the most specific entry applies, e.g. a blocked /32 network within an allowed /8 network stays blocked.
+	// Defaults to `allowPrecedence`.
+	// +optional
+	PolicyEvaluation PolicyEvaluation `json:"policyEvaluation,omitempty"`
+
+	// EnforcementMode is the enforcement mode of the filter lists. In mode `enforce` the connections to blocked
+	// networks are dropped, in mode `audit` they are only logged, e.g. to measure the impact of new filter lists before
+	// enforcing them. Tag filters may override it for the entries they match. Only the calico enforcement backend can
+	// audit entries, the other backends enforce all entries.
+	// Defaults to `enforce`.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
+
+	// EnforcementBackend is the way the filter lists are enforced in the shoot cluster. Backend `applier` deploys
+	// the egress filter applier, which applies them as iptables rules or blackhole routes on the nodes. Backends
+	// `cilium` and `calico` render them into deny policies of the respective network plugin instead, and `auto`
+	// selects the backend by the networking type of the shoot. The seed and garden runtime clusters always use the
+	// applier.
+	// Defaults to `applier`.
+	// +optional
+	EnforcementBackend EnforcementBackend `json:"enforcementBackend,omitempty"`
+
+	// Workloads selects the pods whose egress traffic is filtered. Selecting pods requires the enforcement
+	// backends `cilium` or `calico`, it is rejected with blackholing and worker-specific block modes.
+	// Only supported in the shoot configuration.
+	// +optional
+	Workloads *Workloads `json:"workloads,omitempty"`
+
 	// Workers contains worker-specific block modes
 	// +optional
 	Workers *Workers `json:"workers,omitempty"`
 
+	// Profiles contains filter profiles for the nodes of selected worker pools, which extend the filter list of
+	// the other nodes with additional entries and tag filters or use another mode. Every profile is applied by its
+	// own egress filter applier DaemonSet. Mutually exclusive with Workers.
+	// Only supported in the shoot configuration with enforcement backend `applier`.
+	// +optional
+	Profiles []FilterProfile `json:"profiles,omitempty"`
+
 	// SleepDuration is the time interval between policy updates.
 	SleepDuration *metav1.Duration `json:"sleepDuration,omitempty"`
 
@@ -51,10 +94,37 @@ type EgressFilter struct {
 	// +optional
 	DownloaderConfig *DownloaderConfig `json:"downloaderConfig,omitempty"`
 
+	// DownloadSources contains named filter list sources which are downloaded and merged into one filter list.
+	// Only used for provider type `download`. Mutually exclusive with DownloaderConfig.
+	// +optional
+	DownloadSources []DownloadSource `json:"downloadSources,omitempty"`
+
+	// FilterListRollout configures how changes of the downloaded filter list and of the addresses of FQDN entries
+	// are rolled out to the shoots. Only supported in the extension configuration.
+	// +optional
+	FilterListRollout *FilterListRollout `json:"filterListRollout,omitempty"`
+
 	// EnsureConnectivity configures the removal of seed and/or shoot load balancers IPs from the filter list.
 	// +optional
 	EnsureConnectivity *EnsureConnectivity `json:"ensureConnectivity,omitempty"`
 
+	// SignatureVerification configures the verification of detached filter list signatures.
+	// If set, filter lists without a valid signature of one of the trusted keys are rejected.
+	// +optional
+	SignatureVerification *SignatureVerification `json:"signatureVerification,omitempty"`
+
+	// FQDNResolution configures the resolution of FQDN filter entries.
+	// +optional
+	FQDNResolution *FQDNResolution `json:"fqdnResolution,omitempty"`
+
+	// AllowListSafeguards configures networks and FQDNs which are always allowed in mode `allowList`.
+	// +optional
+	AllowListSafeguards *AllowListSafeguards `json:"allowListSafeguards,omitempty"`
+
+	// BlockedConnectionExporter configures the node agent exporting metrics and Events for blocked connections.
+	// +optional
+	BlockedConnectionExporter *BlockedConnectionExporter `json:"blockedConnectionExporter,omitempty"`
+
 	// TagFilters contains filters to select entries based on tags.
 	// Only used with v2 format filter lists.
 	// +optional
@@ -69,6 +139,22 @@ type EgressFilter struct {
 	// Mutually exclusive with ProjectFilterListSource.
 	// +optional
 	ShootFilterListSource *SecretRef `json:"shootFilterListSource,omitempty"`
+
+	// MergeStrategyLimits limits the merge strategies of the project and shoot filter list sources.
+	// Only supported in the extension configuration.
+	// +optional
+	MergeStrategyLimits *MergeStrategyLimits `json:"mergeStrategyLimits,omitempty"`
+
+	// LockedEntries selects blocked entries which shoot owners cannot allow access to.
+	// Only supported in the extension configuration.
+	// +optional
+	LockedEntries *LockedEntries `json:"lockedEntries,omitempty"`
+
+	// ProjectPermissions restricts overrides of the extension configuration in the shoot configuration to selected
+	// projects. Permissions without rules are granted to all projects. Enforced on admission.
+	// Only supported in the extension configuration.
+	// +optional
+	ProjectPermissions []ProjectPermission `json:"projectPermissions,omitempty"`
 }
 
 // SecretRef references a Secret containing filter list data.
@@ -77,29 +163,183 @@ type EgressFilter struct {
 type SecretRef struct {
 	// Name is the name of the Secret.
 	Name string `json:"name"`
-	// Key is the data key containing the filter list in JSON format.
+	// Key is the data key containing the filter list.
 	// +optional
 	Key string `json:"key,omitempty"`
+	// Format is the format of the filter list.
+	// Defaults to `json`.
+	// +optional
+	Format FilterListFormat `json:"format,omitempty"`
+	// SignatureKey is the data key containing the detached signature of the filter list.
+	// Defaults to the key with suffix `.sig`. Only used if signature verification is enabled.
+	// +optional
+	SignatureKey string `json:"signatureKey,omitempty"`
 	// Namespace is the namespace of the Secret in the shoot cluster.
 	// Only used for ShootFilterListSource.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+	// MergeStrategy defines how the entries of the Secret are combined with the filter list of the extension
+	// configuration.
+	// Defaults to `replace`, the operator can restrict it with mergeStrategyLimits.
+	// +optional
+	MergeStrategy MergeStrategy `json:"mergeStrategy,omitempty"`
+}
+
+// MergeStrategy defines how the entries of a filter list source are combined with the filter lists of lower priority.
+type MergeStrategy string
+
+const (
+	// MergeStrategyReplace replaces the filter lists of lower priority with the entries of the source.
+	MergeStrategyReplace MergeStrategy = "replace"
+	// MergeStrategyMerge adds the entries of the source to the filter lists of lower priority.
+	MergeStrategyMerge MergeStrategy = "merge"
+	// MergeStrategyAppendBlockOnly only adds the entries of the source with policy `BLOCK_ACCESS` to the filter lists of
+	// lower priority, so that the source can block further networks, but cannot allow blocked ones.
+	MergeStrategyAppendBlockOnly MergeStrategy = "appendBlockOnly"
+)
+
+// MergeStrategyLimits contains the most permissive merge strategies of the filter list sources. From the most to the
+// least permissive one, the merge strategies are `replace`, `merge` and `appendBlockOnly`. A source with a more
+// permissive merge strategy than its limit uses the limit instead.
+type MergeStrategyLimits struct {
+	// Project is the most permissive merge strategy of project filter list sources.
+	// Defaults to `replace`.
+	// +optional
+	Project MergeStrategy `json:"project,omitempty"`
+	// Shoot is the most permissive merge strategy of shoot filter list sources. It limits the static filter lists of
+	// the shoot configuration as well.
+	// Defaults to `replace`.
+	// +optional
+	Shoot MergeStrategy `json:"shoot,omitempty"`
 }
 
-// TagFilter specifies a tag-based filter criterion.
+// TagFilter specifies a tag-based filter criterion. An entry is matched by the requirement of Name and Values and
+// the Requirements, combined according to Match. The matching tag filter with the highest Priority decides on the
+// entry, of several ones with the same priority the one listed last.
 type TagFilter struct {
 	// Name is the tag name to filter on.
-	Name string `json:"name"`
+	// +optional
+	Name string `json:"name,omitempty"`
 	// Values is the list of allowed tag values.
 	// An entry matches if it has this tag with any of these values.
-	Values []string `json:"values"`
+	// +optional
+	Values []string `json:"values,omitempty"`
+	// Operator is the operator of the requirement of Name and Values, `in` (default) or `notIn`.
+	// +optional
+	Operator TagOperator `json:"operator,omitempty"`
+	// Requirements are further requirements on the tags of matching entries.
+	// +optional
+	Requirements []TagRequirement `json:"requirements,omitempty"`
+	// Match defines whether an entry has to match `all` (default) or `any` of the requirements.
+	// +optional
+	Match TagMatch `json:"match,omitempty"`
+	// Action is the action for matching entries. With `override` (default), matching entries are kept and their
+	// policy and enforcement mode are overridden. If any tag filter has the action `include`, only entries matching
+	// one of them are kept. Entries are dropped if the tag filter deciding on them has the action `exclude`.
+	// +optional
+	Action TagFilterAction `json:"action,omitempty"`
+	// Priority is the priority of the tag filter if several ones match an entry. Defaults to 0.
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
 	// Policy is an optional access policy to override for matching entries.
 	// If specified, matching entries will have their policy changed to this value.
 	// If omitted, entries keep their original policy from the source filter list.
 	// +optional
 	Policy *Policy `json:"policy,omitempty"`
+	// EnforcementMode is an optional enforcement mode to override for matching entries, e.g. to audit the entries
+	// of newly introduced tags while the other entries are enforced. Only used in mode `blockList`.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
+}
+
+// TagRequirement is a requirement on the tags of an entry.
+type TagRequirement struct {
+	// Name is the tag name.
+	Name string `json:"name"`
+	// Operator is `in` (default) if the entry has to have the tag with any of the values, or `notIn` if it must not.
+	// +optional
+	Operator TagOperator `json:"operator,omitempty"`
+	// Values are the tag values.
+	Values []string `json:"values"`
 }
 
+// TagOperator is the operator of a tag requirement.
+type TagOperator string
+
+const (
+	// TagOperatorIn matches entries which have the tag with any of the values.
+	TagOperatorIn TagOperator = "in"
+	// TagOperatorNotIn matches entries which do not have the tag with any of the values, including untagged ones.
+	TagOperatorNotIn TagOperator = "notIn"
+)
+
+// TagMatch defines how the requirements of a tag filter are combined.
+type TagMatch string
+
+const (
+	// TagMatchAll matches entries matching all requirements.
+	TagMatchAll TagMatch = "all"
+	// TagMatchAny matches entries matching any requirement.
+	TagMatchAny TagMatch = "any"
+)
+
+// TagFilterAction is the action of a tag filter for matching entries.
+type TagFilterAction string
+
+const (
+	// TagFilterActionOverride keeps matching entries and overrides their policy and enforcement mode.
+	TagFilterActionOverride TagFilterAction = "override"
+	// TagFilterActionInclude keeps only the entries matching a tag filter with this action.
+	TagFilterActionInclude TagFilterAction = "include"
+	// TagFilterActionExclude drops matching entries.
+	TagFilterActionExclude TagFilterAction = "exclude"
+)
+
+// FilterMode is the mode of the egress filter.
+type FilterMode string
+
+const (
+	// FilterModeBlockList blocks the blocked networks of the filter list.
+	FilterModeBlockList FilterMode = "blockList"
+	// FilterModeAllowList blocks all public networks except the allowed networks of the filter list.
+	FilterModeAllowList FilterMode = "allowList"
+)
+
+// PolicyEvaluation defines how overlapping `ALLOW_ACCESS` and `BLOCK_ACCESS` entries are evaluated.
+type PolicyEvaluation string
+
+const (
+	// PolicyEvaluationAllowPrecedence carves all allowed networks out of the blocked networks.
+	PolicyEvaluationAllowPrecedence PolicyEvaluation = "allowPrecedence"
+	// PolicyEvaluationLongestPrefixMatch applies the policy of the most specific entry. Of entries for the same
+	// network, the one of the filter list source with the higher priority applies.
+	PolicyEvaluationLongestPrefixMatch PolicyEvaluation = "longestPrefixMatch"
+)
+
+// EnforcementMode is the enforcement mode of the egress filter.
+type EnforcementMode string
+
+const (
+	// EnforcementModeEnforce drops the connections to blocked networks.
+	EnforcementModeEnforce EnforcementMode = "enforce"
+	// EnforcementModeAudit only logs the connections to blocked networks.
+	EnforcementModeAudit EnforcementMode = "audit"
+)
+
+// EnforcementBackend is the way the filter lists are enforced in the shoot cluster.
+type EnforcementBackend string
+
+const (
+	// EnforcementBackendAuto selects the backend by the networking type of the shoot.
+	EnforcementBackendAuto EnforcementBackend = "auto"
+	// EnforcementBackendApplier applies the filter lists with the egress filter applier on the nodes.
+	EnforcementBackendApplier EnforcementBackend = "applier"
+	// EnforcementBackendCilium renders the filter lists into a CiliumClusterwideNetworkPolicy.
+	EnforcementBackendCilium EnforcementBackend = "cilium"
+	// EnforcementBackendCalico renders the filter lists into Calico GlobalNetworkSets and a GlobalNetworkPolicy.
+	EnforcementBackendCalico EnforcementBackend = "calico"
+)
+
 type FilterListProviderType string
 
 const (
@@ -109,6 +349,23 @@ const (
 	FilterListProviderTypeDownload FilterListProviderType = "download"
 )
 
+// FilterListFormat is the format of a filter list.
+type FilterListFormat string
+
+const (
+	// FilterListFormatJSON is the JSON format of filter lists in v1 or v2 format.
+	FilterListFormatJSON FilterListFormat = "json"
+	// FilterListFormatText is a plain text format with one network or IP address per line.
+	// Comments starting with `#` or `;` are ignored.
+	FilterListFormatText FilterListFormat = "text"
+	// FilterListFormatCSV is a CSV format with a header line. The `network` column is required,
+	// the optional `policy` column contains the access policy and all other columns are converted to tags.
+	FilterListFormatCSV FilterListFormat = "csv"
+	// FilterListFormatSTIX is a STIX 2.1 bundle or TAXII 2.1 envelope containing indicators with
+	// `ipv4-addr` or `ipv6-addr` patterns.
+	FilterListFormatSTIX FilterListFormat = "stix"
+)
+
 // Policy is the access policy
 type Policy string
 
@@ -122,14 +379,48 @@ const (
 // Filter specifies a network-CIDR policy pair.
 type Filter struct {
 	// Network is the network CIDR of the filter.
-	Network string `json:"network"`
+	// +optional
+	Network string `json:"network,omitempty"`
+	// FQDN is the fully qualified domain name of the filter. It is resolved periodically into the
+	// addresses of its A and AAAA records. Mutually exclusive with Network.
+	// +optional
+	FQDN string `json:"fqdn,omitempty"`
 	// Policy is the access policy (`BLOCK_ACCESS` or `ALLOW_ACCESS`).
 	Policy Policy `json:"policy"`
+	// Protocol restricts the filter to a protocol (`TCP`, `UDP` or `SCTP`).
+	// If not set, the filter applies to all protocols.
+	// +optional
+	Protocol Protocol `json:"protocol,omitempty"`
+	// Ports restricts the filter to destination ports of the protocol.
+	// If not set, the filter applies to all ports. Requires Protocol.
+	// +optional
+	Ports []PortRange `json:"ports,omitempty"`
 	// Tags contains metadata tags for the entry (preserved from v2 format).
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
 }
 
+// Protocol is the protocol of a port- or protocol-scoped filter.
+type Protocol string
+
+const (
+	// ProtocolTCP is the `TCP` protocol.
+	ProtocolTCP Protocol = "TCP"
+	// ProtocolUDP is the `UDP` protocol.
+	ProtocolUDP Protocol = "UDP"
+	// ProtocolSCTP is the `SCTP` protocol.
+	ProtocolSCTP Protocol = "SCTP"
+)
+
+// PortRange is a destination port or a range of destination ports.
+type PortRange struct {
+	// Port is the destination port or the first port of the range.
+	Port int32 `json:"port"`
+	// EndPort is the last port of the range. If not set, only Port is matched.
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
 // Tag represents a metadata tag with a name and values.
 type Tag struct {
 	// Name is the tag name.
@@ -142,6 +433,14 @@ type Tag struct {
 type DownloaderConfig struct {
 	// Endpoint is the endpoint URL for downloading the filter list.
 	Endpoint string `json:"endpoint"`
+	// Format is the format of the filter list.
+	// Defaults to `json`.
+	// +optional
+	Format FilterListFormat `json:"format,omitempty"`
+	// SignatureEndpoint is the endpoint URL for downloading the detached signature of the filter list.
+	// Defaults to the endpoint with suffix `.sig`. Only used if signature verification is enabled.
+	// +optional
+	SignatureEndpoint string `json:"signatureEndpoint,omitempty"`
 	// OAuth2Endpoint contains the optional OAuth endpoint for fetching the access token.
 	// If specified, the OAuth2Secret must be provided, too.
 	// +optional
@@ -150,6 +449,49 @@ type DownloaderConfig struct {
 	// If unset, the filter list is only fetched on startup.
 	// +optional
 	RefreshPeriod *metav1.Duration `json:"refreshPeriod,omitempty"`
+	// Timeout is the timeout of a single download attempt.
+	// Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Retries is the number of retries of a failed download.
+	// Defaults to 3.
+	// +optional
+	Retries *int32 `json:"retries,omitempty"`
+	// RetryBackoff is the initial wait time between download retries which is doubled for every retry.
+	// Defaults to 5s.
+	// +optional
+	RetryBackoff *metav1.Duration `json:"retryBackoff,omitempty"`
+}
+
+// DownloadSource is a named filter list source which is downloaded independently of other sources.
+type DownloadSource struct {
+	// Name is the unique name of the source.
+	// It is used in metrics and to look up the OAuth2 credentials of the source.
+	Name string `json:"name"`
+	// DownloaderConfig contains the configuration for downloading the filter list of the source.
+	DownloaderConfig `json:",inline"`
+	// DefaultTags are added to all entries of the source which do not have a tag with the same name.
+	// +optional
+	DefaultTags []Tag `json:"defaultTags,omitempty"`
+}
+
+// FilterListRollout configures how changes of the downloaded filter list are rolled out to the shoots. When the
+// filter list changes, the reconciliation of all Extensions is triggered one after the other at the configured rate,
+// each delayed by a random jitter, so that the egress filter appliers of all shoots are not updated at the same time.
+// Changes of the addresses of FQDN entries are rolled out at the same rate.
+type FilterListRollout struct {
+	// Disabled disables the rollout of filter list changes, shoots pick up changes of the filter list with their
+	// next reconciliation. Changes of the addresses of FQDN entries are still rolled out.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+	// ExtensionsPerMinute is the number of Extensions whose reconciliation is triggered per minute.
+	// Defaults to 20.
+	// +optional
+	ExtensionsPerMinute *int32 `json:"extensionsPerMinute,omitempty"`
+	// MaxJitter is the maximum random delay of the reconciliation of an Extension.
+	// Defaults to 10s.
+	// +optional
+	MaxJitter *metav1.Duration `json:"maxJitter,omitempty"`
 }
 
 // EnsureConnectivity configures the removal of seed and/or shoot load balancers IPs from the filter list.
@@ -157,6 +499,180 @@ type EnsureConnectivity struct {
 	// SeedNamespaces contains the seed namespaces to check for load balancers.
 	// +optional
 	SeedNamespaces []string `json:"seedNamespaces,omitempty"`
+	// Registries contains the host names of container registries which must stay reachable, e.g. `europe-docker.pkg.dev`.
+	// They are resolved like FQDN filter entries and carved out of the filter lists like the endpoints of the cluster.
+	// +optional
+	Registries []string `json:"registries,omitempty"`
+}
+
+// AllowListSafeguards configures networks and FQDNs which are always allowed in mode `allowList`, e.g. container
+// registries and Gardener endpoints. The API server of the shoot and the node, pod and service networks of the shoot
+// and the seed are always allowed.
+type AllowListSafeguards struct {
+	// Networks contains the network CIDRs which are always allowed.
+	// +optional
+	Networks []string `json:"networks,omitempty"`
+	// FQDNs contains the fully qualified domain names which are always allowed.
+	// They are resolved like FQDN filter entries.
+	// +optional
+	FQDNs []string `json:"fqdns,omitempty"`
+}
+
+// LockedEntries selects blocked entries which are enforced for all shoots. Neither `ALLOW_ACCESS` entries, tag
+// filters, the merge strategy nor the enforcement mode of the shoot configuration can allow access to them. Only the
+// endpoints required to keep the cluster operable are carved out of them.
+type LockedEntries struct {
+	// Networks contains the network CIDRs which are always blocked.
+	// +optional
+	Networks []string `json:"networks,omitempty"`
+	// Tags selects the blocked entries of the filter list of the extension configuration matching any of the
+	// requirements, i.e. of the downloaded or static filter list. Port- and protocol-scoped entries are not locked.
+	// +optional
+	Tags []TagRequirement `json:"tags,omitempty"`
+}
+
+// ProjectPermission grants a permission to the projects selected by their namespaces or labels. Once a rule exists for
+// a permission, it is denied to all projects not selected by any of its rules.
+type ProjectPermission struct {
+	// Permission is the granted permission.
+	Permission Permission `json:"permission"`
+	// Namespaces contains the namespaces of the selected projects.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ProjectSelector selects the projects by their labels. An empty selector selects all projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+}
+
+// Permission is a permission to override the extension configuration in the shoot configuration.
+type Permission string
+
+const (
+	// PermissionAllowEntries permits `ALLOW_ACCESS` entries in the static filter lists.
+	PermissionAllowEntries Permission = "allowEntries"
+	// PermissionCustomSources permits referencing filter list secrets of the project or the shoot cluster.
+	PermissionCustomSources Permission = "customSources"
+	// PermissionTagPolicyOverrides permits tag filters overriding the policy or enforcement mode of the entries they
+	// match or dropping entries.
+	PermissionTagPolicyOverrides Permission = "tagPolicyOverrides"
+	// PermissionOptOut permits disabling the extension for a shoot and exempting traffic from the egress filter with
+	// the mode `blockList`, the enforcement mode `audit` or the selection of workloads.
+	PermissionOptOut Permission = "optOut"
+)
+
+// BlockedConnectionExporter configures the node agent which parses the kernel log entries of blocked connections on
+// each node, maps them to the source pods and the tags of the matched filter entries and exports them as metrics and
+// Events.
+type BlockedConnectionExporter struct {
+	// Enabled deploys the exporter alongside the egress filter applier.
+	Enabled bool `json:"enabled"`
+	// EventInterval is the interval in which the top offenders are reported in Events.
+	// Defaults to 10m.
+	// +optional
+	EventInterval *metav1.Duration `json:"eventInterval,omitempty"`
+	// TopOffenders is the number of source pods with the most blocked connections reported in Events per interval.
+	// No Events are reported if set to 0.
+	// Defaults to 5.
+	// +optional
+	TopOffenders *int32 `json:"topOffenders,omitempty"`
+}
+
+// FQDNResolution configures the resolution of FQDN filter entries.
+type FQDNResolution struct {
+	// Nameservers contains the addresses (`host:port`) of the DNS servers used to resolve FQDN entries.
+	// Defaults to the nameservers of `/etc/resolv.conf`.
+	// +optional
+	Nameservers []string `json:"nameservers,omitempty"`
+	// MaxAddressesPerName is the maximum number of addresses used per FQDN.
+	// Defaults to 16.
+	// +optional
+	MaxAddressesPerName *int32 `json:"maxAddressesPerName,omitempty"`
+	// MinTTL is the minimum time resolved addresses are cached. It is also used for failed resolutions.
+	// Defaults to 30s.
+	// +optional
+	MinTTL *metav1.Duration `json:"minTTL,omitempty"`
+	// MaxTTL is the maximum time resolved addresses are cached.
+	// Defaults to 1h.
+	// +optional
+	MaxTTL *metav1.Duration `json:"maxTTL,omitempty"`
+	// MinChangeInterval is the minimum time between two changes of the addresses used for an FQDN. Changes of
+	// the resolved addresses within the interval are deferred, so that names whose DNS answers rotate quickly do not
+	// trigger the reconciliation of the shoots on every resolution.
+	// Defaults to 5m.
+	// +optional
+	MinChangeInterval *metav1.Duration `json:"minChangeInterval,omitempty"`
+}
+
+// SignatureVerification configures the verification of detached filter list signatures.
+type SignatureVerification struct {
+	// TrustedKeys contains the public keys trusted to sign filter lists.
+	TrustedKeys []TrustedKey `json:"trustedKeys"`
+}
+
+// TrustedKey is a named public key trusted to sign filter lists.
+type TrustedKey struct {
+	// Name is the name of the key.
+	Name string `json:"name"`
+	// PublicKey is the PEM encoded ed25519, ECDSA or RSA public key.
+	PublicKey string `json:"publicKey"`
+}
+
+// Workloads selects the pods whose egress traffic is filtered.
+type Workloads struct {
+	// Filtered selects the pods which are exclusively subject to the egress filter.
+	// If empty, all pods are subject to it.
+	// +optional
+	Filtered []WorkloadSelector `json:"filtered,omitempty"`
+
+	// Exempted selects the pods which are exempted from the egress filter, also if they are selected by Filtered.
+	// +optional
+	Exempted []WorkloadSelector `json:"exempted,omitempty"`
+}
+
+// WorkloadSelector selects pods by the labels of their namespace and their own labels. Both selectors have to match,
+// an unset selector matches all namespaces or pods respectively.
+type WorkloadSelector struct {
+	// NamespaceSelector selects the namespaces of the pods by their labels.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// PodSelector selects the pods by their labels.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// FilterProfile is a filter for the nodes of selected worker pools which differs from the one of the other nodes.
+// A node selected by several profiles uses the first one.
+type FilterProfile struct {
+	// Name is the name of the profile.
+	Name string `json:"name"`
+
+	// WorkerPools are the names of the worker pools whose nodes use the profile.
+	// +optional
+	WorkerPools []string `json:"workerPools,omitempty"`
+
+	// NodeSelector selects the nodes using the profile by their labels. If WorkerPools is set as well, the nodes
+	// have to match both.
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// Mode is the filter mode of the profile.
+	// Defaults to the mode of the egress filter.
+	// +optional
+	Mode FilterMode `json:"mode,omitempty"`
+
+	// BlackholingEnabled is a flag to set blackholing or firewall approach for the nodes of the profile.
+	// Defaults to BlackholingEnabled of the egress filter.
+	// +optional
+	BlackholingEnabled *bool `json:"blackholingEnabled,omitempty"`
+
+	// StaticFilterList contains filter entries which are added to the filter list of the profile.
+	// +optional
+	StaticFilterList []Filter `json:"staticFilterList,omitempty"`
+
+	// TagFilters contains tag filters which are added to the ones of the egress filter for the profile.
+	// +optional
+	TagFilters []TagFilter `json:"tagFilters,omitempty"`
 }
 
 // Workers allows to set the blocking mode for specific worker groups which may differ from the default.
@@ -167,3 +683,157 @@ type Workers struct {
 	// Names is a list of worker groups to use the specified blocking mode.
 	Names []string `json:"names"`
 }
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressFilterStatus contains the effective filter list state which is reported in the Extension's providerStatus.
+type EgressFilterStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Source is the filter list source selected during the last reconciliation.
+	Source FilterListSource `json:"source"`
+	// MergeStrategy is the merge strategy of the project or shoot filter list source selected during the last
+	// reconciliation.
+	// +optional
+	MergeStrategy MergeStrategy `json:"mergeStrategy,omitempty"`
+	// Checksum is the checksum of the rendered filter lists.
+	Checksum string `json:"checksum"`
+	// IPv4 contains statistics about the rendered IPv4 filter list.
+	IPv4 FilterListStatistics `json:"ipv4"`
+	// IPv6 contains statistics about the rendered IPv6 filter list.
+	IPv6 FilterListStatistics `json:"ipv6"`
+	// DroppedPrivateEntries contains blocked networks which were dropped because they overlap with private or reserved ranges.
+	// The list is truncated, see DroppedPrivateEntriesCount for the total number.
+	// +optional
+	DroppedPrivateEntries []string `json:"droppedPrivateEntries,omitempty"`
+	// DroppedPrivateEntriesCount is the total number of dropped private or reserved networks.
+	// +optional
+	DroppedPrivateEntriesCount int `json:"droppedPrivateEntriesCount,omitempty"`
+	// LockedEntries is the number of locked entries of the extension configuration, which are blocked regardless of
+	// the shoot configuration.
+	// +optional
+	LockedEntries int `json:"lockedEntries,omitempty"`
+	// SignatureVerification contains the result of the filter list signature verification.
+	// It is only set if signature verification is enabled.
+	// +optional
+	SignatureVerification *SignatureVerificationStatus `json:"signatureVerification,omitempty"`
+	// PortScopedRules is the number of rendered port- or protocol-scoped rules.
+	// +optional
+	PortScopedRules int `json:"portScopedRules,omitempty"`
+	// PortScopedEntriesIgnored is true if port- or protocol-scoped entries were ignored, because the egress filter
+	// applier does not support them, blackholing is enabled for all nodes or mode `allowList` is used, which cannot
+	// express them.
+	// +optional
+	PortScopedEntriesIgnored bool `json:"portScopedEntriesIgnored,omitempty"`
+	// Mode is the filter mode used during the last reconciliation.
+	// +optional
+	Mode FilterMode `json:"mode,omitempty"`
+	// PolicyEvaluation is the policy evaluation used during the last reconciliation.
+	// +optional
+	PolicyEvaluation PolicyEvaluation `json:"policyEvaluation,omitempty"`
+	// EnforcementMode is the enforcement mode used during the last reconciliation.
+	// +optional
+	EnforcementMode EnforcementMode `json:"enforcementMode,omitempty"`
+	// EnforcementBackend is the enforcement backend used during the last reconciliation.
+	// +optional
+	EnforcementBackend EnforcementBackend `json:"enforcementBackend,omitempty"`
+	// Audit contains statistics about the rendered filter lists which are only logged instead of enforced.
+	// It is only set if any entries are audited.
+	// +optional
+	Audit *AuditStatistics `json:"audit,omitempty"`
+	// IgnoredAuditedEntries is the number of audited networks and port-scoped rules which were neither enforced
+	// nor logged, because the enforcement backend cannot audit entries.
+	// +optional
+	IgnoredAuditedEntries int `json:"ignoredAuditedEntries,omitempty"`
+	// Profiles contains the filter lists rendered for the filter profiles.
+	// +optional
+	Profiles []ProfileStatus `json:"profiles,omitempty"`
+	// ConnectivityCarveOuts contains the blocked networks which were split or removed to keep endpoints of the cluster
+	// or configured registries reachable.
+	// +optional
+	ConnectivityCarveOuts []ConnectivityCarveOut `json:"connectivityCarveOuts,omitempty"`
+}
+
+// AuditStatistics contains statistics about the rendered filter lists which are only logged instead of enforced.
+type AuditStatistics struct {
+	// IPv4Entries is the number of audited IPv4 networks.
+	// +optional
+	IPv4Entries int `json:"ipv4Entries,omitempty"`
+	// IPv6Entries is the number of audited IPv6 networks.
+	// +optional
+	IPv6Entries int `json:"ipv6Entries,omitempty"`
+	// PortScopedRules is the number of audited port- or protocol-scoped rules.
+	// +optional
+	PortScopedRules int `json:"portScopedRules,omitempty"`
+}
+
+// ProfileStatus contains the filter lists rendered for a filter profile.
+type ProfileStatus struct {
+	// Name is the name of the profile.
+	Name string `json:"name"`
+	// Checksum is the checksum of the rendered filter lists of the profile.
+	Checksum string `json:"checksum"`
+	// IPv4Entries is the number of networks in the rendered IPv4 filter list of the profile.
+	IPv4Entries int `json:"ipv4Entries"`
+	// IPv6Entries is the number of networks in the rendered IPv6 filter list of the profile.
+	IPv6Entries int `json:"ipv6Entries"`
+}
+
+// ConnectivityCarveOut describes a blocked network which was split or removed to keep an endpoint reachable.
+type ConnectivityCarveOut struct {
+	// Network is the blocked network or port-scoped rule which was split or removed.
+	Network string `json:"network"`
+	// Endpoint is the protected endpoint, e.g. `apiServer api.shoot.example.com` or `shootNodes 10.250.0.0/16`.
+	Endpoint string `json:"endpoint"`
+}
+
+// SignatureVerificationStatus contains the result of the filter list signature verification.
+type SignatureVerificationStatus struct {
+	// Failures contains the filter list sources whose latest filter list was rejected because of a missing or invalid signature.
+	// +optional
+	Failures []SignatureVerificationFailure `json:"failures,omitempty"`
+}
+
+// SignatureVerificationFailure describes a filter list rejected because of a missing or invalid signature.
+type SignatureVerificationFailure struct {
+	// Source is the filter list source, i.e. the name of the download source, `download`, `project` or `shoot`.
+	Source string `json:"source"`
+	// Reason is the reason for the rejection.
+	Reason string `json:"reason"`
+}
+
+// FilterListSource is the source of the filter list entries.
+type FilterListSource string
+
+const (
+	// FilterListSourceNone is used if no filter list is configured for the extension.
+	FilterListSourceNone FilterListSource = "none"
+	// FilterListSourceStatic is used if the filter list is taken from the static filter list of the extension configuration.
+	FilterListSourceStatic FilterListSource = "static"
+	// FilterListSourceDownload is used if the filter list is taken from the downloaded filter list.
+	FilterListSourceDownload FilterListSource = "download"
+	// FilterListSourceProject is used if the filter list is taken from the project filter list secret.
+	FilterListSourceProject FilterListSource = "project"
+	// FilterListSourceShoot is used if the filter list is taken from the filter list secret in the shoot cluster.
+	FilterListSourceShoot FilterListSource = "shoot"
+)
+
+// FilterListStatistics contains statistics about a rendered filter list of one IP family.
+type FilterListStatistics struct {
+	// Entries is the number of networks in the rendered filter list.
+	Entries int `json:"entries"`
+	// AllowCarveOuts is the number of blocked networks which were split or removed by `ALLOW_ACCESS` entries.
+	// +optional
+	AllowCarveOuts int `json:"allowCarveOuts,omitempty"`
+	// LoadBalancerCarveOuts is the number of blocked networks which were split to keep load balancer IPs reachable.
+	// +optional
+	LoadBalancerCarveOuts int `json:"loadBalancerCarveOuts,omitempty"`
+	// Added is the number of networks added compared to the previous reconciliation.
+	// It is unset if the previous filter list is unknown.
+	// +optional
+	Added *int `json:"added,omitempty"`
+	// Removed is the number of networks removed compared to the previous reconciliation.
+	// It is unset if the previous filter list is unknown.
+	// +optional
+	Removed *int `json:"removed,omitempty"`
+}
@@ -26,6 +26,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AllowListSafeguards)(nil), (*config.AllowListSafeguards)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllowListSafeguards_To_config_AllowListSafeguards(a.(*AllowListSafeguards), b.(*config.AllowListSafeguards), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AllowListSafeguards)(nil), (*AllowListSafeguards)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AllowListSafeguards_To_v1alpha1_AllowListSafeguards(a.(*config.AllowListSafeguards), b.(*AllowListSafeguards), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditStatistics)(nil), (*config.AuditStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditStatistics_To_config_AuditStatistics(a.(*AuditStatistics), b.(*config.AuditStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AuditStatistics)(nil), (*AuditStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AuditStatistics_To_v1alpha1_AuditStatistics(a.(*config.AuditStatistics), b.(*AuditStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlockedConnectionExporter)(nil), (*config.BlockedConnectionExporter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlockedConnectionExporter_To_config_BlockedConnectionExporter(a.(*BlockedConnectionExporter), b.(*config.BlockedConnectionExporter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BlockedConnectionExporter)(nil), (*BlockedConnectionExporter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BlockedConnectionExporter_To_v1alpha1_BlockedConnectionExporter(a.(*config.BlockedConnectionExporter), b.(*BlockedConnectionExporter), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*config.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Configuration_To_config_Configuration(a.(*Configuration), b.(*config.Configuration), scope)
 	}); err != nil {
@@ -36,6 +66,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConnectivityCarveOut)(nil), (*config.ConnectivityCarveOut)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConnectivityCarveOut_To_config_ConnectivityCarveOut(a.(*ConnectivityCarveOut), b.(*config.ConnectivityCarveOut), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ConnectivityCarveOut)(nil), (*ConnectivityCarveOut)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ConnectivityCarveOut_To_v1alpha1_ConnectivityCarveOut(a.(*config.ConnectivityCarveOut), b.(*ConnectivityCarveOut), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DownloadSource)(nil), (*config.DownloadSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DownloadSource_To_config_DownloadSource(a.(*DownloadSource), b.(*config.DownloadSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DownloadSource)(nil), (*DownloadSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DownloadSource_To_v1alpha1_DownloadSource(a.(*config.DownloadSource), b.(*DownloadSource), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DownloaderConfig)(nil), (*config.DownloaderConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DownloaderConfig_To_config_DownloaderConfig(a.(*DownloaderConfig), b.(*config.DownloaderConfig), scope)
 	}); err != nil {
@@ -56,6 +106,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EgressFilterStatus)(nil), (*config.EgressFilterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressFilterStatus_To_config_EgressFilterStatus(a.(*EgressFilterStatus), b.(*config.EgressFilterStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EgressFilterStatus)(nil), (*EgressFilterStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EgressFilterStatus_To_v1alpha1_EgressFilterStatus(a.(*config.EgressFilterStatus), b.(*EgressFilterStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EnsureConnectivity)(nil), (*config.EnsureConnectivity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_EnsureConnectivity_To_config_EnsureConnectivity(a.(*EnsureConnectivity), b.(*config.EnsureConnectivity), scope)
 	}); err != nil {
@@ -66,6 +126,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FQDNResolution)(nil), (*config.FQDNResolution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FQDNResolution_To_config_FQDNResolution(a.(*FQDNResolution), b.(*config.FQDNResolution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FQDNResolution)(nil), (*FQDNResolution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FQDNResolution_To_v1alpha1_FQDNResolution(a.(*config.FQDNResolution), b.(*FQDNResolution), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Filter)(nil), (*config.Filter)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Filter_To_config_Filter(a.(*Filter), b.(*config.Filter), scope)
 	}); err != nil {
@@ -76,6 +146,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FilterListRollout)(nil), (*config.FilterListRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FilterListRollout_To_config_FilterListRollout(a.(*FilterListRollout), b.(*config.FilterListRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FilterListRollout)(nil), (*FilterListRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FilterListRollout_To_v1alpha1_FilterListRollout(a.(*config.FilterListRollout), b.(*FilterListRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FilterListStatistics)(nil), (*config.FilterListStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics(a.(*FilterListStatistics), b.(*config.FilterListStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FilterListStatistics)(nil), (*FilterListStatistics)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics(a.(*config.FilterListStatistics), b.(*FilterListStatistics), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FilterProfile)(nil), (*config.FilterProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FilterProfile_To_config_FilterProfile(a.(*FilterProfile), b.(*config.FilterProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FilterProfile)(nil), (*FilterProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FilterProfile_To_v1alpha1_FilterProfile(a.(*config.FilterProfile), b.(*FilterProfile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LockedEntries)(nil), (*config.LockedEntries)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LockedEntries_To_config_LockedEntries(a.(*LockedEntries), b.(*config.LockedEntries), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LockedEntries)(nil), (*LockedEntries)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LockedEntries_To_v1alpha1_LockedEntries(a.(*config.LockedEntries), b.(*LockedEntries), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MergeStrategyLimits)(nil), (*config.MergeStrategyLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MergeStrategyLimits_To_config_MergeStrategyLimits(a.(*MergeStrategyLimits), b.(*config.MergeStrategyLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MergeStrategyLimits)(nil), (*MergeStrategyLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MergeStrategyLimits_To_v1alpha1_MergeStrategyLimits(a.(*config.MergeStrategyLimits), b.(*MergeStrategyLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PortRange)(nil), (*config.PortRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PortRange_To_config_PortRange(a.(*PortRange), b.(*config.PortRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PortRange)(nil), (*PortRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PortRange_To_v1alpha1_PortRange(a.(*config.PortRange), b.(*PortRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProfileStatus)(nil), (*config.ProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProfileStatus_To_config_ProfileStatus(a.(*ProfileStatus), b.(*config.ProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProfileStatus)(nil), (*ProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProfileStatus_To_v1alpha1_ProfileStatus(a.(*config.ProfileStatus), b.(*ProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectPermission)(nil), (*config.ProjectPermission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProjectPermission_To_config_ProjectPermission(a.(*ProjectPermission), b.(*config.ProjectPermission), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ProjectPermission)(nil), (*ProjectPermission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ProjectPermission_To_v1alpha1_ProjectPermission(a.(*config.ProjectPermission), b.(*ProjectPermission), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretRef)(nil), (*config.SecretRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretRef_To_config_SecretRef(a.(*SecretRef), b.(*config.SecretRef), scope)
 	}); err != nil {
@@ -86,6 +236,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SignatureVerification)(nil), (*config.SignatureVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SignatureVerification_To_config_SignatureVerification(a.(*SignatureVerification), b.(*config.SignatureVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SignatureVerification)(nil), (*SignatureVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SignatureVerification_To_v1alpha1_SignatureVerification(a.(*config.SignatureVerification), b.(*SignatureVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SignatureVerificationFailure)(nil), (*config.SignatureVerificationFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SignatureVerificationFailure_To_config_SignatureVerificationFailure(a.(*SignatureVerificationFailure), b.(*config.SignatureVerificationFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SignatureVerificationFailure)(nil), (*SignatureVerificationFailure)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SignatureVerificationFailure_To_v1alpha1_SignatureVerificationFailure(a.(*config.SignatureVerificationFailure), b.(*SignatureVerificationFailure), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SignatureVerificationStatus)(nil), (*config.SignatureVerificationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SignatureVerificationStatus_To_config_SignatureVerificationStatus(a.(*SignatureVerificationStatus), b.(*config.SignatureVerificationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SignatureVerificationStatus)(nil), (*SignatureVerificationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SignatureVerificationStatus_To_v1alpha1_SignatureVerificationStatus(a.(*config.SignatureVerificationStatus), b.(*SignatureVerificationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Tag)(nil), (*config.Tag)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Tag_To_config_Tag(a.(*Tag), b.(*config.Tag), scope)
 	}); err != nil {
@@ -106,6 +286,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TagRequirement)(nil), (*config.TagRequirement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TagRequirement_To_config_TagRequirement(a.(*TagRequirement), b.(*config.TagRequirement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TagRequirement)(nil), (*TagRequirement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TagRequirement_To_v1alpha1_TagRequirement(a.(*config.TagRequirement), b.(*TagRequirement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrustedKey)(nil), (*config.TrustedKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrustedKey_To_config_TrustedKey(a.(*TrustedKey), b.(*config.TrustedKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TrustedKey)(nil), (*TrustedKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TrustedKey_To_v1alpha1_TrustedKey(a.(*config.TrustedKey), b.(*TrustedKey), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Workers)(nil), (*config.Workers)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Workers_To_config_Workers(a.(*Workers), b.(*config.Workers), scope)
 	}); err != nil {
@@ -116,9 +316,99 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadSelector)(nil), (*config.WorkloadSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadSelector_To_config_WorkloadSelector(a.(*WorkloadSelector), b.(*config.WorkloadSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.WorkloadSelector)(nil), (*WorkloadSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_WorkloadSelector_To_v1alpha1_WorkloadSelector(a.(*config.WorkloadSelector), b.(*WorkloadSelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Workloads)(nil), (*config.Workloads)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Workloads_To_config_Workloads(a.(*Workloads), b.(*config.Workloads), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Workloads)(nil), (*Workloads)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Workloads_To_v1alpha1_Workloads(a.(*config.Workloads), b.(*Workloads), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AllowListSafeguards_To_config_AllowListSafeguards(in *AllowListSafeguards, out *config.AllowListSafeguards, s conversion.Scope) error {
+	out.Networks = *(*[]string)(unsafe.Pointer(&in.Networks))
+	out.FQDNs = *(*[]string)(unsafe.Pointer(&in.FQDNs))
+	return nil
+}
+
+// Convert_v1alpha1_AllowListSafeguards_To_config_AllowListSafeguards is an autogenerated conversion function.
+func Convert_v1alpha1_AllowListSafeguards_To_config_AllowListSafeguards(in *AllowListSafeguards, out *config.AllowListSafeguards, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllowListSafeguards_To_config_AllowListSafeguards(in, out, s)
+}
+
+func autoConvert_config_AllowListSafeguards_To_v1alpha1_AllowListSafeguards(in *config.AllowListSafeguards, out *AllowListSafeguards, s conversion.Scope) error {
+	out.Networks = *(*[]string)(unsafe.Pointer(&in.Networks))
+	out.FQDNs = *(*[]string)(unsafe.Pointer(&in.FQDNs))
+	return nil
+}
+
+// Convert_config_AllowListSafeguards_To_v1alpha1_AllowListSafeguards is an autogenerated conversion function.
+func Convert_config_AllowListSafeguards_To_v1alpha1_AllowListSafeguards(in *config.AllowListSafeguards, out *AllowListSafeguards, s conversion.Scope) error {
+	return autoConvert_config_AllowListSafeguards_To_v1alpha1_AllowListSafeguards(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditStatistics_To_config_AuditStatistics(in *AuditStatistics, out *config.AuditStatistics, s conversion.Scope) error {
+	out.IPv4Entries = in.IPv4Entries
+	out.IPv6Entries = in.IPv6Entries
+	out.PortScopedRules = in.PortScopedRules
+	return nil
+}
+
+// Convert_v1alpha1_AuditStatistics_To_config_AuditStatistics is an autogenerated conversion function.
+func Convert_v1alpha1_AuditStatistics_To_config_AuditStatistics(in *AuditStatistics, out *config.AuditStatistics, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditStatistics_To_config_AuditStatistics(in, out, s)
+}
+
+func autoConvert_config_AuditStatistics_To_v1alpha1_AuditStatistics(in *config.AuditStatistics, out *AuditStatistics, s conversion.Scope) error {
+	out.IPv4Entries = in.IPv4Entries
+	out.IPv6Entries = in.IPv6Entries
+	out.PortScopedRules = in.PortScopedRules
+	return nil
+}
+
+// Convert_config_AuditStatistics_To_v1alpha1_AuditStatistics is an autogenerated conversion function.
+func Convert_config_AuditStatistics_To_v1alpha1_AuditStatistics(in *config.AuditStatistics, out *AuditStatistics, s conversion.Scope) error {
+	return autoConvert_config_AuditStatistics_To_v1alpha1_AuditStatistics(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlockedConnectionExporter_To_config_BlockedConnectionExporter(in *BlockedConnectionExporter, out *config.BlockedConnectionExporter, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.EventInterval = (*v1.Duration)(unsafe.Pointer(in.EventInterval))
+	out.TopOffenders = (*int32)(unsafe.Pointer(in.TopOffenders))
+	return nil
+}
+
+// Convert_v1alpha1_BlockedConnectionExporter_To_config_BlockedConnectionExporter is an autogenerated conversion function.
+func Convert_v1alpha1_BlockedConnectionExporter_To_config_BlockedConnectionExporter(in *BlockedConnectionExporter, out *config.BlockedConnectionExporter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlockedConnectionExporter_To_config_BlockedConnectionExporter(in, out, s)
+}
+
+func autoConvert_config_BlockedConnectionExporter_To_v1alpha1_BlockedConnectionExporter(in *config.BlockedConnectionExporter, out *BlockedConnectionExporter, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.EventInterval = (*v1.Duration)(unsafe.Pointer(in.EventInterval))
+	out.TopOffenders = (*int32)(unsafe.Pointer(in.TopOffenders))
 	return nil
 }
 
+// Convert_config_BlockedConnectionExporter_To_v1alpha1_BlockedConnectionExporter is an autogenerated conversion function.
+func Convert_config_BlockedConnectionExporter_To_v1alpha1_BlockedConnectionExporter(in *config.BlockedConnectionExporter, out *BlockedConnectionExporter, s conversion.Scope) error {
+	return autoConvert_config_BlockedConnectionExporter_To_v1alpha1_BlockedConnectionExporter(in, out, s)
+}
+
 func autoConvert_v1alpha1_Configuration_To_config_Configuration(in *Configuration, out *config.Configuration, s conversion.Scope) error {
 	out.EgressFilter = (*config.EgressFilter)(unsafe.Pointer(in.EgressFilter))
 	out.HealthCheckConfig = (*configv1alpha1.HealthCheckConfig)(unsafe.Pointer(in.HealthCheckConfig))
@@ -141,10 +431,65 @@ func Convert_config_Configuration_To_v1alpha1_Configuration(in *config.Configura
 	return autoConvert_config_Configuration_To_v1alpha1_Configuration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ConnectivityCarveOut_To_config_ConnectivityCarveOut(in *ConnectivityCarveOut, out *config.ConnectivityCarveOut, s conversion.Scope) error {
+	out.Network = in.Network
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_v1alpha1_ConnectivityCarveOut_To_config_ConnectivityCarveOut is an autogenerated conversion function.
+func Convert_v1alpha1_ConnectivityCarveOut_To_config_ConnectivityCarveOut(in *ConnectivityCarveOut, out *config.ConnectivityCarveOut, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConnectivityCarveOut_To_config_ConnectivityCarveOut(in, out, s)
+}
+
+func autoConvert_config_ConnectivityCarveOut_To_v1alpha1_ConnectivityCarveOut(in *config.ConnectivityCarveOut, out *ConnectivityCarveOut, s conversion.Scope) error {
+	out.Network = in.Network
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_config_ConnectivityCarveOut_To_v1alpha1_ConnectivityCarveOut is an autogenerated conversion function.
+func Convert_config_ConnectivityCarveOut_To_v1alpha1_ConnectivityCarveOut(in *config.ConnectivityCarveOut, out *ConnectivityCarveOut, s conversion.Scope) error {
+	return autoConvert_config_ConnectivityCarveOut_To_v1alpha1_ConnectivityCarveOut(in, out, s)
+}
+
+func autoConvert_v1alpha1_DownloadSource_To_config_DownloadSource(in *DownloadSource, out *config.DownloadSource, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_DownloaderConfig_To_config_DownloaderConfig(&in.DownloaderConfig, &out.DownloaderConfig, s); err != nil {
+		return err
+	}
+	out.DefaultTags = *(*[]config.Tag)(unsafe.Pointer(&in.DefaultTags))
+	return nil
+}
+
+// Convert_v1alpha1_DownloadSource_To_config_DownloadSource is an autogenerated conversion function.
+func Convert_v1alpha1_DownloadSource_To_config_DownloadSource(in *DownloadSource, out *config.DownloadSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DownloadSource_To_config_DownloadSource(in, out, s)
+}
+
+func autoConvert_config_DownloadSource_To_v1alpha1_DownloadSource(in *config.DownloadSource, out *DownloadSource, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_config_DownloaderConfig_To_v1alpha1_DownloaderConfig(&in.DownloaderConfig, &out.DownloaderConfig, s); err != nil {
+		return err
+	}
+	out.DefaultTags = *(*[]Tag)(unsafe.Pointer(&in.DefaultTags))
+	return nil
+}
+
+// Convert_config_DownloadSource_To_v1alpha1_DownloadSource is an autogenerated conversion function.
+func Convert_config_DownloadSource_To_v1alpha1_DownloadSource(in *config.DownloadSource, out *DownloadSource, s conversion.Scope) error {
+	return autoConvert_config_DownloadSource_To_v1alpha1_DownloadSource(in, out, s)
+}
+
 func autoConvert_v1alpha1_DownloaderConfig_To_config_DownloaderConfig(in *DownloaderConfig, out *config.DownloaderConfig, s conversion.Scope) error {
 	out.Endpoint = in.Endpoint
+	out.Format = config.FilterListFormat(in.Format)
+	out.SignatureEndpoint = in.SignatureEndpoint
 	out.OAuth2Endpoint = (*string)(unsafe.Pointer(in.OAuth2Endpoint))
 	out.RefreshPeriod = (*v1.Duration)(unsafe.Pointer(in.RefreshPeriod))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Retries = (*int32)(unsafe.Pointer(in.Retries))
+	out.RetryBackoff = (*v1.Duration)(unsafe.Pointer(in.RetryBackoff))
 	return nil
 }
 
@@ -155,8 +500,13 @@ func Convert_v1alpha1_DownloaderConfig_To_config_DownloaderConfig(in *Downloader
 
 func autoConvert_config_DownloaderConfig_To_v1alpha1_DownloaderConfig(in *config.DownloaderConfig, out *DownloaderConfig, s conversion.Scope) error {
 	out.Endpoint = in.Endpoint
+	out.Format = FilterListFormat(in.Format)
+	out.SignatureEndpoint = in.SignatureEndpoint
 	out.OAuth2Endpoint = (*string)(unsafe.Pointer(in.OAuth2Endpoint))
 	out.RefreshPeriod = (*v1.Duration)(unsafe.Pointer(in.RefreshPeriod))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Retries = (*int32)(unsafe.Pointer(in.Retries))
+	out.RetryBackoff = (*v1.Duration)(unsafe.Pointer(in.RetryBackoff))
 	return nil
 }
 
@@ -167,15 +517,30 @@ func Convert_config_DownloaderConfig_To_v1alpha1_DownloaderConfig(in *config.Dow
 
 func autoConvert_v1alpha1_EgressFilter_To_config_EgressFilter(in *EgressFilter, out *config.EgressFilter, s conversion.Scope) error {
 	out.BlackholingEnabled = in.BlackholingEnabled
+	out.Mode = config.FilterMode(in.Mode)
+	out.PolicyEvaluation = config.PolicyEvaluation(in.PolicyEvaluation)
+	out.EnforcementMode = config.EnforcementMode(in.EnforcementMode)
+	out.EnforcementBackend = config.EnforcementBackend(in.EnforcementBackend)
+	out.Workloads = (*config.Workloads)(unsafe.Pointer(in.Workloads))
 	out.Workers = (*config.Workers)(unsafe.Pointer(in.Workers))
+	out.Profiles = *(*[]config.FilterProfile)(unsafe.Pointer(&in.Profiles))
 	out.SleepDuration = (*v1.Duration)(unsafe.Pointer(in.SleepDuration))
 	out.FilterListProviderType = config.FilterListProviderType(in.FilterListProviderType)
 	out.StaticFilterList = *(*[]config.Filter)(unsafe.Pointer(&in.StaticFilterList))
 	out.DownloaderConfig = (*config.DownloaderConfig)(unsafe.Pointer(in.DownloaderConfig))
+	out.DownloadSources = *(*[]config.DownloadSource)(unsafe.Pointer(&in.DownloadSources))
+	out.FilterListRollout = (*config.FilterListRollout)(unsafe.Pointer(in.FilterListRollout))
 	out.EnsureConnectivity = (*config.EnsureConnectivity)(unsafe.Pointer(in.EnsureConnectivity))
+	out.SignatureVerification = (*config.SignatureVerification)(unsafe.Pointer(in.SignatureVerification))
+	out.FQDNResolution = (*config.FQDNResolution)(unsafe.Pointer(in.FQDNResolution))
+	out.AllowListSafeguards = (*config.AllowListSafeguards)(unsafe.Pointer(in.AllowListSafeguards))
+	out.BlockedConnectionExporter = (*config.BlockedConnectionExporter)(unsafe.Pointer(in.BlockedConnectionExporter))
 	out.TagFilters = *(*[]config.TagFilter)(unsafe.Pointer(&in.TagFilters))
 	out.ProjectFilterListSource = (*config.SecretRef)(unsafe.Pointer(in.ProjectFilterListSource))
 	out.ShootFilterListSource = (*config.SecretRef)(unsafe.Pointer(in.ShootFilterListSource))
+	out.MergeStrategyLimits = (*config.MergeStrategyLimits)(unsafe.Pointer(in.MergeStrategyLimits))
+	out.LockedEntries = (*config.LockedEntries)(unsafe.Pointer(in.LockedEntries))
+	out.ProjectPermissions = *(*[]config.ProjectPermission)(unsafe.Pointer(&in.ProjectPermissions))
 	return nil
 }
 
@@ -186,15 +551,30 @@ func Convert_v1alpha1_EgressFilter_To_config_EgressFilter(in *EgressFilter, out
 
 func autoConvert_config_EgressFilter_To_v1alpha1_EgressFilter(in *config.EgressFilter, out *EgressFilter, s conversion.Scope) error {
 	out.BlackholingEnabled = in.BlackholingEnabled
+	out.Mode = FilterMode(in.Mode)
+	out.PolicyEvaluation = PolicyEvaluation(in.PolicyEvaluation)
+	out.EnforcementMode = EnforcementMode(in.EnforcementMode)
+	out.EnforcementBackend = EnforcementBackend(in.EnforcementBackend)
+	out.Workloads = (*Workloads)(unsafe.Pointer(in.Workloads))
 	out.Workers = (*Workers)(unsafe.Pointer(in.Workers))
+	out.Profiles = *(*[]FilterProfile)(unsafe.Pointer(&in.Profiles))
 	out.SleepDuration = (*v1.Duration)(unsafe.Pointer(in.SleepDuration))
 	out.FilterListProviderType = FilterListProviderType(in.FilterListProviderType)
 	out.StaticFilterList = *(*[]Filter)(unsafe.Pointer(&in.StaticFilterList))
 	out.DownloaderConfig = (*DownloaderConfig)(unsafe.Pointer(in.DownloaderConfig))
+	out.DownloadSources = *(*[]DownloadSource)(unsafe.Pointer(&in.DownloadSources))
+	out.FilterListRollout = (*FilterListRollout)(unsafe.Pointer(in.FilterListRollout))
 	out.EnsureConnectivity = (*EnsureConnectivity)(unsafe.Pointer(in.EnsureConnectivity))
+	out.SignatureVerification = (*SignatureVerification)(unsafe.Pointer(in.SignatureVerification))
+	out.FQDNResolution = (*FQDNResolution)(unsafe.Pointer(in.FQDNResolution))
+	out.AllowListSafeguards = (*AllowListSafeguards)(unsafe.Pointer(in.AllowListSafeguards))
+	out.BlockedConnectionExporter = (*BlockedConnectionExporter)(unsafe.Pointer(in.BlockedConnectionExporter))
 	out.TagFilters = *(*[]TagFilter)(unsafe.Pointer(&in.TagFilters))
 	out.ProjectFilterListSource = (*SecretRef)(unsafe.Pointer(in.ProjectFilterListSource))
 	out.ShootFilterListSource = (*SecretRef)(unsafe.Pointer(in.ShootFilterListSource))
+	out.MergeStrategyLimits = (*MergeStrategyLimits)(unsafe.Pointer(in.MergeStrategyLimits))
+	out.LockedEntries = (*LockedEntries)(unsafe.Pointer(in.LockedEntries))
+	out.ProjectPermissions = *(*[]ProjectPermission)(unsafe.Pointer(&in.ProjectPermissions))
 	return nil
 }
 
@@ -203,8 +583,73 @@ func Convert_config_EgressFilter_To_v1alpha1_EgressFilter(in *config.EgressFilte
 	return autoConvert_config_EgressFilter_To_v1alpha1_EgressFilter(in, out, s)
 }
 
+func autoConvert_v1alpha1_EgressFilterStatus_To_config_EgressFilterStatus(in *EgressFilterStatus, out *config.EgressFilterStatus, s conversion.Scope) error {
+	out.Source = config.FilterListSource(in.Source)
+	out.MergeStrategy = config.MergeStrategy(in.MergeStrategy)
+	out.Checksum = in.Checksum
+	if err := Convert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics(&in.IPv4, &out.IPv4, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics(&in.IPv6, &out.IPv6, s); err != nil {
+		return err
+	}
+	out.DroppedPrivateEntries = *(*[]string)(unsafe.Pointer(&in.DroppedPrivateEntries))
+	out.DroppedPrivateEntriesCount = in.DroppedPrivateEntriesCount
+	out.LockedEntries = in.LockedEntries
+	out.SignatureVerification = (*config.SignatureVerificationStatus)(unsafe.Pointer(in.SignatureVerification))
+	out.PortScopedRules = in.PortScopedRules
+	out.PortScopedEntriesIgnored = in.PortScopedEntriesIgnored
+	out.Mode = config.FilterMode(in.Mode)
+	out.PolicyEvaluation = config.PolicyEvaluation(in.PolicyEvaluation)
+	out.EnforcementMode = config.EnforcementMode(in.EnforcementMode)
+	out.EnforcementBackend = config.EnforcementBackend(in.EnforcementBackend)
+	out.Audit = (*config.AuditStatistics)(unsafe.Pointer(in.Audit))
+	out.IgnoredAuditedEntries = in.IgnoredAuditedEntries
+	out.Profiles = *(*[]config.ProfileStatus)(unsafe.Pointer(&in.Profiles))
+	out.ConnectivityCarveOuts = *(*[]config.ConnectivityCarveOut)(unsafe.Pointer(&in.ConnectivityCarveOuts))
+	return nil
+}
+
+// Convert_v1alpha1_EgressFilterStatus_To_config_EgressFilterStatus is an autogenerated conversion function.
+func Convert_v1alpha1_EgressFilterStatus_To_config_EgressFilterStatus(in *EgressFilterStatus, out *config.EgressFilterStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressFilterStatus_To_config_EgressFilterStatus(in, out, s)
+}
+
+func autoConvert_config_EgressFilterStatus_To_v1alpha1_EgressFilterStatus(in *config.EgressFilterStatus, out *EgressFilterStatus, s conversion.Scope) error {
+	out.Source = FilterListSource(in.Source)
+	out.MergeStrategy = MergeStrategy(in.MergeStrategy)
+	out.Checksum = in.Checksum
+	if err := Convert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics(&in.IPv4, &out.IPv4, s); err != nil {
+		return err
+	}
+	if err := Convert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics(&in.IPv6, &out.IPv6, s); err != nil {
+		return err
+	}
+	out.DroppedPrivateEntries = *(*[]string)(unsafe.Pointer(&in.DroppedPrivateEntries))
+	out.DroppedPrivateEntriesCount = in.DroppedPrivateEntriesCount
+	out.LockedEntries = in.LockedEntries
+	out.SignatureVerification = (*SignatureVerificationStatus)(unsafe.Pointer(in.SignatureVerification))
+	out.PortScopedRules = in.PortScopedRules
+	out.PortScopedEntriesIgnored = in.PortScopedEntriesIgnored
+	out.Mode = FilterMode(in.Mode)
+	out.PolicyEvaluation = PolicyEvaluation(in.PolicyEvaluation)
+	out.EnforcementMode = EnforcementMode(in.EnforcementMode)
+	out.EnforcementBackend = EnforcementBackend(in.EnforcementBackend)
+	out.Audit = (*AuditStatistics)(unsafe.Pointer(in.Audit))
+	out.IgnoredAuditedEntries = in.IgnoredAuditedEntries
+	out.Profiles = *(*[]ProfileStatus)(unsafe.Pointer(&in.Profiles))
+	out.ConnectivityCarveOuts = *(*[]ConnectivityCarveOut)(unsafe.Pointer(&in.ConnectivityCarveOuts))
+	return nil
+}
+
+// Convert_config_EgressFilterStatus_To_v1alpha1_EgressFilterStatus is an autogenerated conversion function.
+func Convert_config_EgressFilterStatus_To_v1alpha1_EgressFilterStatus(in *config.EgressFilterStatus, out *EgressFilterStatus, s conversion.Scope) error {
+	return autoConvert_config_EgressFilterStatus_To_v1alpha1_EgressFilterStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_EnsureConnectivity_To_config_EnsureConnectivity(in *EnsureConnectivity, out *config.EnsureConnectivity, s conversion.Scope) error {
 	out.SeedNamespaces = *(*[]string)(unsafe.Pointer(&in.SeedNamespaces))
+	out.Registries = *(*[]string)(unsafe.Pointer(&in.Registries))
 	return nil
 }
 
@@ -215,6 +660,7 @@ func Convert_v1alpha1_EnsureConnectivity_To_config_EnsureConnectivity(in *Ensure
 
 func autoConvert_config_EnsureConnectivity_To_v1alpha1_EnsureConnectivity(in *config.EnsureConnectivity, out *EnsureConnectivity, s conversion.Scope) error {
 	out.SeedNamespaces = *(*[]string)(unsafe.Pointer(&in.SeedNamespaces))
+	out.Registries = *(*[]string)(unsafe.Pointer(&in.Registries))
 	return nil
 }
 
@@ -223,9 +669,40 @@ func Convert_config_EnsureConnectivity_To_v1alpha1_EnsureConnectivity(in *config
 	return autoConvert_config_EnsureConnectivity_To_v1alpha1_EnsureConnectivity(in, out, s)
 }
 
+func autoConvert_v1alpha1_FQDNResolution_To_config_FQDNResolution(in *FQDNResolution, out *config.FQDNResolution, s conversion.Scope) error {
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.MaxAddressesPerName = (*int32)(unsafe.Pointer(in.MaxAddressesPerName))
+	out.MinTTL = (*v1.Duration)(unsafe.Pointer(in.MinTTL))
+	out.MaxTTL = (*v1.Duration)(unsafe.Pointer(in.MaxTTL))
+	out.MinChangeInterval = (*v1.Duration)(unsafe.Pointer(in.MinChangeInterval))
+	return nil
+}
+
+// Convert_v1alpha1_FQDNResolution_To_config_FQDNResolution is an autogenerated conversion function.
+func Convert_v1alpha1_FQDNResolution_To_config_FQDNResolution(in *FQDNResolution, out *config.FQDNResolution, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FQDNResolution_To_config_FQDNResolution(in, out, s)
+}
+
+func autoConvert_config_FQDNResolution_To_v1alpha1_FQDNResolution(in *config.FQDNResolution, out *FQDNResolution, s conversion.Scope) error {
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.MaxAddressesPerName = (*int32)(unsafe.Pointer(in.MaxAddressesPerName))
+	out.MinTTL = (*v1.Duration)(unsafe.Pointer(in.MinTTL))
+	out.MaxTTL = (*v1.Duration)(unsafe.Pointer(in.MaxTTL))
+	out.MinChangeInterval = (*v1.Duration)(unsafe.Pointer(in.MinChangeInterval))
+	return nil
+}
+
+// Convert_config_FQDNResolution_To_v1alpha1_FQDNResolution is an autogenerated conversion function.
+func Convert_config_FQDNResolution_To_v1alpha1_FQDNResolution(in *config.FQDNResolution, out *FQDNResolution, s conversion.Scope) error {
+	return autoConvert_config_FQDNResolution_To_v1alpha1_FQDNResolution(in, out, s)
+}
+
 func autoConvert_v1alpha1_Filter_To_config_Filter(in *Filter, out *config.Filter, s conversion.Scope) error {
 	out.Network = in.Network
+	out.FQDN = in.FQDN
 	out.Policy = config.Policy(in.Policy)
+	out.Protocol = config.Protocol(in.Protocol)
+	out.Ports = *(*[]config.PortRange)(unsafe.Pointer(&in.Ports))
 	out.Tags = *(*[]config.Tag)(unsafe.Pointer(&in.Tags))
 	return nil
 }
@@ -237,7 +714,10 @@ func Convert_v1alpha1_Filter_To_config_Filter(in *Filter, out *config.Filter, s
 
 func autoConvert_config_Filter_To_v1alpha1_Filter(in *config.Filter, out *Filter, s conversion.Scope) error {
 	out.Network = in.Network
+	out.FQDN = in.FQDN
 	out.Policy = Policy(in.Policy)
+	out.Protocol = Protocol(in.Protocol)
+	out.Ports = *(*[]PortRange)(unsafe.Pointer(&in.Ports))
 	out.Tags = *(*[]Tag)(unsafe.Pointer(&in.Tags))
 	return nil
 }
@@ -247,10 +727,213 @@ func Convert_config_Filter_To_v1alpha1_Filter(in *config.Filter, out *Filter, s
 	return autoConvert_config_Filter_To_v1alpha1_Filter(in, out, s)
 }
 
+func autoConvert_v1alpha1_FilterListRollout_To_config_FilterListRollout(in *FilterListRollout, out *config.FilterListRollout, s conversion.Scope) error {
+	out.Disabled = in.Disabled
+	out.ExtensionsPerMinute = (*int32)(unsafe.Pointer(in.ExtensionsPerMinute))
+	out.MaxJitter = (*v1.Duration)(unsafe.Pointer(in.MaxJitter))
+	return nil
+}
+
+// Convert_v1alpha1_FilterListRollout_To_config_FilterListRollout is an autogenerated conversion function.
+func Convert_v1alpha1_FilterListRollout_To_config_FilterListRollout(in *FilterListRollout, out *config.FilterListRollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FilterListRollout_To_config_FilterListRollout(in, out, s)
+}
+
+func autoConvert_config_FilterListRollout_To_v1alpha1_FilterListRollout(in *config.FilterListRollout, out *FilterListRollout, s conversion.Scope) error {
+	out.Disabled = in.Disabled
+	out.ExtensionsPerMinute = (*int32)(unsafe.Pointer(in.ExtensionsPerMinute))
+	out.MaxJitter = (*v1.Duration)(unsafe.Pointer(in.MaxJitter))
+	return nil
+}
+
+// Convert_config_FilterListRollout_To_v1alpha1_FilterListRollout is an autogenerated conversion function.
+func Convert_config_FilterListRollout_To_v1alpha1_FilterListRollout(in *config.FilterListRollout, out *FilterListRollout, s conversion.Scope) error {
+	return autoConvert_config_FilterListRollout_To_v1alpha1_FilterListRollout(in, out, s)
+}
+
+func autoConvert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics(in *FilterListStatistics, out *config.FilterListStatistics, s conversion.Scope) error {
+	out.Entries = in.Entries
+	out.AllowCarveOuts = in.AllowCarveOuts
+	out.LoadBalancerCarveOuts = in.LoadBalancerCarveOuts
+	out.Added = (*int)(unsafe.Pointer(in.Added))
+	out.Removed = (*int)(unsafe.Pointer(in.Removed))
+	return nil
+}
+
+// Convert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics is an autogenerated conversion function.
+func Convert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics(in *FilterListStatistics, out *config.FilterListStatistics, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FilterListStatistics_To_config_FilterListStatistics(in, out, s)
+}
+
+func autoConvert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics(in *config.FilterListStatistics, out *FilterListStatistics, s conversion.Scope) error {
+	out.Entries = in.Entries
+	out.AllowCarveOuts = in.AllowCarveOuts
+	out.LoadBalancerCarveOuts = in.LoadBalancerCarveOuts
+	out.Added = (*int)(unsafe.Pointer(in.Added))
+	out.Removed = (*int)(unsafe.Pointer(in.Removed))
+	return nil
+}
+
+// Convert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics is an autogenerated conversion function.
+func Convert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics(in *config.FilterListStatistics, out *FilterListStatistics, s conversion.Scope) error {
+	return autoConvert_config_FilterListStatistics_To_v1alpha1_FilterListStatistics(in, out, s)
+}
+
+func autoConvert_v1alpha1_FilterProfile_To_config_FilterProfile(in *FilterProfile, out *config.FilterProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.WorkerPools = *(*[]string)(unsafe.Pointer(&in.WorkerPools))
+	out.NodeSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NodeSelector))
+	out.Mode = config.FilterMode(in.Mode)
+	out.BlackholingEnabled = (*bool)(unsafe.Pointer(in.BlackholingEnabled))
+	out.StaticFilterList = *(*[]config.Filter)(unsafe.Pointer(&in.StaticFilterList))
+	out.TagFilters = *(*[]config.TagFilter)(unsafe.Pointer(&in.TagFilters))
+	return nil
+}
+
+// Convert_v1alpha1_FilterProfile_To_config_FilterProfile is an autogenerated conversion function.
+func Convert_v1alpha1_FilterProfile_To_config_FilterProfile(in *FilterProfile, out *config.FilterProfile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FilterProfile_To_config_FilterProfile(in, out, s)
+}
+
+func autoConvert_config_FilterProfile_To_v1alpha1_FilterProfile(in *config.FilterProfile, out *FilterProfile, s conversion.Scope) error {
+	out.Name = in.Name
+	out.WorkerPools = *(*[]string)(unsafe.Pointer(&in.WorkerPools))
+	out.NodeSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NodeSelector))
+	out.Mode = FilterMode(in.Mode)
+	out.BlackholingEnabled = (*bool)(unsafe.Pointer(in.BlackholingEnabled))
+	out.StaticFilterList = *(*[]Filter)(unsafe.Pointer(&in.StaticFilterList))
+	out.TagFilters = *(*[]TagFilter)(unsafe.Pointer(&in.TagFilters))
+	return nil
+}
+
+// Convert_config_FilterProfile_To_v1alpha1_FilterProfile is an autogenerated conversion function.
+func Convert_config_FilterProfile_To_v1alpha1_FilterProfile(in *config.FilterProfile, out *FilterProfile, s conversion.Scope) error {
+	return autoConvert_config_FilterProfile_To_v1alpha1_FilterProfile(in, out, s)
+}
+
+func autoConvert_v1alpha1_LockedEntries_To_config_LockedEntries(in *LockedEntries, out *config.LockedEntries, s conversion.Scope) error {
+	out.Networks = *(*[]string)(unsafe.Pointer(&in.Networks))
+	out.Tags = *(*[]config.TagRequirement)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+// Convert_v1alpha1_LockedEntries_To_config_LockedEntries is an autogenerated conversion function.
+func Convert_v1alpha1_LockedEntries_To_config_LockedEntries(in *LockedEntries, out *config.LockedEntries, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LockedEntries_To_config_LockedEntries(in, out, s)
+}
+
+func autoConvert_config_LockedEntries_To_v1alpha1_LockedEntries(in *config.LockedEntries, out *LockedEntries, s conversion.Scope) error {
+	out.Networks = *(*[]string)(unsafe.Pointer(&in.Networks))
+	out.Tags = *(*[]TagRequirement)(unsafe.Pointer(&in.Tags))
+	return nil
+}
+
+// Convert_config_LockedEntries_To_v1alpha1_LockedEntries is an autogenerated conversion function.
+func Convert_config_LockedEntries_To_v1alpha1_LockedEntries(in *config.LockedEntries, out *LockedEntries, s conversion.Scope) error {
+	return autoConvert_config_LockedEntries_To_v1alpha1_LockedEntries(in, out, s)
+}
+
+func autoConvert_v1alpha1_MergeStrategyLimits_To_config_MergeStrategyLimits(in *MergeStrategyLimits, out *config.MergeStrategyLimits, s conversion.Scope) error {
+	out.Project = config.MergeStrategy(in.Project)
+	out.Shoot = config.MergeStrategy(in.Shoot)
+	return nil
+}
+
+// Convert_v1alpha1_MergeStrategyLimits_To_config_MergeStrategyLimits is an autogenerated conversion function.
+func Convert_v1alpha1_MergeStrategyLimits_To_config_MergeStrategyLimits(in *MergeStrategyLimits, out *config.MergeStrategyLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MergeStrategyLimits_To_config_MergeStrategyLimits(in, out, s)
+}
+
+func autoConvert_config_MergeStrategyLimits_To_v1alpha1_MergeStrategyLimits(in *config.MergeStrategyLimits, out *MergeStrategyLimits, s conversion.Scope) error {
+	out.Project = MergeStrategy(in.Project)
+	out.Shoot = MergeStrategy(in.Shoot)
+	return nil
+}
+
+// Convert_config_MergeStrategyLimits_To_v1alpha1_MergeStrategyLimits is an autogenerated conversion function.
+func Convert_config_MergeStrategyLimits_To_v1alpha1_MergeStrategyLimits(in *config.MergeStrategyLimits, out *MergeStrategyLimits, s conversion.Scope) error {
+	return autoConvert_config_MergeStrategyLimits_To_v1alpha1_MergeStrategyLimits(in, out, s)
+}
+
+func autoConvert_v1alpha1_PortRange_To_config_PortRange(in *PortRange, out *config.PortRange, s conversion.Scope) error {
+	out.Port = in.Port
+	out.EndPort = (*int32)(unsafe.Pointer(in.EndPort))
+	return nil
+}
+
+// Convert_v1alpha1_PortRange_To_config_PortRange is an autogenerated conversion function.
+func Convert_v1alpha1_PortRange_To_config_PortRange(in *PortRange, out *config.PortRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PortRange_To_config_PortRange(in, out, s)
+}
+
+func autoConvert_config_PortRange_To_v1alpha1_PortRange(in *config.PortRange, out *PortRange, s conversion.Scope) error {
+	out.Port = in.Port
+	out.EndPort = (*int32)(unsafe.Pointer(in.EndPort))
+	return nil
+}
+
+// Convert_config_PortRange_To_v1alpha1_PortRange is an autogenerated conversion function.
+func Convert_config_PortRange_To_v1alpha1_PortRange(in *config.PortRange, out *PortRange, s conversion.Scope) error {
+	return autoConvert_config_PortRange_To_v1alpha1_PortRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProfileStatus_To_config_ProfileStatus(in *ProfileStatus, out *config.ProfileStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Checksum = in.Checksum
+	out.IPv4Entries = in.IPv4Entries
+	out.IPv6Entries = in.IPv6Entries
+	return nil
+}
+
+// Convert_v1alpha1_ProfileStatus_To_config_ProfileStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ProfileStatus_To_config_ProfileStatus(in *ProfileStatus, out *config.ProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProfileStatus_To_config_ProfileStatus(in, out, s)
+}
+
+func autoConvert_config_ProfileStatus_To_v1alpha1_ProfileStatus(in *config.ProfileStatus, out *ProfileStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Checksum = in.Checksum
+	out.IPv4Entries = in.IPv4Entries
+	out.IPv6Entries = in.IPv6Entries
+	return nil
+}
+
+// Convert_config_ProfileStatus_To_v1alpha1_ProfileStatus is an autogenerated conversion function.
+func Convert_config_ProfileStatus_To_v1alpha1_ProfileStatus(in *config.ProfileStatus, out *ProfileStatus, s conversion.Scope) error {
+	return autoConvert_config_ProfileStatus_To_v1alpha1_ProfileStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ProjectPermission_To_config_ProjectPermission(in *ProjectPermission, out *config.ProjectPermission, s conversion.Scope) error {
+	out.Permission = config.Permission(in.Permission)
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ProjectPermission_To_config_ProjectPermission is an autogenerated conversion function.
+func Convert_v1alpha1_ProjectPermission_To_config_ProjectPermission(in *ProjectPermission, out *config.ProjectPermission, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProjectPermission_To_config_ProjectPermission(in, out, s)
+}
+
+func autoConvert_config_ProjectPermission_To_v1alpha1_ProjectPermission(in *config.ProjectPermission, out *ProjectPermission, s conversion.Scope) error {
+	out.Permission = Permission(in.Permission)
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_config_ProjectPermission_To_v1alpha1_ProjectPermission is an autogenerated conversion function.
+func Convert_config_ProjectPermission_To_v1alpha1_ProjectPermission(in *config.ProjectPermission, out *ProjectPermission, s conversion.Scope) error {
+	return autoConvert_config_ProjectPermission_To_v1alpha1_ProjectPermission(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretRef_To_config_SecretRef(in *SecretRef, out *config.SecretRef, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
+	out.Format = config.FilterListFormat(in.Format)
+	out.SignatureKey = in.SignatureKey
 	out.Namespace = in.Namespace
+	out.MergeStrategy = config.MergeStrategy(in.MergeStrategy)
 	return nil
 }
 
@@ -262,7 +945,10 @@ func Convert_v1alpha1_SecretRef_To_config_SecretRef(in *SecretRef, out *config.S
 func autoConvert_config_SecretRef_To_v1alpha1_SecretRef(in *config.SecretRef, out *SecretRef, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
+	out.Format = FilterListFormat(in.Format)
+	out.SignatureKey = in.SignatureKey
 	out.Namespace = in.Namespace
+	out.MergeStrategy = MergeStrategy(in.MergeStrategy)
 	return nil
 }
 
@@ -271,6 +957,68 @@ func Convert_config_SecretRef_To_v1alpha1_SecretRef(in *config.SecretRef, out *S
 	return autoConvert_config_SecretRef_To_v1alpha1_SecretRef(in, out, s)
 }
 
+func autoConvert_v1alpha1_SignatureVerification_To_config_SignatureVerification(in *SignatureVerification, out *config.SignatureVerification, s conversion.Scope) error {
+	out.TrustedKeys = *(*[]config.TrustedKey)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_v1alpha1_SignatureVerification_To_config_SignatureVerification is an autogenerated conversion function.
+func Convert_v1alpha1_SignatureVerification_To_config_SignatureVerification(in *SignatureVerification, out *config.SignatureVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SignatureVerification_To_config_SignatureVerification(in, out, s)
+}
+
+func autoConvert_config_SignatureVerification_To_v1alpha1_SignatureVerification(in *config.SignatureVerification, out *SignatureVerification, s conversion.Scope) error {
+	out.TrustedKeys = *(*[]TrustedKey)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_config_SignatureVerification_To_v1alpha1_SignatureVerification is an autogenerated conversion function.
+func Convert_config_SignatureVerification_To_v1alpha1_SignatureVerification(in *config.SignatureVerification, out *SignatureVerification, s conversion.Scope) error {
+	return autoConvert_config_SignatureVerification_To_v1alpha1_SignatureVerification(in, out, s)
+}
+
+func autoConvert_v1alpha1_SignatureVerificationFailure_To_config_SignatureVerificationFailure(in *SignatureVerificationFailure, out *config.SignatureVerificationFailure, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1alpha1_SignatureVerificationFailure_To_config_SignatureVerificationFailure is an autogenerated conversion function.
+func Convert_v1alpha1_SignatureVerificationFailure_To_config_SignatureVerificationFailure(in *SignatureVerificationFailure, out *config.SignatureVerificationFailure, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SignatureVerificationFailure_To_config_SignatureVerificationFailure(in, out, s)
+}
+
+func autoConvert_config_SignatureVerificationFailure_To_v1alpha1_SignatureVerificationFailure(in *config.SignatureVerificationFailure, out *SignatureVerificationFailure, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_config_SignatureVerificationFailure_To_v1alpha1_SignatureVerificationFailure is an autogenerated conversion function.
+func Convert_config_SignatureVerificationFailure_To_v1alpha1_SignatureVerificationFailure(in *config.SignatureVerificationFailure, out *SignatureVerificationFailure, s conversion.Scope) error {
+	return autoConvert_config_SignatureVerificationFailure_To_v1alpha1_SignatureVerificationFailure(in, out, s)
+}
+
+func autoConvert_v1alpha1_SignatureVerificationStatus_To_config_SignatureVerificationStatus(in *SignatureVerificationStatus, out *config.SignatureVerificationStatus, s conversion.Scope) error {
+	out.Failures = *(*[]config.SignatureVerificationFailure)(unsafe.Pointer(&in.Failures))
+	return nil
+}
+
+// Convert_v1alpha1_SignatureVerificationStatus_To_config_SignatureVerificationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_SignatureVerificationStatus_To_config_SignatureVerificationStatus(in *SignatureVerificationStatus, out *config.SignatureVerificationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SignatureVerificationStatus_To_config_SignatureVerificationStatus(in, out, s)
+}
+
+func autoConvert_config_SignatureVerificationStatus_To_v1alpha1_SignatureVerificationStatus(in *config.SignatureVerificationStatus, out *SignatureVerificationStatus, s conversion.Scope) error {
+	out.Failures = *(*[]SignatureVerificationFailure)(unsafe.Pointer(&in.Failures))
+	return nil
+}
+
+// Convert_config_SignatureVerificationStatus_To_v1alpha1_SignatureVerificationStatus is an autogenerated conversion function.
+func Convert_config_SignatureVerificationStatus_To_v1alpha1_SignatureVerificationStatus(in *config.SignatureVerificationStatus, out *SignatureVerificationStatus, s conversion.Scope) error {
+	return autoConvert_config_SignatureVerificationStatus_To_v1alpha1_SignatureVerificationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Tag_To_config_Tag(in *Tag, out *config.Tag, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
@@ -296,7 +1044,13 @@ func Convert_config_Tag_To_v1alpha1_Tag(in *config.Tag, out *Tag, s conversion.S
 func autoConvert_v1alpha1_TagFilter_To_config_TagFilter(in *TagFilter, out *config.TagFilter, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.Operator = config.TagOperator(in.Operator)
+	out.Requirements = *(*[]config.TagRequirement)(unsafe.Pointer(&in.Requirements))
+	out.Match = config.TagMatch(in.Match)
+	out.Action = config.TagFilterAction(in.Action)
+	out.Priority = in.Priority
 	out.Policy = (*config.Policy)(unsafe.Pointer(in.Policy))
+	out.EnforcementMode = config.EnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -308,7 +1062,13 @@ func Convert_v1alpha1_TagFilter_To_config_TagFilter(in *TagFilter, out *config.T
 func autoConvert_config_TagFilter_To_v1alpha1_TagFilter(in *config.TagFilter, out *TagFilter, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.Operator = TagOperator(in.Operator)
+	out.Requirements = *(*[]TagRequirement)(unsafe.Pointer(&in.Requirements))
+	out.Match = TagMatch(in.Match)
+	out.Action = TagFilterAction(in.Action)
+	out.Priority = in.Priority
 	out.Policy = (*Policy)(unsafe.Pointer(in.Policy))
+	out.EnforcementMode = EnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -317,6 +1077,52 @@ func Convert_config_TagFilter_To_v1alpha1_TagFilter(in *config.TagFilter, out *T
 	return autoConvert_config_TagFilter_To_v1alpha1_TagFilter(in, out, s)
 }
 
+func autoConvert_v1alpha1_TagRequirement_To_config_TagRequirement(in *TagRequirement, out *config.TagRequirement, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Operator = config.TagOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1alpha1_TagRequirement_To_config_TagRequirement is an autogenerated conversion function.
+func Convert_v1alpha1_TagRequirement_To_config_TagRequirement(in *TagRequirement, out *config.TagRequirement, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TagRequirement_To_config_TagRequirement(in, out, s)
+}
+
+func autoConvert_config_TagRequirement_To_v1alpha1_TagRequirement(in *config.TagRequirement, out *TagRequirement, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Operator = TagOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_config_TagRequirement_To_v1alpha1_TagRequirement is an autogenerated conversion function.
+func Convert_config_TagRequirement_To_v1alpha1_TagRequirement(in *config.TagRequirement, out *TagRequirement, s conversion.Scope) error {
+	return autoConvert_config_TagRequirement_To_v1alpha1_TagRequirement(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrustedKey_To_config_TrustedKey(in *TrustedKey, out *config.TrustedKey, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PublicKey = in.PublicKey
+	return nil
+}
+
+// Convert_v1alpha1_TrustedKey_To_config_TrustedKey is an autogenerated conversion function.
+func Convert_v1alpha1_TrustedKey_To_config_TrustedKey(in *TrustedKey, out *config.TrustedKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrustedKey_To_config_TrustedKey(in, out, s)
+}
+
+func autoConvert_config_TrustedKey_To_v1alpha1_TrustedKey(in *config.TrustedKey, out *TrustedKey, s conversion.Scope) error {
+	out.Name = in.Name
+	out.PublicKey = in.PublicKey
+	return nil
+}
+
+// Convert_config_TrustedKey_To_v1alpha1_TrustedKey is an autogenerated conversion function.
+func Convert_config_TrustedKey_To_v1alpha1_TrustedKey(in *config.TrustedKey, out *TrustedKey, s conversion.Scope) error {
+	return autoConvert_config_TrustedKey_To_v1alpha1_TrustedKey(in, out, s)
+}
+
 func autoConvert_v1alpha1_Workers_To_config_Workers(in *Workers, out *config.Workers, s conversion.Scope) error {
 	out.BlackholingEnabled = in.BlackholingEnabled
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
@@ -338,3 +1144,47 @@ func autoConvert_config_Workers_To_v1alpha1_Workers(in *config.Workers, out *Wor
 func Convert_config_Workers_To_v1alpha1_Workers(in *config.Workers, out *Workers, s conversion.Scope) error {
 	return autoConvert_config_Workers_To_v1alpha1_Workers(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkloadSelector_To_config_WorkloadSelector(in *WorkloadSelector, out *config.WorkloadSelector, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.PodSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PodSelector))
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadSelector_To_config_WorkloadSelector is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadSelector_To_config_WorkloadSelector(in *WorkloadSelector, out *config.WorkloadSelector, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadSelector_To_config_WorkloadSelector(in, out, s)
+}
+
+func autoConvert_config_WorkloadSelector_To_v1alpha1_WorkloadSelector(in *config.WorkloadSelector, out *WorkloadSelector, s conversion.Scope) error {
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.PodSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PodSelector))
+	return nil
+}
+
+// Convert_config_WorkloadSelector_To_v1alpha1_WorkloadSelector is an autogenerated conversion function.
+func Convert_config_WorkloadSelector_To_v1alpha1_WorkloadSelector(in *config.WorkloadSelector, out *WorkloadSelector, s conversion.Scope) error {
+	return autoConvert_config_WorkloadSelector_To_v1alpha1_WorkloadSelector(in, out, s)
+}
+
+func autoConvert_v1alpha1_Workloads_To_config_Workloads(in *Workloads, out *config.Workloads, s conversion.Scope) error {
+	out.Filtered = *(*[]config.WorkloadSelector)(unsafe.Pointer(&in.Filtered))
+	out.Exempted = *(*[]config.WorkloadSelector)(unsafe.Pointer(&in.Exempted))
+	return nil
+}
+
+// Convert_v1alpha1_Workloads_To_config_Workloads is an autogenerated conversion function.
+func Convert_v1alpha1_Workloads_To_config_Workloads(in *Workloads, out *config.Workloads, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Workloads_To_config_Workloads(in, out, s)
+}
+
+func autoConvert_config_Workloads_To_v1alpha1_Workloads(in *config.Workloads, out *Workloads, s conversion.Scope) error {
+	out.Filtered = *(*[]WorkloadSelector)(unsafe.Pointer(&in.Filtered))
+	out.Exempted = *(*[]WorkloadSelector)(unsafe.Pointer(&in.Exempted))
+	return nil
+}
+
+// Convert_config_Workloads_To_v1alpha1_Workloads is an autogenerated conversion function.
+func Convert_config_Workloads_To_v1alpha1_Workloads(in *config.Workloads, out *Workloads, s conversion.Scope) error {
+	return autoConvert_config_Workloads_To_v1alpha1_Workloads(in, out, s)
+}
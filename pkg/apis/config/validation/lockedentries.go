@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// ValidateLockedEntries validates that the shoot configuration does not attempt to allow access to the entries locked
+// by the extension configuration, i.e. that no `ALLOW_ACCESS` entry of its static filter lists overlaps a locked
+// network and that none of its tag filters allows or excludes entries with a locked tag. Entries of filter list
+// sources cannot be validated on admission, the actuator keeps them from unblocking locked entries.
+func ValidateLockedEntries(egressFilter *config.EgressFilter, lockedEntries *config.LockedEntries, fldPath *field.Path) field.ErrorList {
+	if egressFilter == nil || lockedEntries == nil {
+		return nil
+	}
+
+	var lockedNetworks []*net.IPNet
+	for _, network := range lockedEntries.Networks {
+		if _, ipNet, err := net.ParseCIDR(network); err == nil {
+			lockedNetworks = append(lockedNetworks, ipNet)
+		}
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateLockedNetworks(egressFilter.StaticFilterList, lockedNetworks, fldPath.Child("staticFilterList"))...)
+	allErrs = append(allErrs, validateLockedTags(egressFilter.TagFilters, lockedEntries.Tags, fldPath.Child("tagFilters"))...)
+	for index, profile := range egressFilter.Profiles {
+		profilePath := fldPath.Child("profiles").Index(index)
+		allErrs = append(allErrs, validateLockedNetworks(profile.StaticFilterList, lockedNetworks, profilePath.Child("staticFilterList"))...)
+		allErrs = append(allErrs, validateLockedTags(profile.TagFilters, lockedEntries.Tags, profilePath.Child("tagFilters"))...)
+	}
+	return allErrs
+}
+
+// validateLockedNetworks forbids unscoped `ALLOW_ACCESS` entries overlapping locked networks. Port- and
+// protocol-scoped entries only carve out of port- and protocol-scoped entries and cannot unblock locked networks.
+func validateLockedNetworks(staticFilterList []config.Filter, lockedNetworks []*net.IPNet, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for index, filter := range staticFilterList {
+		if filter.Policy != config.PolicyAllowAccess || filter.Protocol != "" || filter.Network == "" {
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(filter.Network)
+		if err != nil {
+			continue
+		}
+		for _, locked := range lockedNetworks {
+			if ipNet.Contains(locked.IP) || locked.Contains(ipNet.IP) {
+				allErrs = append(allErrs, field.Forbidden(
+					fldPath.Index(index).Child("network"),
+					fmt.Sprintf("access to locked network %s cannot be allowed", locked.String()),
+				))
+				break
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateLockedTags forbids tag filters allowing or excluding entries with a locked tag, i.e. tag filters with policy
+// `ALLOW_ACCESS` or action `exclude` requiring any of the values of a locked tag.
+func validateLockedTags(tagFilters []config.TagFilter, lockedTags []config.TagRequirement, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for index, tagFilter := range tagFilters {
+		if tagFilter.Action != config.TagFilterActionExclude && (tagFilter.Policy == nil || *tagFilter.Policy != config.PolicyAllowAccess) {
+			continue
+		}
+		requirements := tagFilter.Requirements
+		if tagFilter.Name != "" {
+			requirements = append([]config.TagRequirement{{Name: tagFilter.Name, Operator: tagFilter.Operator, Values: tagFilter.Values}}, requirements...)
+		}
+		if name, ok := lockedTagRequired(requirements, lockedTags); ok {
+			allErrs = append(allErrs, field.Forbidden(
+				fldPath.Index(index),
+				fmt.Sprintf("entries with locked tag %s cannot be allowed or excluded", name),
+			))
+		}
+	}
+	return allErrs
+}
+
+// lockedTagRequired returns the name of the locked tag if any of the requirements requires one of its values.
+func lockedTagRequired(requirements, lockedTags []config.TagRequirement) (string, bool) {
+	for _, requirement := range requirements {
+		if requirement.Operator == config.TagOperatorNotIn {
+			continue
+		}
+		for _, locked := range lockedTags {
+			if locked.Operator != config.TagOperatorNotIn && locked.Name == requirement.Name &&
+				slices.ContainsFunc(requirement.Values, func(value string) bool { return slices.Contains(locked.Values, value) }) {
+				return locked.Name, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Locked Entries Validation", func() {
+	lockedEntries := &config.LockedEntries{
+		Networks: []string{"192.0.2.0/24", "2001:db8::/32"},
+		Tags:     []config.TagRequirement{{Name: "category", Values: []string{"malware", "botnet"}}},
+	}
+
+	DescribeTable("#ValidateLockedEntries",
+		func(egressFilter *config.EgressFilter, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateLockedEntries(egressFilter, lockedEntries, field.NewPath("egressFilter"))).To(matcher)
+		},
+
+		Entry("should succeed without egress filter", nil, BeEmpty()),
+		Entry("should succeed with entries not allowing locked networks",
+			&config.EgressFilter{
+				StaticFilterList: []config.Filter{
+					{Network: "192.0.2.0/25", Policy: config.PolicyBlockAccess},
+					{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess},
+					{Network: "0.0.0.0/0", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP},
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("should return error for allowed networks containing or within locked networks",
+			&config.EgressFilter{
+				StaticFilterList: []config.Filter{
+					{Network: "0.0.0.0/0", Policy: config.PolicyAllowAccess},
+					{Network: "2001:db8:1::/48", Policy: config.PolicyAllowAccess},
+				},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("egressFilter.staticFilterList[0].network"),
+					"Detail": Equal("access to locked network 192.0.2.0/24 cannot be allowed"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("egressFilter.staticFilterList[1].network"),
+				})),
+			),
+		),
+		Entry("should succeed with tag filters not allowing locked tags",
+			&config.EgressFilter{
+				TagFilters: []config.TagFilter{
+					{Name: "category", Values: []string{"malware"}, Policy: new(config.PolicyBlockAccess)},
+					{Name: "category", Values: []string{"adware"}, Policy: new(config.PolicyAllowAccess)},
+					{Name: "category", Operator: config.TagOperatorNotIn, Values: []string{"malware"}, Action: config.TagFilterActionExclude},
+				},
+			},
+			BeEmpty(),
+		),
+		Entry("should return error for tag filters allowing or excluding locked tags",
+			&config.EgressFilter{
+				TagFilters: []config.TagFilter{
+					{Name: "category", Values: []string{"malware"}, Policy: new(config.PolicyAllowAccess)},
+					{Requirements: []config.TagRequirement{{Name: "category", Values: []string{"botnet"}}}, Action: config.TagFilterActionExclude},
+				},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("egressFilter.tagFilters[0]"),
+					"Detail": Equal("entries with locked tag category cannot be allowed or excluded"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("egressFilter.tagFilters[1]"),
+				})),
+			),
+		),
+		Entry("should return error for profiles allowing locked entries",
+			&config.EgressFilter{
+				Profiles: []config.FilterProfile{{
+					Name:             "gpu",
+					StaticFilterList: []config.Filter{{Network: "192.0.2.128/25", Policy: config.PolicyAllowAccess}},
+					TagFilters:       []config.TagFilter{{Name: "category", Values: []string{"malware"}, Action: config.TagFilterActionExclude}},
+				}},
+			},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("egressFilter.profiles[0].staticFilterList[0].network")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("egressFilter.profiles[0].tagFilters[0]")})),
+			),
+		),
+	)
+})
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"slices"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// supportedPermissions are the permissions which can be restricted to selected projects.
+var supportedPermissions = []config.Permission{
+	config.PermissionAllowEntries,
+	config.PermissionCustomSources,
+	config.PermissionTagPolicyOverrides,
+	config.PermissionOptOut,
+}
+
+// ValidateProjectPermissions validates the project permission rules of the extension configuration.
+func ValidateProjectPermissions(projectPermissions []config.ProjectPermission, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for index, rule := range projectPermissions {
+		idxPath := fldPath.Index(index)
+		if !slices.Contains(supportedPermissions, rule.Permission) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("permission"), rule.Permission, supportedPermissions))
+		}
+		for i, namespace := range rule.Namespaces {
+			for _, msg := range validation.IsDNS1123Label(namespace) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("namespaces").Index(i), namespace, msg))
+			}
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(rule.ProjectSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("projectSelector"))...)
+	}
+	return allErrs
+}
+
+// PermissionUsage is an override of the configuration of a shoot requiring a permission.
+type PermissionUsage struct {
+	// Path is the field path of the override.
+	Path *field.Path
+	// Value is the filter entry, tag filter or filter list source of the override.
+	Value any
+}
+
+// PermissionUsages returns the overrides of the egress filter configuration of a shoot requiring a permission, i.e.
+// the `ALLOW_ACCESS` entries of its static filter lists, its filter list sources, its tag filters overriding the
+// policy or enforcement mode or dropping entries, and the settings exempting traffic from the egress filter: the mode
+// `blockList`, the enforcement mode `audit` and the selection of workloads. Disabling the extension is not part of
+// the egress filter configuration and is left to the caller.
+func PermissionUsages(egressFilter *config.EgressFilter, fldPath *field.Path) map[config.Permission][]PermissionUsage {
+	usages := map[config.Permission][]PermissionUsage{}
+	if egressFilter == nil {
+		return usages
+	}
+	addUsage := func(permission config.Permission, fldPath *field.Path, value any) {
+		usages[permission] = append(usages[permission], PermissionUsage{Path: fldPath, Value: value})
+	}
+
+	addFilterListUsages := func(staticFilterList []config.Filter, tagFilters []config.TagFilter, mode config.FilterMode, fldPath *field.Path) {
+		for index, filter := range staticFilterList {
+			if filter.Policy == config.PolicyAllowAccess {
+				addUsage(config.PermissionAllowEntries, fldPath.Child("staticFilterList").Index(index).Child("policy"), filter)
+			}
+		}
+		for index, tagFilter := range tagFilters {
+			idxPath := fldPath.Child("tagFilters").Index(index)
+			if tagFilter.Policy != nil {
+				addUsage(config.PermissionTagPolicyOverrides, idxPath.Child("policy"), tagFilter)
+			}
+			// Included entries drop all other ones as well
+			if tagFilter.Action == config.TagFilterActionExclude || tagFilter.Action == config.TagFilterActionInclude {
+				addUsage(config.PermissionTagPolicyOverrides, idxPath.Child("action"), tagFilter)
+			}
+			if tagFilter.EnforcementMode == config.EnforcementModeAudit {
+				addUsage(config.PermissionTagPolicyOverrides, idxPath.Child("enforcementMode"), tagFilter)
+			}
+		}
+		if mode == config.FilterModeBlockList {
+			addUsage(config.PermissionOptOut, fldPath.Child("mode"), mode)
+		}
+	}
+
+	addFilterListUsages(egressFilter.StaticFilterList, egressFilter.TagFilters, egressFilter.Mode, fldPath)
+	for index, profile := range egressFilter.Profiles {
+		addFilterListUsages(profile.StaticFilterList, profile.TagFilters, profile.Mode, fldPath.Child("profiles").Index(index))
+	}
+	if egressFilter.ProjectFilterListSource != nil {
+		addUsage(config.PermissionCustomSources, fldPath.Child("projectFilterListSource"), *egressFilter.ProjectFilterListSource)
+	}
+	if egressFilter.ShootFilterListSource != nil {
+		addUsage(config.PermissionCustomSources, fldPath.Child("shootFilterListSource"), *egressFilter.ShootFilterListSource)
+	}
+	if egressFilter.EnforcementMode == config.EnforcementModeAudit {
+		addUsage(config.PermissionOptOut, fldPath.Child("enforcementMode"), egressFilter.EnforcementMode)
+	}
+	if egressFilter.Workloads != nil {
+		for index, selector := range egressFilter.Workloads.Filtered {
+			addUsage(config.PermissionOptOut, fldPath.Child("workloads", "filtered").Index(index), selector)
+		}
+		for index, selector := range egressFilter.Workloads.Exempted {
+			addUsage(config.PermissionOptOut, fldPath.Child("workloads", "exempted").Index(index), selector)
+		}
+	}
+	return usages
+}
+
+// Used returns true if the usage is contained in the given usages with the same field path and value.
+func (u PermissionUsage) Used(usages []PermissionUsage) bool {
+	return slices.ContainsFunc(usages, func(usage PermissionUsage) bool {
+		return usage.Path.String() == u.Path.String() && apiequality.Semantic.DeepEqual(usage.Value, u.Value)
+	})
+}
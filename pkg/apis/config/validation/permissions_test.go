@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Project Permissions Validation", func() {
+	DescribeTable("#ValidateProjectPermissions",
+		func(projectPermissions []config.ProjectPermission, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateProjectPermissions(projectPermissions, field.NewPath("projectPermissions"))).To(matcher)
+		},
+
+		Entry("should succeed without rules", nil, BeEmpty()),
+		Entry("should succeed with valid rules",
+			[]config.ProjectPermission{
+				{Permission: config.PermissionAllowEntries, Namespaces: []string{"garden-dev"}},
+				{Permission: config.PermissionOptOut, ProjectSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"stage": "dev"}}},
+			},
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported permission",
+			[]config.ProjectPermission{{Permission: "disableAll", Namespaces: []string{"garden-dev"}}},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("projectPermissions[0].permission"),
+				})),
+			),
+		),
+		Entry("should return error for invalid namespaces and selectors",
+			[]config.ProjectPermission{{
+				Permission:      config.PermissionCustomSources,
+				Namespaces:      []string{"Garden_Dev"},
+				ProjectSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "stage", Operator: "Unknown"}}},
+			}},
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("projectPermissions[0].namespaces[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("projectPermissions[0].projectSelector.matchExpressions[0].operator")})),
+			),
+		),
+	)
+
+	Describe("#PermissionUsages", func() {
+		It("should not return usages without egress filter", func() {
+			Expect(PermissionUsages(nil, field.NewPath("egressFilter"))).To(BeEmpty())
+		})
+
+		It("should return the field paths of the overrides requiring a permission", func() {
+			usages := PermissionUsages(&config.EgressFilter{
+				StaticFilterList: []config.Filter{
+					{Network: "192.0.2.0/24", Policy: config.PolicyBlockAccess},
+					{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess},
+				},
+				TagFilters: []config.TagFilter{
+					{Name: "category", Values: []string{"malware"}},
+					{Name: "category", Values: []string{"adware"}, Policy: new(config.PolicyAllowAccess)},
+				},
+				Profiles: []config.FilterProfile{{
+					Name:             "gpu",
+					StaticFilterList: []config.Filter{{Network: "203.0.113.0/24", Policy: config.PolicyAllowAccess}},
+				}},
+				ShootFilterListSource: &config.SecretRef{Name: "filter-list", Key: "filterList"},
+			}, field.NewPath("egressFilter"))
+
+			paths := map[config.Permission][]string{}
+			for permission, permissionUsages := range usages {
+				paths[permission] = usagePaths(permissionUsages)
+			}
+			Expect(paths).To(Equal(map[config.Permission][]string{
+				config.PermissionAllowEntries:       {"egressFilter.staticFilterList[1].policy", "egressFilter.profiles[0].staticFilterList[0].policy"},
+				config.PermissionTagPolicyOverrides: {"egressFilter.tagFilters[1].policy"},
+				config.PermissionCustomSources:      {"egressFilter.shootFilterListSource"},
+			}))
+			Expect(usages[config.PermissionAllowEntries][0].Value).To(Equal(config.Filter{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess}))
+			Expect(usages[config.PermissionCustomSources][0].Value).To(Equal(config.SecretRef{Name: "filter-list", Key: "filterList"}))
+		})
+
+		It("should return the tag filters dropping or auditing entries as tag policy overrides", func() {
+			usages := PermissionUsages(&config.EgressFilter{
+				TagFilters: []config.TagFilter{
+					{Name: "category", Values: []string{"malware"}, Action: config.TagFilterActionOverride},
+					{Name: "category", Values: []string{"adware"}, Action: config.TagFilterActionExclude},
+					{Name: "category", Values: []string{"botnet"}, Action: config.TagFilterActionInclude},
+					{Name: "category", Values: []string{"phishing"}, EnforcementMode: config.EnforcementModeAudit},
+					{Name: "category", Values: []string{"spam"}, EnforcementMode: config.EnforcementModeEnforce},
+				},
+				Profiles: []config.FilterProfile{{
+					Name:       "gpu",
+					TagFilters: []config.TagFilter{{Name: "category", Values: []string{"adware"}, Action: config.TagFilterActionExclude}},
+				}},
+			}, field.NewPath("egressFilter"))
+
+			Expect(usagePaths(usages[config.PermissionTagPolicyOverrides])).To(Equal([]string{
+				"egressFilter.tagFilters[1].action",
+				"egressFilter.tagFilters[2].action",
+				"egressFilter.tagFilters[3].enforcementMode",
+				"egressFilter.profiles[0].tagFilters[0].action",
+			}))
+			Expect(usages[config.PermissionTagPolicyOverrides][0].Value).To(Equal(config.TagFilter{Name: "category", Values: []string{"adware"}, Action: config.TagFilterActionExclude}))
+		})
+
+		It("should return the settings exempting traffic from the egress filter as opting out", func() {
+			exempted := config.WorkloadSelector{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "scanner"}}}
+			usages := PermissionUsages(&config.EgressFilter{
+				Mode:            config.FilterModeBlockList,
+				EnforcementMode: config.EnforcementModeAudit,
+				Workloads: &config.Workloads{
+					Filtered: []config.WorkloadSelector{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "web"}}}},
+					Exempted: []config.WorkloadSelector{exempted},
+				},
+				Profiles: []config.FilterProfile{
+					{Name: "gpu", Mode: config.FilterModeBlockList},
+					{Name: "payment", Mode: config.FilterModeAllowList},
+				},
+			}, field.NewPath("egressFilter"))
+
+			Expect(usagePaths(usages[config.PermissionOptOut])).To(Equal([]string{
+				"egressFilter.mode",
+				"egressFilter.profiles[0].mode",
+				"egressFilter.enforcementMode",
+				"egressFilter.workloads.filtered[0]",
+				"egressFilter.workloads.exempted[0]",
+			}))
+			Expect(usages[config.PermissionOptOut][4].Value).To(Equal(exempted))
+		})
+
+		It("should not return the enforcing settings", func() {
+			Expect(PermissionUsages(&config.EgressFilter{
+				Mode:            config.FilterModeAllowList,
+				EnforcementMode: config.EnforcementModeEnforce,
+				Profiles:        []config.FilterProfile{{Name: "gpu"}},
+			}, field.NewPath("egressFilter"))).To(BeEmpty())
+		})
+
+		It("should only consider usages with the same field path and value as used", func() {
+			fldPath := field.NewPath("egressFilter", "staticFilterList").Index(0).Child("policy")
+			usage := PermissionUsage{Path: fldPath, Value: config.Filter{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess}}
+
+			Expect(usage.Used(nil)).To(BeFalse())
+			Expect(usage.Used([]PermissionUsage{usage})).To(BeTrue())
+			Expect(usage.Used([]PermissionUsage{{Path: fldPath, Value: config.Filter{Network: "203.0.113.0/24", Policy: config.PolicyAllowAccess}}})).To(BeFalse())
+			Expect(usage.Used([]PermissionUsage{{Path: field.NewPath("egressFilter", "staticFilterList").Index(1).Child("policy"), Value: usage.Value}})).To(BeFalse())
+		})
+	})
+})
+
+// usagePaths returns the field paths of the given permission usages.
+func usagePaths(usages []PermissionUsage) []string {
+	var paths []string
+	for _, usage := range usages {
+		paths = append(paths, usage.Path.String())
+	}
+	return paths
+}
@@ -8,14 +8,85 @@ import (
 	"fmt"
 	"net"
 	"slices"
+	"strings"
 
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 )
 
+// supportedProtocols are the protocols supported for port- and protocol-scoped filter entries.
+var supportedProtocols = []config.Protocol{
+	config.ProtocolTCP,
+	config.ProtocolUDP,
+	config.ProtocolSCTP,
+}
+
+// supportedFilterModes are the supported modes of the egress filter.
+var supportedFilterModes = []config.FilterMode{
+	config.FilterModeBlockList,
+	config.FilterModeAllowList,
+}
+
+// supportedPolicyEvaluations are the supported evaluations of overlapping entries of the egress filter.
+var supportedPolicyEvaluations = []config.PolicyEvaluation{
+	config.PolicyEvaluationAllowPrecedence,
+	config.PolicyEvaluationLongestPrefixMatch,
+}
+
+// supportedEnforcementModes are the supported enforcement modes of the egress filter.
+var supportedEnforcementModes = []config.EnforcementMode{
+	config.EnforcementModeEnforce,
+	config.EnforcementModeAudit,
+}
+
+// supportedTagOperators are the supported operators of tag requirements.
+var supportedTagOperators = []config.TagOperator{
+	config.TagOperatorIn,
+	config.TagOperatorNotIn,
+}
+
+// supportedTagMatches are the supported ways to combine the requirements of tag filters.
+var supportedTagMatches = []config.TagMatch{
+	config.TagMatchAll,
+	config.TagMatchAny,
+}
+
+// supportedTagFilterActions are the supported actions of tag filters.
+var supportedTagFilterActions = []config.TagFilterAction{
+	config.TagFilterActionOverride,
+	config.TagFilterActionInclude,
+	config.TagFilterActionExclude,
+}
+
+// supportedEnforcementBackends are the supported enforcement backends of the egress filter.
+var supportedEnforcementBackends = []config.EnforcementBackend{
+	config.EnforcementBackendAuto,
+	config.EnforcementBackendApplier,
+	config.EnforcementBackendCilium,
+	config.EnforcementBackendCalico,
+}
+
+// supportedMergeStrategies are the supported merge strategies of filter list sources.
+var supportedMergeStrategies = []config.MergeStrategy{
+	config.MergeStrategyReplace,
+	config.MergeStrategyMerge,
+	config.MergeStrategyAppendBlockOnly,
+}
+
+// supportedFilterListFormats are the formats supported for filter lists in secrets.
+var supportedFilterListFormats = []config.FilterListFormat{
+	config.FilterListFormatJSON,
+	config.FilterListFormatText,
+	config.FilterListFormatCSV,
+	config.FilterListFormatSTIX,
+}
+
 func ValidateProviderConfig(config *config.Configuration, fldPath *field.Path) field.ErrorList {
 	if config == nil {
 		return nil
@@ -61,12 +132,69 @@ func validateEgressFilterConfig(egressFilter *config.EgressFilter, fldPath *fiel
 
 	if egressFilter.StaticFilterList != nil {
 		allErrs = append(allErrs, validateStaticFilterList(egressFilter.StaticFilterList, fldPath.Child("staticFilterList"))...)
+
+		switch {
+		case egressFilter.EnforcementBackend == config.EnforcementBackendApplier:
+			allErrs = append(allErrs, validateApplierFilterScopes(egressFilter.StaticFilterList, fldPath.Child("staticFilterList"))...)
+		case egressFilter.BlackholingEnabled && (egressFilter.Workers == nil || egressFilter.Workers.BlackholingEnabled):
+			// Port- and protocol-scoped entries cannot be expressed by blackhole routes
+			for index, filter := range egressFilter.StaticFilterList {
+				if filter.Protocol != "" {
+					allErrs = append(allErrs, field.Forbidden(
+						fldPath.Child("staticFilterList").Index(index).Child("protocol"),
+						"port- and protocol-scoped entries are not supported with blackholing",
+					))
+				}
+			}
+		}
+	}
+
+	if egressFilter.Mode != "" && !slices.Contains(supportedFilterModes, egressFilter.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), egressFilter.Mode, supportedFilterModes))
+	}
+
+	if egressFilter.PolicyEvaluation != "" && !slices.Contains(supportedPolicyEvaluations, egressFilter.PolicyEvaluation) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policyEvaluation"), egressFilter.PolicyEvaluation, supportedPolicyEvaluations))
+	}
+
+	if egressFilter.EnforcementMode != "" && !slices.Contains(supportedEnforcementModes, egressFilter.EnforcementMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcementMode"), egressFilter.EnforcementMode, supportedEnforcementModes))
+	}
+
+	if egressFilter.EnforcementBackend != "" && !slices.Contains(supportedEnforcementBackends, egressFilter.EnforcementBackend) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcementBackend"), egressFilter.EnforcementBackend, supportedEnforcementBackends))
+	} else if egressFilter.EnforcementBackend != "" && egressFilter.EnforcementBackend != config.EnforcementBackendAuto {
+		allErrs = append(allErrs, validateAudit(egressFilter, egressFilter.EnforcementBackend, fldPath)...)
+	}
+
+	for index, tagFilter := range egressFilter.TagFilters {
+		allErrs = append(allErrs, validateTagFilter(tagFilter, fldPath.Child("tagFilters").Index(index))...)
+	}
+
+	// Port- and protocol-scoped entries cannot be expressed as exceptions of blocking all public networks
+	if egressFilter.Mode == config.FilterModeAllowList && egressFilter.EnforcementBackend != config.EnforcementBackendApplier {
+		for index, filter := range egressFilter.StaticFilterList {
+			if filter.Protocol != "" {
+				allErrs = append(allErrs, field.Forbidden(
+					fldPath.Child("staticFilterList").Index(index).Child("protocol"),
+					"port- and protocol-scoped entries are not supported in mode allowList",
+				))
+			}
+		}
 	}
 
 	if egressFilter.Workers != nil {
 		allErrs = append(allErrs, validateWorkersConfig(egressFilter.Workers, fldPath.Child("workers"))...)
 	}
 
+	if egressFilter.Workloads != nil {
+		allErrs = append(allErrs, validateWorkloads(egressFilter, fldPath.Child("workloads"))...)
+	}
+
+	if len(egressFilter.Profiles) > 0 {
+		allErrs = append(allErrs, validateProfiles(egressFilter, fldPath.Child("profiles"))...)
+	}
+
 	if egressFilter.DownloaderConfig != nil {
 		allErrs = append(allErrs, field.Invalid(
 			fldPath.Child("downloaderConfig"),
@@ -75,6 +203,22 @@ func validateEgressFilterConfig(egressFilter *config.EgressFilter, fldPath *fiel
 		))
 	}
 
+	if egressFilter.DownloadSources != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("downloadSources"),
+			egressFilter.DownloadSources,
+			"downloadSources is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.FilterListRollout != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("filterListRollout"),
+			egressFilter.FilterListRollout,
+			"filterListRollout is not supported in shoot configuration",
+		))
+	}
+
 	if egressFilter.EnsureConnectivity != nil {
 		allErrs = append(allErrs, field.Invalid(
 			fldPath.Child("ensureConnectivity"),
@@ -83,6 +227,62 @@ func validateEgressFilterConfig(egressFilter *config.EgressFilter, fldPath *fiel
 		))
 	}
 
+	if egressFilter.SignatureVerification != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("signatureVerification"),
+			egressFilter.SignatureVerification,
+			"signatureVerification is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.FQDNResolution != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("fqdnResolution"),
+			egressFilter.FQDNResolution,
+			"fqdnResolution is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.AllowListSafeguards != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("allowListSafeguards"),
+			egressFilter.AllowListSafeguards,
+			"allowListSafeguards is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.BlockedConnectionExporter != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("blockedConnectionExporter"),
+			egressFilter.BlockedConnectionExporter,
+			"blockedConnectionExporter is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.MergeStrategyLimits != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("mergeStrategyLimits"),
+			egressFilter.MergeStrategyLimits,
+			"mergeStrategyLimits is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.LockedEntries != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("lockedEntries"),
+			egressFilter.LockedEntries,
+			"lockedEntries is not supported in shoot configuration",
+		))
+	}
+
+	if egressFilter.ProjectPermissions != nil {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("projectPermissions"),
+			egressFilter.ProjectPermissions,
+			"projectPermissions is not supported in shoot configuration",
+		))
+	}
+
 	// Validate mutual exclusivity of projectFilterListSource and shootFilterListSource
 	if egressFilter.ProjectFilterListSource != nil && egressFilter.ShootFilterListSource != nil {
 		allErrs = append(allErrs, field.Invalid(
@@ -103,6 +303,214 @@ func validateEgressFilterConfig(egressFilter *config.EgressFilter, fldPath *fiel
 	return allErrs
 }
 
+// validateWorkloads validates the selection of the filtered pods. The egress filter applier filters the traffic of
+// whole nodes, so pods can only be selected with the network policies of the cilium and calico backends.
+func validateWorkloads(egressFilter *config.EgressFilter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if egressFilter.EnforcementBackend == config.EnforcementBackendApplier {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "workloads cannot be selected with enforcement backend applier"))
+	}
+	if egressFilter.BlackholingEnabled || egressFilter.Workers != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "workloads cannot be selected with blackholing or worker-specific block modes"))
+	}
+
+	for index, selector := range egressFilter.Workloads.Filtered {
+		allErrs = append(allErrs, validateWorkloadSelector(selector, fldPath.Child("filtered").Index(index))...)
+	}
+	for index, selector := range egressFilter.Workloads.Exempted {
+		allErrs = append(allErrs, validateWorkloadSelector(selector, fldPath.Child("exempted").Index(index))...)
+	}
+
+	return allErrs
+}
+
+// ValidateEnforcementBackend validates the shoot configuration against the enforcement backend resolved for the shoot
+// from its configuration, the extension configuration and its networking type. Settings not supported by an enforcement
+// backend configured in the shoot configuration itself are already rejected by ValidateProviderConfig.
+func ValidateEnforcementBackend(egressFilter *config.EgressFilter, backend config.EnforcementBackend, fldPath *field.Path) field.ErrorList {
+	if egressFilter == nil || egressFilter.EnforcementBackend == backend {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if backend == config.EnforcementBackendApplier {
+		if egressFilter.Workloads != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("workloads"), "workloads cannot be selected with enforcement backend applier"))
+		}
+		allErrs = append(allErrs, validateApplierFilterScopes(egressFilter.StaticFilterList, fldPath.Child("staticFilterList"))...)
+	}
+	allErrs = append(allErrs, validateAudit(egressFilter, backend, fldPath)...)
+
+	return allErrs
+}
+
+// validateAudit rejects the enforcement mode `audit` of the shoot configuration and its tag filters if the enforcement
+// backend cannot audit entries, as the audited entries would be neither blocked nor logged.
+func validateAudit(egressFilter *config.EgressFilter, backend config.EnforcementBackend, fldPath *field.Path) field.ErrorList {
+	if helper.AuditSupported(backend) {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	detail := fmt.Sprintf("entries cannot be audited with enforcement backend %s", backend)
+	if egressFilter.EnforcementMode == config.EnforcementModeAudit {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enforcementMode"), detail))
+	}
+	allErrs = append(allErrs, validateTagFiltersAudit(egressFilter.TagFilters, fldPath.Child("tagFilters"), detail)...)
+
+	return allErrs
+}
+
+func validateTagFiltersAudit(tagFilters []config.TagFilter, fldPath *field.Path, detail string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for index, tagFilter := range tagFilters {
+		if tagFilter.EnforcementMode == config.EnforcementModeAudit {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("enforcementMode"), detail))
+		}
+	}
+
+	return allErrs
+}
+
+// validateApplierFilterScopes rejects the port- and protocol-scoped entries of a static filter list enforced by the
+// egress filter applier, which does not apply them.
+func validateApplierFilterScopes(staticFilterList []config.Filter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for index, filter := range staticFilterList {
+		if filter.Protocol != "" {
+			allErrs = append(allErrs, field.Forbidden(
+				fldPath.Index(index).Child("protocol"),
+				"port- and protocol-scoped entries are not supported with enforcement backend applier",
+			))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkloadSelector(selector config.WorkloadSelector, fldPath *field.Path) field.ErrorList {
+	if selector.NamespaceSelector == nil && selector.PodSelector == nil {
+		return field.ErrorList{field.Required(fldPath, "namespaceSelector or podSelector must be set")}
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector.PodSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("podSelector"))...)
+	return allErrs
+}
+
+// validateProfiles validates the filter profiles. The profiles are applied by egress filter applier DaemonSets
+// selecting the nodes, so they cannot be combined with the network policies of the cilium and calico backends.
+func validateProfiles(egressFilter *config.EgressFilter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if egressFilter.Workers != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "profiles and workers are mutually exclusive, use blackholingEnabled of the profiles instead"))
+	}
+	if egressFilter.EnforcementBackend == config.EnforcementBackendCilium || egressFilter.EnforcementBackend == config.EnforcementBackendCalico || egressFilter.Workloads != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "profiles are only supported with enforcement backend applier"))
+	}
+
+	names := sets.New[string]()
+	for index, profile := range egressFilter.Profiles {
+		idxPath := fldPath.Index(index)
+
+		if profile.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "profile name must be specified"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(profile.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), profile.Name, fmt.Sprintf("profile name is not valid: %s", msg)))
+			}
+			if names.Has(profile.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), profile.Name))
+			}
+			names.Insert(profile.Name)
+		}
+
+		if len(profile.WorkerPools) == 0 && profile.NodeSelector == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "workerPools or nodeSelector must be set"))
+		}
+		for poolIndex, pool := range profile.WorkerPools {
+			for _, msg := range validation.IsDNS1123Label(pool) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("workerPools").Index(poolIndex), pool, fmt.Sprintf("worker name is not valid: %s", msg)))
+			}
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(profile.NodeSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("nodeSelector"))...)
+
+		if profile.Mode != "" && !slices.Contains(supportedFilterModes, profile.Mode) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("mode"), profile.Mode, supportedFilterModes))
+		}
+		for tagIndex, tagFilter := range profile.TagFilters {
+			allErrs = append(allErrs, validateTagFilter(tagFilter, idxPath.Child("tagFilters").Index(tagIndex))...)
+		}
+		allErrs = append(allErrs, validateTagFiltersAudit(profile.TagFilters, idxPath.Child("tagFilters"), "entries cannot be audited with enforcement backend applier")...)
+
+		allErrs = append(allErrs, validateStaticFilterList(profile.StaticFilterList, idxPath.Child("staticFilterList"))...)
+		allErrs = append(allErrs, validateApplierFilterScopes(profile.StaticFilterList, idxPath.Child("staticFilterList"))...)
+	}
+
+	return allErrs
+}
+
+func validateTagFilter(tagFilter config.TagFilter, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if tagFilter.Name == "" && len(tagFilter.Requirements) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name or requirements must be set"))
+	}
+	if tagFilter.Name != "" || len(tagFilter.Values) > 0 || tagFilter.Operator != "" {
+		allErrs = append(allErrs, validateTagRequirement(config.TagRequirement{Name: tagFilter.Name, Operator: tagFilter.Operator, Values: tagFilter.Values}, fldPath)...)
+	}
+	for index, requirement := range tagFilter.Requirements {
+		allErrs = append(allErrs, validateTagRequirement(requirement, fldPath.Child("requirements").Index(index))...)
+	}
+
+	if tagFilter.Match != "" && !slices.Contains(supportedTagMatches, tagFilter.Match) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("match"), tagFilter.Match, supportedTagMatches))
+	}
+	if tagFilter.Action != "" && !slices.Contains(supportedTagFilterActions, tagFilter.Action) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), tagFilter.Action, supportedTagFilterActions))
+	}
+	if tagFilter.EnforcementMode != "" && !slices.Contains(supportedEnforcementModes, tagFilter.EnforcementMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcementMode"), tagFilter.EnforcementMode, supportedEnforcementModes))
+	}
+
+	// Excluded entries are dropped, so there is nothing to override
+	if tagFilter.Action == config.TagFilterActionExclude {
+		if tagFilter.Policy != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("policy"), "policy cannot be set for excluded entries"))
+		}
+		if tagFilter.EnforcementMode != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enforcementMode"), "enforcementMode cannot be set for excluded entries"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateTagRequirement validates a requirement of a tag filter. The requirement of the name and values of the tag
+// filter itself is validated with the path of the tag filter.
+func validateTagRequirement(requirement config.TagRequirement, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if requirement.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "tag name must be set"))
+	}
+	if len(requirement.Values) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("values"), "at least one tag value must be set"))
+	}
+	if requirement.Operator != "" && !slices.Contains(supportedTagOperators, requirement.Operator) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), requirement.Operator, supportedTagOperators))
+	}
+
+	return allErrs
+}
+
 func validateStaticFilterList(staticFilterList []config.Filter, fldPath *field.Path) field.ErrorList {
 	if len(staticFilterList) == 0 {
 		return nil
@@ -124,12 +532,23 @@ func validateStaticFilterList(staticFilterList []config.Filter, fldPath *field.P
 	var allErrs field.ErrorList
 
 	for index, filter := range staticFilterList {
-		if _, _, err := net.ParseCIDR(filter.Network); err != nil {
+		switch {
+		case filter.FQDN != "" && filter.Network != "":
 			allErrs = append(allErrs, field.Invalid(
-				fldPath.Index(index).Child("network"),
-				filter.Network,
-				"filter network must be a valid CIDR",
+				fldPath.Index(index),
+				filter.FQDN,
+				"filter network and fqdn are mutually exclusive",
 			))
+		case filter.FQDN != "":
+			allErrs = append(allErrs, validateFQDN(filter.FQDN, fldPath.Index(index).Child("fqdn"))...)
+		default:
+			if _, _, err := net.ParseCIDR(filter.Network); err != nil {
+				allErrs = append(allErrs, field.Invalid(
+					fldPath.Index(index).Child("network"),
+					filter.Network,
+					"filter network must be a valid CIDR",
+				))
+			}
 		}
 
 		if !slices.Contains(allowedPolicies, filter.Policy) {
@@ -139,6 +558,53 @@ func validateStaticFilterList(staticFilterList []config.Filter, fldPath *field.P
 				fmt.Sprintf("filter policy must be one of: %v", allowedPolicies),
 			))
 		}
+
+		allErrs = append(allErrs, ValidateFilterScope(&filter, fldPath.Index(index))...)
+	}
+	return allErrs
+}
+
+// ValidateFilterScope validates the protocol and ports of a port- or protocol-scoped filter entry.
+func ValidateFilterScope(filter *config.Filter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if filter.Protocol == "" {
+		if len(filter.Ports) > 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("protocol"), "protocol must be specified if ports are specified"))
+		}
+		return allErrs
+	}
+
+	if !slices.Contains(supportedProtocols, filter.Protocol) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), filter.Protocol, supportedProtocols))
+	}
+
+	for index, portRange := range filter.Ports {
+		idxPath := fldPath.Child("ports").Index(index)
+		for _, msg := range validation.IsValidPortNum(int(portRange.Port)) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("port"), portRange.Port, msg))
+		}
+		if portRange.EndPort != nil {
+			for _, msg := range validation.IsValidPortNum(int(*portRange.EndPort)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("endPort"), *portRange.EndPort, msg))
+			}
+			if *portRange.EndPort < portRange.Port {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("endPort"), *portRange.EndPort, "endPort must not be less than port"))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateFQDN(fqdn string, fldPath *field.Path) field.ErrorList {
+	name := strings.TrimSuffix(fqdn, ".")
+	if !strings.Contains(name, ".") || net.ParseIP(name) != nil {
+		return field.ErrorList{field.Invalid(fldPath, fqdn, "filter fqdn must be a fully qualified domain name")}
+	}
+
+	var allErrs field.ErrorList
+	for _, msg := range validation.IsDNS1123Subdomain(strings.ToLower(name)) {
+		allErrs = append(allErrs, field.Invalid(fldPath, fqdn, fmt.Sprintf("filter fqdn is not valid: %s", msg)))
 	}
 	return allErrs
 }
@@ -159,13 +625,6 @@ func validateWorkersConfig(workers *config.Workers, fldPath *field.Path) field.E
 	}
 
 	for index, name := range workers.Names {
-		if len(name) > constants.MaxWorkerNameLength {
-			allErrs = append(allErrs, field.Invalid(
-				fldPath.Index(index).Child("names"),
-				name,
-				fmt.Sprintf("worker name must not exceed %d characters", constants.MaxWorkerNameLength),
-			))
-		}
 		for _, msg := range validation.IsDNS1123Label(name) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Index(index).Child("names"), name, fmt.Sprintf("worker name is not valid: %s", msg)))
 		}
@@ -191,5 +650,13 @@ func validateSecretRef(ref *config.SecretRef, fldPath *field.Path) field.ErrorLi
 		}
 	}
 
+	if ref.Format != "" && !slices.Contains(supportedFilterListFormats, ref.Format) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("format"), ref.Format, supportedFilterListFormats))
+	}
+
+	if ref.MergeStrategy != "" && !slices.Contains(supportedMergeStrategies, ref.MergeStrategy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mergeStrategy"), ref.MergeStrategy, supportedMergeStrategies))
+	}
+
 	return allErrs
 }
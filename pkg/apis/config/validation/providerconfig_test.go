@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"strings"
 	"time"
 
 	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
@@ -59,6 +60,28 @@ var _ = Describe("Provider Config Validation", func() {
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.downloaderConfig")})),
 			),
 		),
+		Entry("should return error for downloadSources in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					DownloadSources: []config.DownloadSource{{Name: "feed", DownloaderConfig: config.DownloaderConfig{Endpoint: "https://example.com"}}},
+				},
+			},
+			field.NewPath("config"),
+			ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.downloadSources")})),
+			),
+		),
+		Entry("should return error for filterListRollout in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					FilterListRollout: &config.FilterListRollout{ExtensionsPerMinute: new(int32(5))},
+				},
+			},
+			field.NewPath("config"),
+			ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.filterListRollout")})),
+			),
+		),
 		Entry("should return error for ensureConnectivity in shoot config",
 			&config.Configuration{
 				EgressFilter: &config.EgressFilter{
@@ -70,6 +93,28 @@ var _ = Describe("Provider Config Validation", func() {
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.ensureConnectivity")})),
 			),
 		),
+		Entry("should return error for signatureVerification in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					SignatureVerification: &config.SignatureVerification{TrustedKeys: []config.TrustedKey{{Name: "foo", PublicKey: "bar"}}},
+				},
+			},
+			field.NewPath("config"),
+			ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.signatureVerification")})),
+			),
+		),
+		Entry("should return error for fqdnResolution in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					FQDNResolution: &config.FQDNResolution{Nameservers: []string{"10.0.0.10:53"}},
+				},
+			},
+			field.NewPath("config"),
+			ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.fqdnResolution")})),
+			),
+		),
 		Entry("should return error if staticFilterList exceeds max entries",
 			&config.Configuration{
 				EgressFilter: &config.EgressFilter{
@@ -126,6 +171,448 @@ var _ = Describe("Provider Config Validation", func() {
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[0].network")})),
 			),
 		),
+		Entry("should succeed with FQDN entries in StaticFilterList",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					StaticFilterList: []config.Filter{
+						{FQDN: "pool.mining.example.com", Policy: config.PolicyBlockAccess},
+						{FQDN: "Paste.Example.com.", Policy: config.PolicyBlockAccess},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for StaticFilterList with invalid FQDNs",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					StaticFilterList: []config.Filter{
+						{FQDN: "localhost", Policy: config.PolicyBlockAccess},
+						{FQDN: "10.0.0.1", Policy: config.PolicyBlockAccess},
+						{FQDN: "foo_bar.example.com", Policy: config.PolicyBlockAccess},
+						{FQDN: "example.com", Network: "10.0.0.0/24", Policy: config.PolicyBlockAccess},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[0].fqdn")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[1].fqdn")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[2].fqdn")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[3]")})),
+			),
+		),
+		Entry("should succeed with port- and protocol-scoped entries in StaticFilterList",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					StaticFilterList: []config.Filter{
+						{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 25}}},
+						{Network: "::/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolUDP, Ports: []config.PortRange{{Port: 5000, EndPort: new(int32(5100))}}},
+						{Network: "10.0.0.0/24", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolSCTP},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for StaticFilterList with invalid protocols or ports",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					StaticFilterList: []config.Filter{
+						{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: "ICMP"},
+						{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Ports: []config.PortRange{{Port: 25}}},
+						{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 0}, {Port: 100, EndPort: new(int32(99))}, {Port: 1, EndPort: new(int32(65536))}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[0].protocol")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[1].protocol")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[2].ports[0].port")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[2].ports[1].endPort")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.staticFilterList[2].ports[2].endPort")})),
+			),
+		),
+		Entry("should return error for port-scoped entries with blackholing",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					BlackholingEnabled: true,
+					StaticFilterList: []config.Filter{
+						{Network: "10.0.0.0/24", Policy: config.PolicyBlockAccess},
+						{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 25}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.egressFilter.staticFilterList[1].protocol"),
+				})),
+			),
+		),
+		Entry("should succeed with port-scoped entries if blackholing is disabled for some workers",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					BlackholingEnabled: true,
+					Workers:            &config.Workers{BlackholingEnabled: false, Names: []string{"worker-a"}},
+					StaticFilterList: []config.Filter{
+						{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 25}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should succeed with mode allowList",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Mode: config.FilterModeAllowList,
+					StaticFilterList: []config.Filter{
+						{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess},
+						{FQDN: "registry.example.com", Policy: config.PolicyAllowAccess},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported mode",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Mode: "denyList",
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.mode"),
+				})),
+			),
+		),
+		Entry("should succeed with enforcement mode audit for the filter and a tag",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementMode: config.EnforcementModeAudit,
+					TagFilters: []config.TagFilter{
+						{Name: "threat-type", Values: []string{"botnet"}, EnforcementMode: config.EnforcementModeEnforce},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported enforcement modes",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementMode: "dryRun",
+					TagFilters: []config.TagFilter{
+						{Name: "threat-type", Values: []string{"botnet"}},
+						{Name: "threat-type", Values: []string{"phishing"}, EnforcementMode: "log"},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.enforcementMode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.tagFilters[1].enforcementMode"),
+				})),
+			),
+		),
+		Entry("should succeed with policy evaluation longestPrefixMatch",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					PolicyEvaluation: config.PolicyEvaluationLongestPrefixMatch,
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported policy evaluations",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					PolicyEvaluation: "firstMatch",
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.policyEvaluation"),
+				})),
+			),
+		),
+		Entry("should succeed with tag selectors",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					TagFilters: []config.TagFilter{
+						{
+							Action: config.TagFilterActionInclude,
+							Requirements: []config.TagRequirement{
+								{Name: "category", Values: []string{"malware"}},
+								{Name: "confidence", Operator: config.TagOperatorIn, Values: []string{"high"}},
+							},
+						},
+						{Name: "region", Values: []string{"internal"}, Action: config.TagFilterActionExclude, Priority: 10},
+						{Name: "region", Operator: config.TagOperatorNotIn, Values: []string{"eu"}, Match: config.TagMatchAny, Policy: new(config.PolicyAllowAccess)},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should succeed with enforcement mode audit for enforcement backend calico",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendCalico,
+					EnforcementMode:    config.EnforcementModeAudit,
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for enforcement mode audit if the enforcement backend cannot audit entries",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendCilium,
+					EnforcementMode:    config.EnforcementModeAudit,
+					TagFilters: []config.TagFilter{
+						{Name: "threat-type", Values: []string{"botnet"}, EnforcementMode: config.EnforcementModeEnforce},
+						{Name: "threat-type", Values: []string{"phishing"}, EnforcementMode: config.EnforcementModeAudit},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.enforcementMode"),
+					"Detail": Equal("entries cannot be audited with enforcement backend cilium"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.tagFilters[1].enforcementMode"),
+					"Detail": Equal("entries cannot be audited with enforcement backend cilium"),
+				})),
+			),
+		),
+		Entry("should return error for audited tag filters of filter profiles",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Profiles: []config.FilterProfile{{
+						Name:        "gpu",
+						WorkerPools: []string{"gpu"},
+						TagFilters:  []config.TagFilter{{Name: "threat-type", Values: []string{"botnet"}, EnforcementMode: config.EnforcementModeAudit}},
+					}},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.profiles[0].tagFilters[0].enforcementMode"),
+					"Detail": Equal("entries cannot be audited with enforcement backend applier"),
+				})),
+			),
+		),
+		Entry("should return error for invalid tag selectors",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					TagFilters: []config.TagFilter{
+						{Policy: new(config.PolicyBlockAccess)},
+						{Name: "region", Operator: "exists", Match: "none", Action: "drop"},
+						{Requirements: []config.TagRequirement{{Values: []string{"high"}}}},
+						{Name: "region", Values: []string{"internal"}, Action: config.TagFilterActionExclude, Policy: new(config.PolicyAllowAccess), EnforcementMode: config.EnforcementModeAudit},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.egressFilter.tagFilters[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.egressFilter.tagFilters[1].values"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.tagFilters[1].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.tagFilters[1].match"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.tagFilters[1].action"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.egressFilter.tagFilters[2].requirements[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.egressFilter.tagFilters[3].policy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.egressFilter.tagFilters[3].enforcementMode"),
+				})),
+			),
+		),
+		Entry("should succeed with enforcement backend cilium",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendCilium,
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported enforcement backend",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: "nftables",
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.enforcementBackend"),
+				})),
+			),
+		),
+		Entry("should succeed with workloads",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendAuto,
+					Workloads: &config.Workloads{
+						Filtered: []config.WorkloadSelector{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}}},
+						Exempted: []config.WorkloadSelector{{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "monitoring"}},
+							PodSelector:       &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"scanner"}}}},
+						}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for workloads with the egress filter applier",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendApplier,
+					BlackholingEnabled: true,
+					Workloads: &config.Workloads{
+						Exempted: []config.WorkloadSelector{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "scanner"}}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.workloads"),
+					"Detail": Equal("workloads cannot be selected with enforcement backend applier"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.workloads"),
+					"Detail": Equal("workloads cannot be selected with blackholing or worker-specific block modes"),
+				})),
+			),
+		),
+		Entry("should return error for invalid workload selectors",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Workloads: &config.Workloads{
+						Filtered: []config.WorkloadSelector{{}},
+						Exempted: []config.WorkloadSelector{{PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn}}}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.egressFilter.workloads.filtered[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.egressFilter.workloads.exempted[0].podSelector.matchExpressions[0].values"),
+				})),
+			),
+		),
+		Entry("should return error for port-scoped entries with enforcement backend applier",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendApplier,
+					Mode:               config.FilterModeAllowList,
+					StaticFilterList: []config.Filter{
+						{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess},
+						{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 443}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.staticFilterList[1].protocol"),
+					"Detail": Equal("port- and protocol-scoped entries are not supported with enforcement backend applier"),
+				})),
+			),
+		),
+		Entry("should return error for port-scoped entries in mode allowList",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Mode: config.FilterModeAllowList,
+					StaticFilterList: []config.Filter{
+						{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess},
+						{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 443}}},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.egressFilter.staticFilterList[1].protocol"),
+				})),
+			),
+		),
+		Entry("should return error for allowListSafeguards in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					AllowListSafeguards: &config.AllowListSafeguards{Networks: []string{"192.0.2.0/24"}},
+				},
+			},
+			field.NewPath("config"),
+			ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.allowListSafeguards")})),
+			),
+		),
+		Entry("should return error for blockedConnectionExporter in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					BlockedConnectionExporter: &config.BlockedConnectionExporter{Enabled: true},
+				},
+			},
+			field.NewPath("config"),
+			ContainElement(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.blockedConnectionExporter")})),
+			),
+		),
 		Entry("should succeed with empty StaticFilterList",
 			&config.Configuration{
 				EgressFilter: &config.EgressFilter{
@@ -148,7 +635,7 @@ var _ = Describe("Provider Config Validation", func() {
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.workers")})),
 			),
 		),
-		Entry("should return error for worker name exceeding max length",
+		Entry("should succeed with worker names longer than 15 characters",
 			&config.Configuration{
 				EgressFilter: &config.EgressFilter{
 					Workers: &config.Workers{
@@ -157,10 +644,104 @@ var _ = Describe("Provider Config Validation", func() {
 				},
 			},
 			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for worker name exceeding the DNS label length",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Workers: &config.Workers{
+						Names: []string{strings.Repeat("a", 64)},
+					},
+				},
+			},
+			field.NewPath("config"),
 			ConsistOf(
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.workers[0].names")})),
 			),
 		),
+		Entry("should succeed with filter profiles",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					Profiles: []config.FilterProfile{
+						{
+							Name:             "payment-processing",
+							WorkerPools:      []string{"payment-processing-pool"},
+							Mode:             config.FilterModeAllowList,
+							StaticFilterList: []config.Filter{{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess}},
+						},
+						{
+							Name:               "gpu",
+							NodeSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"accelerator": "gpu"}},
+							BlackholingEnabled: new(false),
+							StaticFilterList:   []config.Filter{{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess}},
+							TagFilters:         []config.TagFilter{{Name: "threat-type", Values: []string{"botnet"}}},
+						},
+					},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for invalid filter profiles",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					BlackholingEnabled: true,
+					Workers:            &config.Workers{Names: []string{"pool"}},
+					Profiles: []config.FilterProfile{
+						{Name: "gpu", WorkerPools: []string{"gpu"}, Mode: "denyList"},
+						{Name: "gpu"},
+						{
+							Name:             "Invalid_Name",
+							WorkerPools:      []string{"pool"},
+							StaticFilterList: []config.Filter{{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP}},
+						},
+					},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.egressFilter.profiles"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.profiles[0].mode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("config.egressFilter.profiles[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("config.egressFilter.profiles[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.egressFilter.profiles[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.egressFilter.profiles[2].staticFilterList[0].protocol"),
+				})),
+			),
+		),
+		Entry("should return error for filter profiles with the network policy backends",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					EnforcementBackend: config.EnforcementBackendCilium,
+					Profiles:           []config.FilterProfile{{Name: "gpu", WorkerPools: []string{"gpu"}}},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("config.egressFilter.profiles"),
+					"Detail": Equal("profiles are only supported with enforcement backend applier"),
+				})),
+			),
+		),
 		Entry("should return error for worker name with invalid characters",
 			&config.Configuration{
 				EgressFilter: &config.EgressFilter{
@@ -208,5 +789,154 @@ var _ = Describe("Provider Config Validation", func() {
 				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.healthCheckConfig")})),
 			),
 		),
+		Entry("should succeed with supported format of shoot filter list source",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					ShootFilterListSource: &config.SecretRef{Name: "filter-list", Format: config.FilterListFormatCSV},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported format of project filter list source",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					ProjectFilterListSource: &config.SecretRef{Name: "filter-list", Format: "xml"},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.projectFilterListSource.format")})),
+			),
+		),
+		Entry("should succeed with supported merge strategy of project filter list source",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					ProjectFilterListSource: &config.SecretRef{Name: "filter-list", MergeStrategy: config.MergeStrategyAppendBlockOnly},
+				},
+			},
+			field.NewPath("config"),
+			BeEmpty(),
+		),
+		Entry("should return error for unsupported merge strategy of shoot filter list source",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					ShootFilterListSource: &config.SecretRef{Name: "filter-list", MergeStrategy: "append"},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("config.egressFilter.shootFilterListSource.mergeStrategy"),
+				})),
+			),
+		),
+		Entry("should return error for mergeStrategyLimits in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					MergeStrategyLimits: &config.MergeStrategyLimits{Shoot: config.MergeStrategyMerge},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.mergeStrategyLimits")})),
+			),
+		),
+		Entry("should return error for lockedEntries in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					LockedEntries: &config.LockedEntries{Networks: []string{"192.0.2.0/24"}},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.lockedEntries")})),
+			),
+		),
+		Entry("should return error for projectPermissions in shoot config",
+			&config.Configuration{
+				EgressFilter: &config.EgressFilter{
+					ProjectPermissions: []config.ProjectPermission{{Permission: config.PermissionOptOut, Namespaces: []string{"garden-dev"}}},
+				},
+			},
+			field.NewPath("config"),
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Field": Equal("config.egressFilter.projectPermissions")})),
+			),
+		),
+	)
+
+	DescribeTable("#ValidateEnforcementBackend",
+		func(egressFilter *config.EgressFilter, backend config.EnforcementBackend, matcher gomegatypes.GomegaMatcher) {
+			Expect(ValidateEnforcementBackend(egressFilter, backend, field.NewPath("egressFilter"))).To(matcher)
+		},
+
+		Entry("should succeed without egress filter", nil, config.EnforcementBackendApplier, BeEmpty()),
+		Entry("should succeed with workloads for enforcement backend cilium",
+			&config.EgressFilter{Workloads: &config.Workloads{}},
+			config.EnforcementBackendCilium,
+			BeEmpty(),
+		),
+		Entry("should return error for workloads with enforcement backend applier",
+			&config.EgressFilter{EnforcementBackend: config.EnforcementBackendAuto, Workloads: &config.Workloads{}},
+			config.EnforcementBackendApplier,
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("egressFilter.workloads"),
+					"Detail": Equal("workloads cannot be selected with enforcement backend applier"),
+				})),
+			),
+		),
+		Entry("should succeed with port-scoped entries for enforcement backend calico",
+			&config.EgressFilter{StaticFilterList: []config.Filter{{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP}}},
+			config.EnforcementBackendCalico,
+			BeEmpty(),
+		),
+		Entry("should return error for port-scoped entries with enforcement backend applier",
+			&config.EgressFilter{StaticFilterList: []config.Filter{
+				{Network: "10.0.0.0/24", Policy: config.PolicyBlockAccess},
+				{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 25}}},
+			}},
+			config.EnforcementBackendApplier,
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("egressFilter.staticFilterList[1].protocol"),
+					"Detail": Equal("port- and protocol-scoped entries are not supported with enforcement backend applier"),
+				})),
+			),
+		),
+		Entry("should succeed with enforcement mode audit for enforcement backend calico",
+			&config.EgressFilter{EnforcementMode: config.EnforcementModeAudit},
+			config.EnforcementBackendCalico,
+			BeEmpty(),
+		),
+		Entry("should return error for enforcement mode audit if the enforcement backend cannot audit entries",
+			&config.EgressFilter{
+				EnforcementBackend: config.EnforcementBackendAuto,
+				EnforcementMode:    config.EnforcementModeAudit,
+				TagFilters:         []config.TagFilter{{Name: "threat-type", Values: []string{"botnet"}, EnforcementMode: config.EnforcementModeAudit}},
+			},
+			config.EnforcementBackendApplier,
+			ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("egressFilter.enforcementMode"),
+					"Detail": Equal("entries cannot be audited with enforcement backend applier"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("egressFilter.tagFilters[0].enforcementMode"),
+					"Detail": Equal("entries cannot be audited with enforcement backend applier"),
+				})),
+			),
+		),
+		Entry("should not return errors reported by #ValidateProviderConfig for the configured enforcement backend",
+			&config.EgressFilter{EnforcementBackend: config.EnforcementBackendApplier, Workloads: &config.Workloads{}},
+			config.EnforcementBackendApplier,
+			BeEmpty(),
+		),
 	)
 })
@@ -15,6 +15,74 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowListSafeguards) DeepCopyInto(out *AllowListSafeguards) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FQDNs != nil {
+		in, out := &in.FQDNs, &out.FQDNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowListSafeguards.
+func (in *AllowListSafeguards) DeepCopy() *AllowListSafeguards {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowListSafeguards)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditStatistics) DeepCopyInto(out *AuditStatistics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditStatistics.
+func (in *AuditStatistics) DeepCopy() *AuditStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedConnectionExporter) DeepCopyInto(out *BlockedConnectionExporter) {
+	*out = *in
+	if in.EventInterval != nil {
+		in, out := &in.EventInterval, &out.EventInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.TopOffenders != nil {
+		in, out := &in.TopOffenders, &out.TopOffenders
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedConnectionExporter.
+func (in *BlockedConnectionExporter) DeepCopy() *BlockedConnectionExporter {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedConnectionExporter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -50,6 +118,46 @@ func (in *Configuration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCarveOut) DeepCopyInto(out *ConnectivityCarveOut) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCarveOut.
+func (in *ConnectivityCarveOut) DeepCopy() *ConnectivityCarveOut {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCarveOut)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownloadSource) DeepCopyInto(out *DownloadSource) {
+	*out = *in
+	in.DownloaderConfig.DeepCopyInto(&out.DownloaderConfig)
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadSource.
+func (in *DownloadSource) DeepCopy() *DownloadSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DownloaderConfig) DeepCopyInto(out *DownloaderConfig) {
 	*out = *in
@@ -63,6 +171,21 @@ func (in *DownloaderConfig) DeepCopyInto(out *DownloaderConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryBackoff != nil {
+		in, out := &in.RetryBackoff, &out.RetryBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -79,11 +202,23 @@ func (in *DownloaderConfig) DeepCopy() *DownloaderConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressFilter) DeepCopyInto(out *EgressFilter) {
 	*out = *in
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = new(Workloads)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = new(Workers)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]FilterProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SleepDuration != nil {
 		in, out := &in.SleepDuration, &out.SleepDuration
 		*out = new(v1.Duration)
@@ -101,11 +236,43 @@ func (in *EgressFilter) DeepCopyInto(out *EgressFilter) {
 		*out = new(DownloaderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DownloadSources != nil {
+		in, out := &in.DownloadSources, &out.DownloadSources
+		*out = make([]DownloadSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FilterListRollout != nil {
+		in, out := &in.FilterListRollout, &out.FilterListRollout
+		*out = new(FilterListRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EnsureConnectivity != nil {
 		in, out := &in.EnsureConnectivity, &out.EnsureConnectivity
 		*out = new(EnsureConnectivity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(SignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FQDNResolution != nil {
+		in, out := &in.FQDNResolution, &out.FQDNResolution
+		*out = new(FQDNResolution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowListSafeguards != nil {
+		in, out := &in.AllowListSafeguards, &out.AllowListSafeguards
+		*out = new(AllowListSafeguards)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockedConnectionExporter != nil {
+		in, out := &in.BlockedConnectionExporter, &out.BlockedConnectionExporter
+		*out = new(BlockedConnectionExporter)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TagFilters != nil {
 		in, out := &in.TagFilters, &out.TagFilters
 		*out = make([]TagFilter, len(*in))
@@ -123,6 +290,23 @@ func (in *EgressFilter) DeepCopyInto(out *EgressFilter) {
 		*out = new(SecretRef)
 		**out = **in
 	}
+	if in.MergeStrategyLimits != nil {
+		in, out := &in.MergeStrategyLimits, &out.MergeStrategyLimits
+		*out = new(MergeStrategyLimits)
+		**out = **in
+	}
+	if in.LockedEntries != nil {
+		in, out := &in.LockedEntries, &out.LockedEntries
+		*out = new(LockedEntries)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProjectPermissions != nil {
+		in, out := &in.ProjectPermissions, &out.ProjectPermissions
+		*out = make([]ProjectPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -136,6 +320,58 @@ func (in *EgressFilter) DeepCopy() *EgressFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressFilterStatus) DeepCopyInto(out *EgressFilterStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.IPv4.DeepCopyInto(&out.IPv4)
+	in.IPv6.DeepCopyInto(&out.IPv6)
+	if in.DroppedPrivateEntries != nil {
+		in, out := &in.DroppedPrivateEntries, &out.DroppedPrivateEntries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignatureVerification != nil {
+		in, out := &in.SignatureVerification, &out.SignatureVerification
+		*out = new(SignatureVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(AuditStatistics)
+		**out = **in
+	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]ProfileStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConnectivityCarveOuts != nil {
+		in, out := &in.ConnectivityCarveOuts, &out.ConnectivityCarveOuts
+		*out = make([]ConnectivityCarveOut, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFilterStatus.
+func (in *EgressFilterStatus) DeepCopy() *EgressFilterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressFilterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EgressFilterStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnsureConnectivity) DeepCopyInto(out *EnsureConnectivity) {
 	*out = *in
@@ -144,6 +380,11 @@ func (in *EnsureConnectivity) DeepCopyInto(out *EnsureConnectivity) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -157,9 +398,57 @@ func (in *EnsureConnectivity) DeepCopy() *EnsureConnectivity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FQDNResolution) DeepCopyInto(out *FQDNResolution) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxAddressesPerName != nil {
+		in, out := &in.MaxAddressesPerName, &out.MaxAddressesPerName
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinTTL != nil {
+		in, out := &in.MinTTL, &out.MinTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxTTL != nil {
+		in, out := &in.MaxTTL, &out.MaxTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinChangeInterval != nil {
+		in, out := &in.MinChangeInterval, &out.MinChangeInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FQDNResolution.
+func (in *FQDNResolution) DeepCopy() *FQDNResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(FQDNResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Filter) DeepCopyInto(out *Filter) {
 	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -190,6 +479,13 @@ func (in *FilterEntryV2) DeepCopyInto(out *FilterEntryV2) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]PortRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -203,6 +499,58 @@ func (in *FilterEntryV2) DeepCopy() *FilterEntryV2 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterListRollout) DeepCopyInto(out *FilterListRollout) {
+	*out = *in
+	if in.ExtensionsPerMinute != nil {
+		in, out := &in.ExtensionsPerMinute, &out.ExtensionsPerMinute
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxJitter != nil {
+		in, out := &in.MaxJitter, &out.MaxJitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterListRollout.
+func (in *FilterListRollout) DeepCopy() *FilterListRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterListRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterListStatistics) DeepCopyInto(out *FilterListStatistics) {
+	*out = *in
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = new(int)
+		**out = **in
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterListStatistics.
+func (in *FilterListStatistics) DeepCopy() *FilterListStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterListStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterListV2) DeepCopyInto(out *FilterListV2) {
 	*out = *in
@@ -226,6 +574,95 @@ func (in *FilterListV2) DeepCopy() *FilterListV2 {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterProfile) DeepCopyInto(out *FilterProfile) {
+	*out = *in
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlackholingEnabled != nil {
+		in, out := &in.BlackholingEnabled, &out.BlackholingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StaticFilterList != nil {
+		in, out := &in.StaticFilterList, &out.StaticFilterList
+		*out = make([]Filter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TagFilters != nil {
+		in, out := &in.TagFilters, &out.TagFilters
+		*out = make([]TagFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterProfile.
+func (in *FilterProfile) DeepCopy() *FilterProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LockedEntries) DeepCopyInto(out *LockedEntries) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]TagRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LockedEntries.
+func (in *LockedEntries) DeepCopy() *LockedEntries {
+	if in == nil {
+		return nil
+	}
+	out := new(LockedEntries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MergeStrategyLimits) DeepCopyInto(out *MergeStrategyLimits) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MergeStrategyLimits.
+func (in *MergeStrategyLimits) DeepCopy() *MergeStrategyLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(MergeStrategyLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuth2Secret) DeepCopyInto(out *OAuth2Secret) {
 	*out = *in
@@ -252,6 +689,69 @@ func (in *OAuth2Secret) DeepCopy() *OAuth2Secret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortRange) DeepCopyInto(out *PortRange) {
+	*out = *in
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortRange.
+func (in *PortRange) DeepCopy() *PortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileStatus) DeepCopyInto(out *ProfileStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileStatus.
+func (in *ProfileStatus) DeepCopy() *ProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectPermission) DeepCopyInto(out *ProjectPermission) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectPermission.
+func (in *ProjectPermission) DeepCopy() *ProjectPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -268,6 +768,64 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureVerification) DeepCopyInto(out *SignatureVerification) {
+	*out = *in
+	if in.TrustedKeys != nil {
+		in, out := &in.TrustedKeys, &out.TrustedKeys
+		*out = make([]TrustedKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerification.
+func (in *SignatureVerification) DeepCopy() *SignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureVerificationFailure) DeepCopyInto(out *SignatureVerificationFailure) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerificationFailure.
+func (in *SignatureVerificationFailure) DeepCopy() *SignatureVerificationFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureVerificationFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureVerificationStatus) DeepCopyInto(out *SignatureVerificationStatus) {
+	*out = *in
+	if in.Failures != nil {
+		in, out := &in.Failures, &out.Failures
+		*out = make([]SignatureVerificationFailure, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerificationStatus.
+func (in *SignatureVerificationStatus) DeepCopy() *SignatureVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -297,6 +855,13 @@ func (in *TagFilter) DeepCopyInto(out *TagFilter) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Requirements != nil {
+		in, out := &in.Requirements, &out.Requirements
+		*out = make([]TagRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Policy != nil {
 		in, out := &in.Policy, &out.Policy
 		*out = new(Policy)
@@ -315,6 +880,43 @@ func (in *TagFilter) DeepCopy() *TagFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagRequirement) DeepCopyInto(out *TagRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagRequirement.
+func (in *TagRequirement) DeepCopy() *TagRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(TagRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedKey) DeepCopyInto(out *TrustedKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedKey.
+func (in *TrustedKey) DeepCopy() *TrustedKey {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workers) DeepCopyInto(out *Workers) {
 	*out = *in
@@ -335,3 +937,59 @@ func (in *Workers) DeepCopy() *Workers {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSelector) DeepCopyInto(out *WorkloadSelector) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSelector.
+func (in *WorkloadSelector) DeepCopy() *WorkloadSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Workloads) DeepCopyInto(out *Workloads) {
+	*out = *in
+	if in.Filtered != nil {
+		in, out := &in.Filtered, &out.Filtered
+		*out = make([]WorkloadSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exempted != nil {
+		in, out := &in.Exempted, &out.Exempted
+		*out = make([]WorkloadSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workloads.
+func (in *Workloads) DeepCopy() *Workloads {
+	if in == nil {
+		return nil
+	}
+	out := new(Workloads)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package backend contains the enforcement backends which render the generated filter lists into the resources
+// enforcing them in the shoot cluster.
+package backend
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// Backend renders the resources enforcing the filter lists of the filter list secret data in the shoot cluster.
+type Backend interface {
+	// Resources returns the resources enforcing the filter lists. Namespaced resources are created in the given
+	// namespace.
+	Resources(namespace string, secretData map[string][]byte) ([]client.Object, error)
+}
+
+// FilterLists are the filter lists of the filter list secret data.
+type FilterLists struct {
+	// Networks are the blocked IPv4 and IPv6 networks.
+	Networks []string
+	// PortRules are the blocked port- and protocol-scoped rules grouped by their scope.
+	PortRules []PortRule
+	// AuditNetworks are the audited IPv4 and IPv6 networks.
+	AuditNetworks []string
+	// AuditPortRules are the audited port- and protocol-scoped rules grouped by their scope.
+	AuditPortRules []PortRule
+}
+
+// PortRule are the networks of the port list with the same protocol and destination ports.
+type PortRule struct {
+	// Protocol is the protocol in upper case, e.g. `TCP`.
+	Protocol string
+	// Ports are the destination port ranges. No ports means all ports of the protocol.
+	Ports []PortRange
+	// Networks are the networks of the rule.
+	Networks []string
+}
+
+// PortRange is an inclusive range of destination ports.
+type PortRange struct {
+	// Port is the first port of the range.
+	Port int32
+	// EndPort is the last port of the range. It is equal to Port for single ports.
+	EndPort int32
+}
+
+// ParseFilterLists parses the filter lists of the filter list secret data.
+func ParseFilterLists(secretData map[string][]byte) (*FilterLists, error) {
+	portRules, err := parsePortList(secretData[constants.KeyPortList])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", constants.KeyPortList, err)
+	}
+	auditPortRules, err := parsePortList(secretData[constants.KeyPortAuditList])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", constants.KeyPortAuditList, err)
+	}
+	return &FilterLists{
+		Networks:       append(listEntries(secretData[constants.KeyIPV4List]), listEntries(secretData[constants.KeyIPV6List])...),
+		PortRules:      portRules,
+		AuditNetworks:  append(listEntries(secretData[constants.KeyIPV4AuditList]), listEntries(secretData[constants.KeyIPV6AuditList])...),
+		AuditPortRules: auditPortRules,
+	}, nil
+}
+
+// listEntries returns the entries of a rendered filter list, e.g. `- 192.0.2.0/24`.
+func listEntries(data []byte) []string {
+	var result []string
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if entry, ok := strings.CutPrefix(strings.TrimSpace(line), "- "); ok && entry != "" {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// parsePortList parses the rules of a rendered port list, e.g. `- tcp 25,8000-8080 192.0.2.0/24` or
+// `- udp * 192.0.2.0/24`, and groups the networks by their scope sorted by the scope.
+func parsePortList(data []byte) ([]PortRule, error) {
+	rules := map[string]*PortRule{}
+	for _, entry := range listEntries(data) {
+		fields := strings.Fields(entry)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid rule %q", entry)
+		}
+		scope := fields[0] + " " + fields[1]
+		rule, ok := rules[scope]
+		if !ok {
+			ports, err := parsePorts(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid rule %q: %w", entry, err)
+			}
+			rule = &PortRule{Protocol: strings.ToUpper(fields[0]), Ports: ports}
+			rules[scope] = rule
+		}
+		rule.Networks = append(rule.Networks, fields[2])
+	}
+
+	result := make([]PortRule, 0, len(rules))
+	for _, scope := range slices.Sorted(maps.Keys(rules)) {
+		result = append(result, *rules[scope])
+	}
+	return result, nil
+}
+
+// parsePorts parses the ports of a port list rule, e.g. `25,8000-8080` or `*` for all ports.
+func parsePorts(ports string) ([]PortRange, error) {
+	if ports == "*" {
+		return nil, nil
+	}
+	var result []PortRange
+	for portRange := range strings.SplitSeq(ports, ",") {
+		first, last, isRange := strings.Cut(portRange, "-")
+		port, err := strconv.ParseInt(first, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		endPort := port
+		if isRange {
+			if endPort, err = strconv.ParseInt(last, 10, 32); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, PortRange{Port: int32(port), EndPort: int32(endPort)})
+	}
+	slices.SortFunc(result, func(a, b PortRange) int { return cmp.Compare(a.Port, b.Port) })
+	return result, nil
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+func TestBackend(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Enforcement Backend Test Suite")
+}
+
+// expectGolden compares the objects serialized as YAML documents with the golden file in testdata. The golden file
+// is written instead if UPDATE_GOLDEN is set.
+func expectGolden(objects []client.Object, name string) {
+	var actual []byte
+	for _, obj := range objects {
+		data, err := yaml.Marshal(obj)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		actual = append(append(actual, "---\n"...), data...)
+	}
+
+	path := filepath.Join("testdata", name)
+	if os.Getenv("UPDATE_GOLDEN") != "" {
+		ExpectWithOffset(1, os.WriteFile(path, actual, 0600)).To(Succeed())
+	}
+	expected, err := os.ReadFile(path) // #nosec G304 -- Golden file of the test.
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, string(actual)).To(Equal(string(expected)))
+}
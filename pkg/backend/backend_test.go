@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var (
+	// secretData contains all filter lists rendered by the actuator.
+	secretData = map[string][]byte{
+		constants.KeyIPV4List:      []byte("- 192.0.2.0/24\n- 198.51.100.0/25\n"),
+		constants.KeyIPV6List:      []byte("- 2001:db8::/32\n"),
+		constants.KeyPortList:      []byte("- tcp 25,8000-8080 203.0.113.0/24\n- udp * 203.0.113.0/24\n- tcp 25,8000-8080 2001:db8:1::/48\n"),
+		constants.KeyIPV4AuditList: []byte("- 198.51.100.128/25\n"),
+		constants.KeyIPV6AuditList: []byte("[]"),
+		constants.KeyPortAuditList: []byte("- tcp 443 203.0.113.0/24\n"),
+	}
+	// enforcedSecretData contains the filter lists without audited or port-scoped entries.
+	enforcedSecretData = map[string][]byte{
+		constants.KeyIPV4List: []byte("- 192.0.2.0/24\n"),
+		constants.KeyIPV6List: []byte("[]"),
+	}
+	// workloads filters the pods of two teams except the ones of the monitoring namespace with an app label.
+	workloads = &config.Workloads{
+		Filtered: []config.WorkloadSelector{
+			{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}}},
+			{PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"checkout", "search"}}}}},
+		},
+		Exempted: []config.WorkloadSelector{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "monitoring"}},
+			PodSelector:       &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpExists}}},
+		}},
+	}
+)
+
+var _ = Describe("#ParseFilterLists", func() {
+	It("should parse the filter lists and group the port-scoped rules", func() {
+		lists, err := ParseFilterLists(secretData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lists).To(Equal(&FilterLists{
+			Networks: []string{"192.0.2.0/24", "198.51.100.0/25", "2001:db8::/32"},
+			PortRules: []PortRule{
+				{Protocol: "TCP", Ports: []PortRange{{Port: 25, EndPort: 25}, {Port: 8000, EndPort: 8080}}, Networks: []string{"203.0.113.0/24", "2001:db8:1::/48"}},
+				{Protocol: "UDP", Networks: []string{"203.0.113.0/24"}},
+			},
+			AuditNetworks: []string{"198.51.100.128/25"},
+			AuditPortRules: []PortRule{
+				{Protocol: "TCP", Ports: []PortRange{{Port: 443, EndPort: 443}}, Networks: []string{"203.0.113.0/24"}},
+			},
+		}))
+	})
+
+	It("should fail for invalid port-scoped rules", func() {
+		_, err := ParseFilterLists(map[string][]byte{constants.KeyPortList: []byte("- tcp http 203.0.113.0/24\n")})
+		Expect(err).To(MatchError(ContainSubstring("invalid rule")))
+	})
+})
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+const (
+	// calicoTier is the Calico policy tier of the egress filter. It is evaluated before the default tier with the
+	// Kubernetes NetworkPolicies, which are only evaluated for connections passed by the egress filter.
+	calicoTier = "gardener-egress-filter"
+	// calicoTierOrder is the order of the Calico policy tier of the egress filter.
+	calicoTierOrder = 100
+	// calicoLabelList is the label of the GlobalNetworkSets with the blocked and audited networks.
+	calicoLabelList = "egress-filter.gardener.cloud/list"
+	// calicoPodSelector selects the workload endpoints of all pods.
+	calicoPodSelector = "projectcalico.org/orchestrator == 'k8s'"
+	// calicoNamespaceLabelPrefix is the prefix of the namespace labels in the labels of the workload endpoints.
+	calicoNamespaceLabelPrefix = "pcns."
+)
+
+var (
+	calicoTierKind          = schema.GroupVersionKind{Group: "crd.projectcalico.org", Version: "v1", Kind: "Tier"}
+	calicoNetworkSetKind    = schema.GroupVersionKind{Group: "crd.projectcalico.org", Version: "v1", Kind: "GlobalNetworkSet"}
+	calicoNetworkPolicyKind = schema.GroupVersionKind{Group: "crd.projectcalico.org", Version: "v1", Kind: "GlobalNetworkPolicy"}
+)
+
+// Calico renders the filter lists into GlobalNetworkSets and a GlobalNetworkPolicy in a dedicated tier selecting the
+// filtered pods. Connections to blocked networks are denied, connections to audited networks are logged, and all other
+// connections are passed to the next tier.
+type Calico struct {
+	// Workloads selects the filtered pods. If nil, all pods are filtered.
+	Workloads *config.Workloads
+}
+
+var _ Backend = Calico{}
+
+// Resources implements Backend.
+func (c Calico) Resources(_ string, secretData map[string][]byte) ([]client.Object, error) {
+	lists, err := ParseFilterLists(secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	tier := calicoObject(calicoTierKind, calicoTier, map[string]any{"order": int64(calicoTierOrder)})
+	objects := []client.Object{tier}
+
+	var rules []any
+	if _, ok := secretData[constants.KeyIPV4AuditList]; ok {
+		objects = append(objects, calicoNetworkSet(constants.PolicyName+"-audit", "audit", lists.AuditNetworks))
+		rules = append(rules, calicoRule("Log", "", map[string]any{"selector": calicoLabelList + " == 'audit'"}))
+		rules = append(rules, calicoPortRules("Log", lists.AuditPortRules)...)
+	}
+	objects = append(objects, calicoNetworkSet(constants.PolicyName, "block", lists.Networks))
+	rules = append(rules, calicoRule("Deny", "", map[string]any{"selector": calicoLabelList + " == 'block'"}))
+	rules = append(rules, calicoPortRules("Deny", lists.PortRules)...)
+	rules = append(rules, map[string]any{"action": "Pass"})
+
+	objects = append(objects, calicoObject(calicoNetworkPolicyKind, calicoTier+"."+constants.PolicyName, map[string]any{
+		"tier":     calicoTier,
+		"order":    int64(10),
+		"selector": calicoWorkloadSelector(c.Workloads),
+		"types":    []any{"Egress"},
+		"egress":   rules,
+	}))
+	return objects, nil
+}
+
+// calicoWorkloadSelector returns the selector of the workload endpoints of the filtered pods.
+func calicoWorkloadSelector(workloads *config.Workloads) string {
+	if workloads == nil {
+		return calicoPodSelector
+	}
+	expressions := []string{calicoPodSelector}
+	if len(workloads.Filtered) > 0 {
+		var filtered []string
+		for _, selector := range workloads.Filtered {
+			filtered = append(filtered, "("+calicoSelector(workloadRequirements(selector))+")")
+		}
+		expressions = append(expressions, "("+strings.Join(filtered, " || ")+")")
+	}
+	for _, selector := range workloads.Exempted {
+		expressions = append(expressions, "!("+calicoSelector(workloadRequirements(selector))+")")
+	}
+	return strings.Join(expressions, " && ")
+}
+
+// calicoSelector returns the selector of the workload endpoints matching all requirements.
+func calicoSelector(requirements []labelRequirement) string {
+	if len(requirements) == 0 {
+		return "all()"
+	}
+	expressions := make([]string, 0, len(requirements))
+	for _, requirement := range requirements {
+		key := requirement.key
+		if requirement.namespace {
+			key = calicoNamespaceLabelPrefix + key
+		}
+		values := make([]string, 0, len(requirement.values))
+		for _, value := range requirement.values {
+			values = append(values, "'"+value+"'")
+		}
+		switch requirement.operator {
+		case metav1.LabelSelectorOpIn:
+			expressions = append(expressions, fmt.Sprintf("%s in {%s}", key, strings.Join(values, ", ")))
+		case metav1.LabelSelectorOpNotIn:
+			expressions = append(expressions, fmt.Sprintf("%s not in {%s}", key, strings.Join(values, ", ")))
+		case metav1.LabelSelectorOpExists:
+			expressions = append(expressions, fmt.Sprintf("has(%s)", key))
+		case metav1.LabelSelectorOpDoesNotExist:
+			expressions = append(expressions, fmt.Sprintf("!has(%s)", key))
+		}
+	}
+	return strings.Join(expressions, " && ")
+}
+
+// calicoObject returns a Calico object with the given kind, name and spec.
+func calicoObject(kind schema.GroupVersionKind, name string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetGroupVersionKind(kind)
+	obj.SetName(name)
+	return obj
+}
+
+// calicoNetworkSet returns a GlobalNetworkSet with the given networks labeled with the given list.
+func calicoNetworkSet(name, list string, networks []string) *unstructured.Unstructured {
+	nets := make([]any, 0, len(networks))
+	for _, network := range networks {
+		nets = append(nets, network)
+	}
+	obj := calicoObject(calicoNetworkSetKind, name, map[string]any{"nets": nets})
+	obj.SetLabels(map[string]string{calicoLabelList: list})
+	return obj
+}
+
+// calicoRule returns an egress rule with the given action, protocol and destination.
+func calicoRule(action, protocol string, destination map[string]any) map[string]any {
+	rule := map[string]any{"action": action, "destination": destination}
+	if protocol != "" {
+		rule["protocol"] = protocol
+	}
+	return rule
+}
+
+// calicoPortRules returns the egress rules with the given action for the port- and protocol-scoped rules. Calico
+// rejects rules with networks of both IP families, so a rule is returned per IP family.
+func calicoPortRules(action string, portRules []PortRule) []any {
+	var result []any
+	for _, portRule := range portRules {
+		var ports []any
+		for _, portRange := range portRule.Ports {
+			if portRange.Port == portRange.EndPort {
+				ports = append(ports, int64(portRange.Port))
+			} else {
+				ports = append(ports, fmt.Sprintf("%d:%d", portRange.Port, portRange.EndPort))
+			}
+		}
+
+		nets := map[int64][]any{}
+		for _, network := range portRule.Networks {
+			ipVersion := int64(4)
+			if strings.Contains(network, ":") {
+				ipVersion = 6
+			}
+			nets[ipVersion] = append(nets[ipVersion], network)
+		}
+		for _, ipVersion := range []int64{4, 6} {
+			if len(nets[ipVersion]) == 0 {
+				continue
+			}
+			destination := map[string]any{"nets": nets[ipVersion]}
+			if len(ports) > 0 {
+				destination["ports"] = ports
+			}
+			rule := calicoRule(action, portRule.Protocol, destination)
+			rule["ipVersion"] = ipVersion
+			result = append(result, rule)
+		}
+	}
+	return result
+}
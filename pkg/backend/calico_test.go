@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Calico", func() {
+	It("should render the filter lists into network sets and a network policy", func() {
+		objects, err := Calico{}.Resources("kube-system", secretData)
+		Expect(err).NotTo(HaveOccurred())
+		expectGolden(objects, "calico.yaml")
+	})
+
+	It("should render filter lists without audited or port-scoped entries", func() {
+		objects, err := Calico{}.Resources("kube-system", enforcedSecretData)
+		Expect(err).NotTo(HaveOccurred())
+		expectGolden(objects, "calico-enforced.yaml")
+	})
+
+	It("should only filter the selected workloads", func() {
+		objects, err := Calico{Workloads: workloads}.Resources("kube-system", enforcedSecretData)
+		Expect(err).NotTo(HaveOccurred())
+		expectGolden(objects, "calico-workloads.yaml")
+	})
+})
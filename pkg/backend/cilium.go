@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// ciliumNamespaceLabelPrefix is the prefix of the namespace labels in the labels of the Cilium endpoints.
+const ciliumNamespaceLabelPrefix = "io.cilium.k8s.namespace.labels."
+
+// ciliumPolicyKind is the kind of the Cilium policy enforcing the filter lists.
+var ciliumPolicyKind = schema.GroupVersionKind{Group: "cilium.io", Version: "v2", Kind: "CiliumClusterwideNetworkPolicy"}
+
+// Cilium renders the filter lists into the egress deny rules of a CiliumClusterwideNetworkPolicy selecting the
+// filtered pods. Cilium cannot log connections matching a policy, so audited entries are ignored.
+type Cilium struct {
+	// Workloads selects the filtered pods. If nil, all pods are filtered.
+	Workloads *config.Workloads
+}
+
+var _ Backend = Cilium{}
+
+// Resources implements Backend.
+func (c Cilium) Resources(_ string, secretData map[string][]byte) ([]client.Object, error) {
+	lists, err := ParseFilterLists(secretData)
+	if err != nil {
+		return nil, err
+	}
+
+	// An endpoint selector is a conjunction of requirements, so every term of the selected pods needs its own spec
+	terms := workloadTerms(c.Workloads)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	var rules []any
+	if len(lists.Networks) > 0 {
+		rules = append(rules, map[string]any{"toCIDRSet": ciliumCIDRSet(lists.Networks)})
+	}
+	for _, rule := range lists.PortRules {
+		rules = append(rules, map[string]any{
+			"toCIDRSet": ciliumCIDRSet(rule.Networks),
+			"toPorts":   []any{map[string]any{"ports": ciliumPorts(rule)}},
+		})
+	}
+
+	var specs []any
+	for _, term := range terms {
+		spec := map[string]any{
+			"description":      "Blocks egress traffic of the pods to the networks of the egress filter lists.",
+			"endpointSelector": ciliumEndpointSelector(term),
+			// Deny rules must not turn on default deny for the selected pods
+			"enableDefaultDeny": map[string]any{"egress": false, "ingress": false},
+		}
+		if len(rules) > 0 {
+			spec["egressDeny"] = rules
+		}
+		specs = append(specs, spec)
+	}
+
+	policy := &unstructured.Unstructured{Object: map[string]any{"spec": specs[0]}}
+	if len(specs) > 1 {
+		policy.Object = map[string]any{"specs": specs}
+	}
+	policy.SetGroupVersionKind(ciliumPolicyKind)
+	policy.SetName(constants.PolicyName)
+	return []client.Object{policy}, nil
+}
+
+// ciliumEndpointSelector returns the endpoint selector of the pods matching all requirements.
+func ciliumEndpointSelector(requirements []labelRequirement) map[string]any {
+	if len(requirements) == 0 {
+		return map[string]any{}
+	}
+	expressions := make([]any, 0, len(requirements))
+	for _, requirement := range requirements {
+		key := requirement.key
+		if requirement.namespace {
+			key = ciliumNamespaceLabelPrefix + key
+		}
+		expression := map[string]any{"key": key, "operator": string(requirement.operator)}
+		if len(requirement.values) > 0 {
+			values := make([]any, 0, len(requirement.values))
+			for _, value := range requirement.values {
+				values = append(values, value)
+			}
+			expression["values"] = values
+		}
+		expressions = append(expressions, expression)
+	}
+	return map[string]any{"matchExpressions": expressions}
+}
+
+// ciliumCIDRSet returns the CIDR set of the given networks.
+func ciliumCIDRSet(networks []string) []any {
+	result := make([]any, 0, len(networks))
+	for _, network := range networks {
+		result = append(result, map[string]any{"cidr": network})
+	}
+	return result
+}
+
+// ciliumPorts returns the port protocols of the rule. Port 0 matches all ports of the protocol.
+func ciliumPorts(rule PortRule) []any {
+	if len(rule.Ports) == 0 {
+		return []any{map[string]any{"port": "0", "protocol": rule.Protocol}}
+	}
+	result := make([]any, 0, len(rule.Ports))
+	for _, portRange := range rule.Ports {
+		port := map[string]any{"port": strconv.Itoa(int(portRange.Port)), "protocol": rule.Protocol}
+		if portRange.EndPort != portRange.Port {
+			port["endPort"] = int64(portRange.EndPort)
+		}
+		result = append(result, port)
+	}
+	return result
+}
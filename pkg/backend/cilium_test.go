@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Cilium", func() {
+	It("should render the filter lists into deny rules", func() {
+		objects, err := Cilium{}.Resources("kube-system", secretData)
+		Expect(err).NotTo(HaveOccurred())
+		expectGolden(objects, "cilium.yaml")
+	})
+
+	It("should render filter lists without audited or port-scoped entries", func() {
+		objects, err := Cilium{}.Resources("kube-system", enforcedSecretData)
+		Expect(err).NotTo(HaveOccurred())
+		expectGolden(objects, "cilium-enforced.yaml")
+	})
+
+	It("should only filter the selected workloads", func() {
+		objects, err := Cilium{Workloads: workloads}.Resources("kube-system", enforcedSecretData)
+		Expect(err).NotTo(HaveOccurred())
+		expectGolden(objects, "cilium-workloads.yaml")
+	})
+
+	It("should not render a policy if all workloads are exempted", func() {
+		objects, err := Cilium{Workloads: &config.Workloads{Exempted: []config.WorkloadSelector{{PodSelector: &metav1.LabelSelector{}}}}}.Resources("kube-system", enforcedSecretData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(BeEmpty())
+	})
+})
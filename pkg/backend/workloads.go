@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"maps"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// labelRequirement is a requirement of a workload selector on the labels of the pods or their namespaces.
+type labelRequirement struct {
+	// namespace is true for requirements on the labels of the namespaces.
+	namespace bool
+	key       string
+	operator  metav1.LabelSelectorOperator
+	values    []string
+}
+
+// negate returns the requirement matching the pods not matched by this one.
+func (r labelRequirement) negate() labelRequirement {
+	switch r.operator {
+	case metav1.LabelSelectorOpIn:
+		r.operator = metav1.LabelSelectorOpNotIn
+	case metav1.LabelSelectorOpNotIn:
+		r.operator = metav1.LabelSelectorOpIn
+	case metav1.LabelSelectorOpExists:
+		r.operator = metav1.LabelSelectorOpDoesNotExist
+	case metav1.LabelSelectorOpDoesNotExist:
+		r.operator = metav1.LabelSelectorOpExists
+	}
+	return r
+}
+
+// workloadRequirements returns the requirements of the workload selector, which all have to match. The requirements
+// of the namespace selector come first, match labels are sorted by their key.
+func workloadRequirements(selector config.WorkloadSelector) []labelRequirement {
+	var result []labelRequirement
+	for _, labelSelector := range []struct {
+		namespace bool
+		selector  *metav1.LabelSelector
+	}{
+		{true, selector.NamespaceSelector},
+		{false, selector.PodSelector},
+	} {
+		if labelSelector.selector == nil {
+			continue
+		}
+		for _, key := range slices.Sorted(maps.Keys(labelSelector.selector.MatchLabels)) {
+			result = append(result, labelRequirement{
+				namespace: labelSelector.namespace,
+				key:       key,
+				operator:  metav1.LabelSelectorOpIn,
+				values:    []string{labelSelector.selector.MatchLabels[key]},
+			})
+		}
+		for _, expression := range labelSelector.selector.MatchExpressions {
+			result = append(result, labelRequirement{
+				namespace: labelSelector.namespace,
+				key:       expression.Key,
+				operator:  expression.Operator,
+				values:    expression.Values,
+			})
+		}
+	}
+	return result
+}
+
+// workloadTerms returns the selected pods as disjunction of conjunctions of requirements, i.e. a pod is selected if
+// it matches all requirements of any term. A term without requirements selects all pods, no terms select no pods.
+// Exempted pods are removed by adding the negation of one of the requirements of their selector to each term.
+func workloadTerms(workloads *config.Workloads) [][]labelRequirement {
+	terms := [][]labelRequirement{nil}
+	if workloads == nil {
+		return terms
+	}
+
+	if len(workloads.Filtered) > 0 {
+		terms = nil
+		for _, selector := range workloads.Filtered {
+			terms = append(terms, workloadRequirements(selector))
+		}
+	}
+	for _, selector := range workloads.Exempted {
+		var next [][]labelRequirement
+		for _, term := range terms {
+			for _, requirement := range workloadRequirements(selector) {
+				next = append(next, append(slices.Clone(term), requirement.negate()))
+			}
+		}
+		terms = next
+	}
+	return terms
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("#workloadTerms", func() {
+	It("should select all pods by default", func() {
+		Expect(workloadTerms(nil)).To(Equal([][]labelRequirement{nil}))
+		Expect(workloadTerms(&config.Workloads{})).To(Equal([][]labelRequirement{nil}))
+	})
+
+	It("should select the filtered pods without the exempted ones", func() {
+		var (
+			payments   = labelRequirement{namespace: true, key: "team", operator: metav1.LabelSelectorOpIn, values: []string{"payments"}}
+			teams      = labelRequirement{key: "team", operator: metav1.LabelSelectorOpIn, values: []string{"checkout", "search"}}
+			monitoring = labelRequirement{namespace: true, key: "kubernetes.io/metadata.name", operator: metav1.LabelSelectorOpNotIn, values: []string{"monitoring"}}
+			noApp      = labelRequirement{key: "app", operator: metav1.LabelSelectorOpDoesNotExist}
+		)
+		Expect(workloadTerms(workloads)).To(Equal([][]labelRequirement{
+			{payments, monitoring},
+			{payments, noApp},
+			{teams, monitoring},
+			{teams, noApp},
+		}))
+	})
+
+	It("should select no pods if all pods are exempted", func() {
+		Expect(workloadTerms(&config.Workloads{Exempted: []config.WorkloadSelector{{NamespaceSelector: &metav1.LabelSelector{}}}})).To(BeEmpty())
+	})
+})
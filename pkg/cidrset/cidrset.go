@@ -0,0 +1,330 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cidrset provides a set of IP addresses which is stored as sorted, disjoint ranges per IP family.
+// It supports union, subtraction and containment checks and renders the set as minimal list of CIDR prefixes.
+package cidrset
+
+import (
+	"math/bits"
+	"net/netip"
+	"slices"
+	"sort"
+)
+
+const (
+	familyIPv4 = iota
+	familyIPv6
+)
+
+// familyBits contains the address length of the IP families.
+var familyBits = [2]int{32, 128}
+
+// uint128 is an IP address as 128 bit unsigned integer. IPv4 addresses only use the lowest 32 bits.
+type uint128 struct {
+	hi, lo uint64
+}
+
+func (u uint128) cmp(v uint128) int {
+	switch {
+	case u.hi < v.hi:
+		return -1
+	case u.hi > v.hi:
+		return 1
+	case u.lo < v.lo:
+		return -1
+	case u.lo > v.lo:
+		return 1
+	}
+	return 0
+}
+
+func (u uint128) addOne() uint128 {
+	lo, carry := bits.Add64(u.lo, 1, 0)
+	return uint128{u.hi + carry, lo}
+}
+
+func (u uint128) subOne() uint128 {
+	lo, borrow := bits.Sub64(u.lo, 1, 0)
+	return uint128{u.hi - borrow, lo}
+}
+
+func (u uint128) or(v uint128) uint128 {
+	return uint128{u.hi | v.hi, u.lo | v.lo}
+}
+
+func (u uint128) andNot(v uint128) uint128 {
+	return uint128{u.hi &^ v.hi, u.lo &^ v.lo}
+}
+
+func (u uint128) trailingZeros() int {
+	if u.lo == 0 {
+		return 64 + bits.TrailingZeros64(u.hi)
+	}
+	return bits.TrailingZeros64(u.lo)
+}
+
+// hostMask returns a value with the lowest n bits set.
+func hostMask(n int) uint128 {
+	switch {
+	case n <= 0:
+		return uint128{}
+	case n < 64:
+		return uint128{0, 1<<n - 1}
+	case n < 128:
+		return uint128{1<<(n-64) - 1, ^uint64(0)}
+	}
+	return uint128{^uint64(0), ^uint64(0)}
+}
+
+func fromAddr(addr netip.Addr) (uint128, int) {
+	if addr.Is4() {
+		a := addr.As4()
+		return uint128{0, uint64(a[0])<<24 | uint64(a[1])<<16 | uint64(a[2])<<8 | uint64(a[3])}, familyIPv4
+	}
+	a := addr.As16()
+	var u uint128
+	for i := range 8 {
+		u.hi = u.hi<<8 | uint64(a[i])
+		u.lo = u.lo<<8 | uint64(a[i+8])
+	}
+	return u, familyIPv6
+}
+
+func toAddr(u uint128, family int) netip.Addr {
+	if family == familyIPv4 {
+		return netip.AddrFrom4([4]byte{byte(u.lo >> 24), byte(u.lo >> 16), byte(u.lo >> 8), byte(u.lo)})
+	}
+	var a [16]byte
+	for i := range 8 {
+		a[7-i] = byte(u.hi >> (8 * i))
+		a[15-i] = byte(u.lo >> (8 * i))
+	}
+	return netip.AddrFrom16(a)
+}
+
+// ipRange is an inclusive range of IP addresses.
+type ipRange struct {
+	from, to uint128
+}
+
+func rangeFromPrefix(prefix netip.Prefix) (ipRange, int) {
+	from, family := fromAddr(prefix.Masked().Addr())
+	return ipRange{from: from, to: from.or(hostMask(familyBits[family] - prefix.Bits()))}, family
+}
+
+// normalize sorts the ranges and merges overlapping and adjacent ranges. The given slice is modified.
+func normalize(ranges []ipRange) []ipRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	slices.SortFunc(ranges, func(a, b ipRange) int { return a.from.cmp(b.from) })
+
+	result := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &result[len(result)-1]
+		if r.from.cmp(last.to) <= 0 || r.from == last.to.addOne() {
+			if r.to.cmp(last.to) > 0 {
+				last.to = r.to
+			}
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// subtract removes the normalized ranges b from the normalized ranges a.
+func subtract(a, b []ipRange) []ipRange {
+	if len(a) == 0 || len(b) == 0 {
+		return a
+	}
+
+	result := make([]ipRange, 0, len(a))
+	j := 0
+	for _, r := range a {
+		for j < len(b) && b[j].to.cmp(r.from) < 0 {
+			j++
+		}
+
+		from, done := r.from, false
+		for k := j; k < len(b) && b[k].from.cmp(r.to) <= 0; k++ {
+			if b[k].from.cmp(from) > 0 {
+				result = append(result, ipRange{from: from, to: b[k].from.subOne()})
+			}
+			if b[k].to.cmp(r.to) >= 0 {
+				done = true
+				break
+			}
+			from = b[k].to.addOne()
+		}
+		if !done {
+			result = append(result, ipRange{from: from, to: r.to})
+		}
+	}
+	return result
+}
+
+// appendPrefixes appends the minimal list of prefixes covering the range.
+func appendPrefixes(dst []netip.Prefix, r ipRange, family int) []netip.Prefix {
+	maxBits := familyBits[family]
+	from := r.from
+	for {
+		size := min(from.trailingZeros(), maxBits)
+		for size > 0 && from.or(hostMask(size)).cmp(r.to) > 0 {
+			size--
+		}
+		dst = append(dst, netip.PrefixFrom(toAddr(from, family), maxBits-size))
+
+		last := from.or(hostMask(size))
+		if last == r.to {
+			return dst
+		}
+		from = last.addOne()
+	}
+}
+
+// Set is an immutable set of IP addresses. Use a Builder to create it.
+type Set struct {
+	families [2][]ipRange
+}
+
+// Prefixes returns the minimal list of prefixes covering the set, IPv4 prefixes first and sorted by address.
+func (s *Set) Prefixes() []netip.Prefix {
+	var result []netip.Prefix
+	for family, ranges := range s.families {
+		for _, r := range ranges {
+			result = appendPrefixes(result, r, family)
+		}
+	}
+	return result
+}
+
+// Contains returns true if the set contains the address.
+func (s *Set) Contains(addr netip.Addr) bool {
+	if !addr.IsValid() {
+		return false
+	}
+	return s.ContainsPrefix(netip.PrefixFrom(addr, addr.BitLen()))
+}
+
+// ContainsPrefix returns true if the set contains all addresses of the prefix.
+func (s *Set) ContainsPrefix(prefix netip.Prefix) bool {
+	r, ranges, ok := s.lookup(prefix)
+	return ok && ranges[0].from.cmp(r.from) <= 0 && ranges[0].to.cmp(r.to) >= 0
+}
+
+// OverlapsPrefix returns true if the set contains any address of the prefix.
+func (s *Set) OverlapsPrefix(prefix netip.Prefix) bool {
+	r, ranges, ok := s.lookup(prefix)
+	return ok && ranges[0].from.cmp(r.to) <= 0
+}
+
+// lookup returns the range of the prefix and the ranges of the set starting with the first one not ending before it.
+func (s *Set) lookup(prefix netip.Prefix) (ipRange, []ipRange, bool) {
+	if !prefix.IsValid() {
+		return ipRange{}, nil, false
+	}
+	r, family := rangeFromPrefix(prefix)
+	ranges := s.families[family]
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].to.cmp(r.from) >= 0 })
+	return r, ranges[i:], i < len(ranges)
+}
+
+// Builder builds a Set by adding and removing addresses in the given order.
+// The zero value is an empty builder ready to use.
+type Builder struct {
+	families [2]builderFamily
+}
+
+// builderFamily collects additions and removals of one IP family. Consecutive additions or removals are applied in
+// one batch as their order does not matter.
+type builderFamily struct {
+	ranges  []ipRange
+	added   []ipRange
+	removed []ipRange
+}
+
+func (f *builderFamily) add(r ipRange) {
+	if len(f.removed) > 0 {
+		f.flush()
+	}
+	f.added = append(f.added, r)
+}
+
+func (f *builderFamily) remove(r ipRange) {
+	if len(f.added) > 0 {
+		f.flush()
+	}
+	f.removed = append(f.removed, r)
+}
+
+func (f *builderFamily) flush() {
+	if len(f.added) > 0 {
+		f.ranges = normalize(append(f.ranges, f.added...))
+		f.added = nil
+	}
+	if len(f.removed) > 0 {
+		f.ranges = subtract(f.ranges, normalize(f.removed))
+		f.removed = nil
+	}
+}
+
+// Add adds the address to the set. Invalid addresses are ignored.
+func (b *Builder) Add(addr netip.Addr) {
+	if addr.IsValid() {
+		b.AddPrefix(netip.PrefixFrom(addr, addr.BitLen()))
+	}
+}
+
+// AddPrefix adds all addresses of the prefix to the set. Invalid prefixes are ignored.
+func (b *Builder) AddPrefix(prefix netip.Prefix) {
+	if prefix.IsValid() {
+		r, family := rangeFromPrefix(prefix)
+		b.families[family].add(r)
+	}
+}
+
+// AddSet adds all addresses of the other set to the set.
+func (b *Builder) AddSet(s *Set) {
+	for family, ranges := range s.families {
+		for _, r := range ranges {
+			b.families[family].add(r)
+		}
+	}
+}
+
+// Remove removes the address from the set. Invalid addresses are ignored.
+func (b *Builder) Remove(addr netip.Addr) {
+	if addr.IsValid() {
+		b.RemovePrefix(netip.PrefixFrom(addr, addr.BitLen()))
+	}
+}
+
+// RemovePrefix removes all addresses of the prefix from the set. Invalid prefixes are ignored.
+func (b *Builder) RemovePrefix(prefix netip.Prefix) {
+	if prefix.IsValid() {
+		r, family := rangeFromPrefix(prefix)
+		b.families[family].remove(r)
+	}
+}
+
+// RemoveSet removes all addresses of the other set from the set.
+func (b *Builder) RemoveSet(s *Set) {
+	for family, ranges := range s.families {
+		for _, r := range ranges {
+			b.families[family].remove(r)
+		}
+	}
+}
+
+// Set returns the set of the current state of the builder. The builder can be used further on.
+func (b *Builder) Set() *Set {
+	s := &Set{}
+	for family := range b.families {
+		b.families[family].flush()
+		s.families[family] = slices.Clone(b.families[family].ranges)
+	}
+	return s
+}
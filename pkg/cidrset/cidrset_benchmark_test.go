@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cidrset
+
+import (
+	"math/rand/v2"
+	"net/netip"
+	"testing"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+func randomPrefixes(rnd *rand.Rand, count, minBits, maxBits int) []netip.Prefix {
+	result := make([]netip.Prefix, count)
+	for i := range result {
+		addr := netip.AddrFrom4([4]byte{byte(1 + rnd.IntN(223)), byte(rnd.IntN(256)), byte(rnd.IntN(256)), byte(rnd.IntN(256))})
+		result[i] = netip.PrefixFrom(addr, minBits+rnd.IntN(maxBits-minBits+1)).Masked()
+	}
+	return result
+}
+
+func benchmarkBlockAndAllow(b *testing.B, blocked, allowed int) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	blockList := randomPrefixes(rnd, blocked, 12, 32)
+	allowList := randomPrefixes(rnd, allowed, 16, 32)
+
+	for b.Loop() {
+		var blockBuilder, allowBuilder Builder
+		for _, prefix := range blockList {
+			blockBuilder.AddPrefix(prefix)
+		}
+		for _, prefix := range allowList {
+			allowBuilder.AddPrefix(prefix)
+		}
+		blockBuilder.RemoveSet(allowBuilder.Set())
+		_ = blockBuilder.Set().Prefixes()
+	}
+}
+
+func BenchmarkMaxEntriesWithoutAllowed(b *testing.B) {
+	benchmarkBlockAndAllow(b, constants.FilterListMaxEntries, 0)
+}
+
+func BenchmarkMaxEntriesWith1000Allowed(b *testing.B) {
+	benchmarkBlockAndAllow(b, constants.FilterListMaxEntries, 1000)
+}
+
+func BenchmarkMaxEntriesWith10000Allowed(b *testing.B) {
+	benchmarkBlockAndAllow(b, constants.FilterListMaxEntries, 10000)
+}
+
+func BenchmarkContains(b *testing.B) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	var builder Builder
+	for _, prefix := range randomPrefixes(rnd, constants.FilterListMaxEntries, 12, 32) {
+		builder.AddPrefix(prefix)
+	}
+	s := builder.Set()
+	addrs := randomPrefixes(rnd, 1000, 32, 32)
+
+	for b.Loop() {
+		for _, addr := range addrs {
+			_ = s.Contains(addr.Addr())
+		}
+	}
+}
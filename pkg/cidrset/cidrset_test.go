@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cidrset
+
+import (
+	"math/rand/v2"
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func prefixStrings(s *Set) []string {
+	var result []string
+	for _, prefix := range s.Prefixes() {
+		result = append(result, prefix.String())
+	}
+	return result
+}
+
+func setOf(cidrs ...string) *Set {
+	var b Builder
+	for _, cidr := range cidrs {
+		b.AddPrefix(netip.MustParsePrefix(cidr))
+	}
+	return b.Set()
+}
+
+var _ = Describe("CIDR set", func() {
+	DescribeTable("#AddPrefix",
+		func(cidrs []string, expected []string) {
+			Expect(prefixStrings(setOf(cidrs...))).To(Equal(expected))
+		},
+		Entry("empty", nil, nil),
+		Entry("single prefix", []string{"1.2.3.0/24"}, []string{"1.2.3.0/24"}),
+		Entry("masks host bits", []string{"1.2.3.5/24"}, []string{"1.2.3.0/24"}),
+		Entry("duplicates", []string{"1.2.3.0/24", "1.2.3.0/24"}, []string{"1.2.3.0/24"}),
+		Entry("contained prefix", []string{"1.2.3.4/31", "1.2.3.0/24"}, []string{"1.2.3.0/24"}),
+		Entry("adjacent prefixes are aggregated", []string{"1.2.3.128/25", "1.2.3.0/25"}, []string{"1.2.3.0/24"}),
+		Entry("adjacent prefixes not aligned to a larger prefix", []string{"1.2.3.0/24", "1.2.4.0/24"}, []string{"1.2.3.0/24", "1.2.4.0/24"}),
+		Entry("sorted by family and address", []string{"2001:db8::/32", "10.0.0.0/8", "1.0.0.0/8"}, []string{"1.0.0.0/8", "10.0.0.0/8", "2001:db8::/32"}),
+		Entry("full ranges", []string{"::/0", "0.0.0.0/0"}, []string{"0.0.0.0/0", "::/0"}),
+		Entry("host addresses", []string{"1.2.3.4/32", "1.2.3.5/32", "::1/128"}, []string{"1.2.3.4/31", "::1/128"}),
+	)
+
+	DescribeTable("#RemovePrefix",
+		func(cidr, removed string, expected []string) {
+			var b Builder
+			b.AddPrefix(netip.MustParsePrefix(cidr))
+			b.RemovePrefix(netip.MustParsePrefix(removed))
+			Expect(prefixStrings(b.Set())).To(Equal(expected))
+		},
+		Entry("1.2.3.4 in 1.2.4.0/24", "1.2.4.0/24", "1.2.3.4/32", []string{"1.2.4.0/24"}),
+		Entry("1.2.3.4 in 1.2.3.4/32", "1.2.3.4/32", "1.2.3.4/32", nil),
+		Entry("1.2.3.4 in 1.2.3.4/31", "1.2.3.4/31", "1.2.3.4/32", []string{"1.2.3.5/32"}),
+		Entry("1.2.3.5 in 1.2.3.4/31", "1.2.3.4/31", "1.2.3.5/32", []string{"1.2.3.4/32"}),
+		Entry("1.2.3.4 in 1.2.3.4/30", "1.2.3.4/30", "1.2.3.4/32", []string{"1.2.3.5/32", "1.2.3.6/31"}),
+		Entry("1.2.3.5 in 1.2.3.4/30", "1.2.3.4/30", "1.2.3.5/32", []string{"1.2.3.4/32", "1.2.3.6/31"}),
+		Entry("1.2.3.6 in 1.2.3.4/30", "1.2.3.4/30", "1.2.3.6/32", []string{"1.2.3.4/31", "1.2.3.7/32"}),
+		Entry("1.2.3.7 in 1.2.3.4/30", "1.2.3.4/30", "1.2.3.7/32", []string{"1.2.3.4/31", "1.2.3.6/32"}),
+		Entry("45.67.89.101 in 45.67.88.0/22", "45.67.88.0/22", "45.67.89.101/32",
+			[]string{"45.67.88.0/24", "45.67.89.0/26", "45.67.89.64/27", "45.67.89.96/30", "45.67.89.100/32", "45.67.89.102/31", "45.67.89.104/29", "45.67.89.112/28", "45.67.89.128/25", "45.67.90.0/23"}),
+		Entry("2001:db8::ff00:42:8329 in 2001:db8::ff00:42:8300/120", "2001:db8::ff00:42:8300/120", "2001:db8::ff00:42:8329/128",
+			[]string{"2001:db8::ff00:42:8300/123", "2001:db8::ff00:42:8320/125", "2001:db8::ff00:42:8328/128", "2001:db8::ff00:42:832a/127", "2001:db8::ff00:42:832c/126", "2001:db8::ff00:42:8330/124", "2001:db8::ff00:42:8340/122", "2001:db8::ff00:42:8380/121"}),
+		Entry("larger prefix removes everything", "203.0.113.0/25", "203.0.113.0/24", nil),
+		Entry("no overlap", "203.0.113.0/24", "198.51.100.0/24", []string{"203.0.113.0/24"}),
+		Entry("other family", "0.0.0.0/0", "::/0", []string{"0.0.0.0/0"}),
+		Entry("first address of full range", "0.0.0.0/0", "0.0.0.0/32",
+			[]string{"0.0.0.1/32", "0.0.0.2/31", "0.0.0.4/30", "0.0.0.8/29", "0.0.0.16/28", "0.0.0.32/27", "0.0.0.64/26", "0.0.0.128/25", "0.0.1.0/24", "0.0.2.0/23", "0.0.4.0/22", "0.0.8.0/21", "0.0.16.0/20", "0.0.32.0/19", "0.0.64.0/18", "0.0.128.0/17", "0.1.0.0/16", "0.2.0.0/15", "0.4.0.0/14", "0.8.0.0/13", "0.16.0.0/12", "0.32.0.0/11", "0.64.0.0/10", "0.128.0.0/9", "1.0.0.0/8", "2.0.0.0/7", "4.0.0.0/6", "8.0.0.0/5", "16.0.0.0/4", "32.0.0.0/3", "64.0.0.0/2", "128.0.0.0/1"}),
+	)
+
+	It("should remove the last addresses of the full range", func() {
+		var b Builder
+		b.AddPrefix(netip.MustParsePrefix("::/0"))
+		b.RemovePrefix(netip.MustParsePrefix("ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe/127"))
+		prefixes := b.Set().Prefixes()
+		Expect(prefixes).To(HaveLen(127))
+		Expect(prefixes[0].String()).To(Equal("::/1"))
+		Expect(prefixes[126].String()).To(Equal("ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffc/127"))
+	})
+
+	It("should apply additions and removals in the given order", func() {
+		var b Builder
+		b.AddPrefix(netip.MustParsePrefix("10.0.0.0/8"))
+		b.RemovePrefix(netip.MustParsePrefix("10.0.0.0/9"))
+		b.AddPrefix(netip.MustParsePrefix("10.0.0.0/24"))
+		b.Remove(netip.MustParseAddr("10.0.0.1"))
+		Expect(prefixStrings(b.Set())).To(Equal([]string{"10.0.0.0/32", "10.0.0.2/31", "10.0.0.4/30", "10.0.0.8/29", "10.0.0.16/28", "10.0.0.32/27", "10.0.0.64/26", "10.0.0.128/25", "10.128.0.0/9"}))
+	})
+
+	It("should not modify sets returned earlier", func() {
+		var b Builder
+		b.AddPrefix(netip.MustParsePrefix("10.0.0.0/8"))
+		s := b.Set()
+		b.RemovePrefix(netip.MustParsePrefix("10.0.0.0/9"))
+		b.AddPrefix(netip.MustParsePrefix("1.0.0.0/8"))
+		Expect(prefixStrings(s)).To(Equal([]string{"10.0.0.0/8"}))
+		Expect(prefixStrings(b.Set())).To(Equal([]string{"1.0.0.0/8", "10.128.0.0/9"}))
+	})
+
+	It("should add and remove sets", func() {
+		var b Builder
+		b.AddSet(setOf("10.0.0.0/8", "2001:db8::/32"))
+		b.RemoveSet(setOf("10.0.0.0/9", "2001:db8::/33"))
+		Expect(prefixStrings(b.Set())).To(Equal([]string{"10.128.0.0/9", "2001:db8:8000::/33"}))
+	})
+
+	It("should check containment and overlaps", func() {
+		s := setOf("10.0.0.0/24", "10.0.1.0/24", "2001:db8::/32")
+
+		Expect(s.Contains(netip.MustParseAddr("10.0.1.255"))).To(BeTrue())
+		Expect(s.Contains(netip.MustParseAddr("10.0.2.0"))).To(BeFalse())
+		Expect(s.Contains(netip.MustParseAddr("2001:db8::1"))).To(BeTrue())
+		Expect(s.Contains(netip.MustParseAddr("::ffff:10.0.0.1"))).To(BeFalse())
+		Expect(s.Contains(netip.Addr{})).To(BeFalse())
+
+		Expect(s.ContainsPrefix(netip.MustParsePrefix("10.0.0.0/23"))).To(BeTrue())
+		Expect(s.ContainsPrefix(netip.MustParsePrefix("10.0.0.0/22"))).To(BeFalse())
+		Expect(s.OverlapsPrefix(netip.MustParsePrefix("10.0.0.0/22"))).To(BeTrue())
+		Expect(s.OverlapsPrefix(netip.MustParsePrefix("10.0.2.0/23"))).To(BeFalse())
+		Expect(s.OverlapsPrefix(netip.MustParsePrefix("2001::/16"))).To(BeTrue())
+		Expect(s.OverlapsPrefix(netip.MustParsePrefix("0.0.0.0/0"))).To(BeTrue())
+		Expect(s.OverlapsPrefix(netip.Prefix{})).To(BeFalse())
+	})
+
+	It("should match a naive implementation for random prefixes", func() {
+		rnd := rand.New(rand.NewPCG(1, 2))
+		randomPrefix := func() netip.Prefix {
+			return netip.PrefixFrom(netip.AddrFrom4([4]byte{10, 0, byte(rnd.IntN(4)), byte(rnd.IntN(256))}), 22+rnd.IntN(11)).Masked()
+		}
+
+		var (
+			b                Builder
+			added, removed   []netip.Prefix
+			containsExpected = func(addr netip.Addr) bool {
+				for _, p := range removed {
+					if p.Contains(addr) {
+						return false
+					}
+				}
+				for _, p := range added {
+					if p.Contains(addr) {
+						return true
+					}
+				}
+				return false
+			}
+		)
+		for range 200 {
+			p := randomPrefix()
+			added = append(added, p)
+			b.AddPrefix(p)
+		}
+		for range 100 {
+			p := randomPrefix()
+			removed = append(removed, p)
+			b.RemovePrefix(p)
+		}
+
+		s := b.Set()
+		prefixes := s.Prefixes()
+		for i := 1; i < len(prefixes); i++ {
+			Expect(prefixes[i-1].Addr().Less(prefixes[i].Addr())).To(BeTrue())
+			Expect(prefixes[i-1].Overlaps(prefixes[i])).To(BeFalse())
+			if prefixes[i-1].Bits() == prefixes[i].Bits() {
+				parent, err := prefixes[i-1].Addr().Prefix(prefixes[i].Bits() - 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(parent.Contains(prefixes[i].Addr())).To(BeFalse(), "sibling prefixes must be aggregated")
+			}
+		}
+		Expect(prefixStrings(setOf(prefixStrings(s)...))).To(Equal(prefixStrings(s)))
+
+		for i := range 4 * 256 {
+			addr := netip.AddrFrom4([4]byte{10, 0, byte(i / 256), byte(i % 256)})
+			Expect(s.Contains(addr)).To(Equal(containsExpected(addr)), addr.String())
+		}
+	})
+})
@@ -42,15 +42,17 @@ func init() {
 
 // PolicyFilterOptions holds options related to the policy filter controller.
 type PolicyFilterOptions struct {
-	ConfigLocation  string
-	OAuth2ConfigDir string
-	config          *PolicyFilterConfig
+	ConfigLocation         string
+	OAuth2ConfigDir        string
+	OAuth2SourcesConfigDir string
+	config                 *PolicyFilterConfig
 }
 
 // AddFlags implements Flagger.AddFlags.
 func (o *PolicyFilterOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ConfigLocation, "config", "", "Path to policy filter configuration")
 	fs.StringVar(&o.OAuth2ConfigDir, "oauth2-config-dir", "", "Directory with oauth2 configuration")
+	fs.StringVar(&o.OAuth2SourcesConfigDir, "oauth2-sources-config-dir", "", "Directory with oauth2 configuration of the download sources in subdirectories named after the sources")
 }
 
 // Complete implements Completer.Complete.
@@ -71,30 +73,55 @@ func (o *PolicyFilterOptions) Complete() error {
 
 	var oauth2Secret *apisconfig.OAuth2Secret
 	if config.EgressFilter != nil && config.EgressFilter.DownloaderConfig != nil && o.OAuth2ConfigDir != "" {
-		secretData := &apisconfig.OAuth2Secret{}
-		filename := path.Join(o.OAuth2ConfigDir, constants.KeyClientID)
-		clientID, err := os.ReadFile(filename) // #nosec: G304 -- loading client id configuration from file is a feature. In reality files can be read from the pod's file system only.
+		oauth2Secret, err = readOAuth2Secret(o.OAuth2ConfigDir)
 		if err != nil {
-			return fmt.Errorf("cannot read clientID from %s: %w", filename, err)
+			return err
 		}
-		secretData.ClientID = string(clientID)
-		clientSecret, err := os.ReadFile(path.Join(o.OAuth2ConfigDir, constants.KeyClientSecret))
-		if err == nil {
-			secretData.ClientSecret = string(clientSecret)
+	}
+
+	var oauth2Secrets map[string]*apisconfig.OAuth2Secret
+	if config.EgressFilter != nil && len(config.EgressFilter.DownloadSources) > 0 && o.OAuth2SourcesConfigDir != "" {
+		oauth2Secrets = map[string]*apisconfig.OAuth2Secret{}
+		for _, source := range config.EgressFilter.DownloadSources {
+			dir := path.Join(o.OAuth2SourcesConfigDir, source.Name)
+			if _, err := os.Stat(dir); os.IsNotExist(err) {
+				continue
+			}
+			secretData, err := readOAuth2Secret(dir)
+			if err != nil {
+				return fmt.Errorf("download source %q: %w", source.Name, err)
+			}
+			oauth2Secrets[source.Name] = secretData
 		}
-		secretData.ClientCert, _ = os.ReadFile(path.Join(o.OAuth2ConfigDir, constants.KeyClientCert))
-		secretData.ClientCertKey, _ = os.ReadFile(path.Join(o.OAuth2ConfigDir, constants.KeyClientCertKey))
-		oauth2Secret = secretData
 	}
 
 	o.config = &PolicyFilterConfig{
-		config:       config,
-		oAuth2Secret: oauth2Secret,
+		config:        config,
+		oAuth2Secret:  oauth2Secret,
+		oAuth2Secrets: oauth2Secrets,
 	}
 
 	return nil
 }
 
+// readOAuth2Secret reads the OAuth2 client credentials from the files in the given directory.
+func readOAuth2Secret(dir string) (*apisconfig.OAuth2Secret, error) {
+	secretData := &apisconfig.OAuth2Secret{}
+	filename := path.Join(dir, constants.KeyClientID)
+	clientID, err := os.ReadFile(filename) // #nosec: G304 -- loading client id configuration from file is a feature. In reality files can be read from the pod's file system only.
+	if err != nil {
+		return nil, fmt.Errorf("cannot read clientID from %s: %w", filename, err)
+	}
+	secretData.ClientID = string(clientID)
+	clientSecret, err := os.ReadFile(path.Join(dir, constants.KeyClientSecret))
+	if err == nil {
+		secretData.ClientSecret = string(clientSecret)
+	}
+	secretData.ClientCert, _ = os.ReadFile(path.Join(dir, constants.KeyClientCert))
+	secretData.ClientCertKey, _ = os.ReadFile(path.Join(dir, constants.KeyClientCertKey))
+	return secretData, nil
+}
+
 // Completed returns the decoded PolicyFilterConfig instance. Only call this if `Complete` was successful.
 func (o *PolicyFilterOptions) Completed() *PolicyFilterConfig {
 	return o.config
@@ -102,14 +129,16 @@ func (o *PolicyFilterOptions) Completed() *PolicyFilterConfig {
 
 // PolicyFilterConfig contains configuration information about the networking policy filter.
 type PolicyFilterConfig struct {
-	config       apisconfig.Configuration
-	oAuth2Secret *apisconfig.OAuth2Secret
+	config        apisconfig.Configuration
+	oAuth2Secret  *apisconfig.OAuth2Secret
+	oAuth2Secrets map[string]*apisconfig.OAuth2Secret
 }
 
 // Apply applies the PolicyFilterOptions to the passed ControllerOptions instance.
 func (c *PolicyFilterConfig) Apply(config *controllerconfig.Config) {
 	config.Configuration = c.config
 	config.OAuth2Secret = c.oAuth2Secret
+	config.OAuth2Secrets = c.oAuth2Secrets
 }
 
 // ApplyHealthCheckConfig applies the HealthCheckConfig to the config.
@@ -123,6 +152,11 @@ func (c *PolicyFilterConfig) Oauth2Config() *apisconfig.OAuth2Secret {
 	return c.oAuth2Secret
 }
 
+// Oauth2SourcesConfig returns the OAuth2 secret data of the download sources by source name.
+func (c *PolicyFilterConfig) Oauth2SourcesConfig() map[string]*apisconfig.OAuth2Secret {
+	return c.oAuth2Secrets
+}
+
 func (c *PolicyFilterConfig) Config() apisconfig.Configuration {
 	return c.config
 }
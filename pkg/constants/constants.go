@@ -22,14 +22,29 @@ const (
 	// ManagedResourceNamesSeed is the name used to describe the managed resources for the seed.
 	ManagedResourceNamesSeed = extensionServiceName + "-seed"
 
-	// MaxWorkerNameLength is the maximum length of a worker name.
-	MaxWorkerNameLength = 15
-
 	// ApplicationName is the name for resource describing the components deployed by the extension controller.
 	ApplicationName = "egress-filter-applier"
+	// LabelKeyApplication is the label key identifying the egress filter applier DaemonSets and pods.
+	LabelKeyApplication = "k8s-app"
+	// LabelKeyProfile is the label key of the egress filter applier DaemonSets with the name of their filter profile.
+	LabelKeyProfile = "egress-filter.gardener.cloud/profile"
+	// AnnotationChecksumEgressFilter is the pod template annotation with the checksum of the applied egress filter secret.
+	AnnotationChecksumEgressFilter = "checksum/" + EgressFilterSecretName
 
 	ImageEgressFilter = "egress-filter"
 
+	// ExporterName is the name for resources describing the blocked connection exporter deployed by the extension controller.
+	ExporterName = "egress-filter-exporter"
+	// ImageEgressFilterExporter is the name of the image of the blocked connection exporter.
+	ImageEgressFilterExporter = "egress-filter-exporter"
+	// ExporterSecretName is the name of the secret containing the filter entries for the blocked connection exporter.
+	ExporterSecretName = extensionServiceName + "-exporter"
+	// ExporterEntriesPath is the mount path of the filter entries in the blocked connection exporter.
+	ExporterEntriesPath = "entries"
+
+	// PolicyName is the name of the network policies enforcing the filter lists with the cilium and calico backends.
+	PolicyName = "egress-filter"
+
 	// FilterListSecretName name of the secret containing the egress filter list
 	FilterListSecretName = "egress-filter-list" // #nosec G101 -- No credential.
 	// FilterNamespaceEnvName is the namespace of the extension deployment
@@ -43,10 +58,26 @@ const (
 
 	// KeyFilterList is the key in the filter list secret for the raw filter list
 	KeyFilterList = "filter-list"
+	// KeyFilterListSignature is the key in the filter list secret for the detached signature of the raw filter list
+	KeyFilterListSignature = "filter-list.sig"
+	// KeyFilterListETag is the key in the filter list secret for the ETag of the downloaded filter list
+	KeyFilterListETag = "etag"
+	// KeyFilterListLastModified is the key in the filter list secret for the last modification time of the downloaded filter list
+	KeyFilterListLastModified = "last-modified"
 	// KeyIPV4List is the key in the filter list secret for the ipv4 policy list
 	KeyIPV4List = "ipv4-list"
 	// KeyIPV6List is the key in the filter list secret for the ipv6 policy list
 	KeyIPV6List = "ipv6-list"
+	// KeyPortList is the key in the filter list secret for the port- and protocol-scoped policy list
+	KeyPortList = "port-list"
+	// KeyIPV4AuditList is the key in the filter list secret for the ipv4 policy list which is only logged
+	KeyIPV4AuditList = "ipv4-audit-list"
+	// KeyIPV6AuditList is the key in the filter list secret for the ipv6 policy list which is only logged
+	KeyIPV6AuditList = "ipv6-audit-list"
+	// KeyPortAuditList is the key in the filter list secret for the port- and protocol-scoped policy list which is only logged
+	KeyPortAuditList = "port-audit-list"
+	// KeyFilterEntries is the key in the exporter secret for the blocked filter entries with their tags
+	KeyFilterEntries = "filter-entries"
 
 	// KeyClientID is the key in the OAuth2 secret for the client ID.
 	KeyClientID = "clientID"
@@ -60,4 +91,7 @@ const (
 	XtablesLockName = "xtables-lock"
 	// XtablesLockPath is the path of the xtables lock file.
 	XtablesLockPath = "/run/xtables.lock"
+
+	// IstioIngressGatewayAppLabelValue is the value of the `app` label of the istio ingress gateway services in the seed.
+	IstioIngressGatewayAppLabelValue = "istio-ingressgateway"
 )
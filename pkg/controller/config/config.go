@@ -12,4 +12,6 @@ import (
 type Config struct {
 	config.Configuration
 	OAuth2Secret *config.OAuth2Secret
+	// OAuth2Secrets contains the OAuth2 secret data of the download sources by source name.
+	OAuth2Secrets map[string]*config.OAuth2Secret
 }
@@ -6,10 +6,14 @@ package healthcheck
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 )
 
@@ -30,6 +35,8 @@ var (
 
 // RegisterHealthChecks registers health checks for each extension resource
 // HealthChecks are grouped by extension (e.g worker), extension.type (e.g aws) and  Health Check Type (e.g SystemComponentsHealthy)
+// Only the Extensions of shoots are checked, the egress filter of the seed and garden runtime clusters is deployed
+// with ManagedResources which are checked by the gardener-resource-manager.
 func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthcheck.DefaultAddArgs) error {
 	return healthcheck.DefaultRegistration(
 		constants.ExtensionType,
@@ -39,11 +46,50 @@ func RegisterHealthChecks(ctx context.Context, mgr manager.Manager, opts healthc
 		mgr,
 		opts,
 		nil,
-		[]healthcheck.ConditionTypeToHealthCheck{},
+		[]healthcheck.ConditionTypeToHealthCheck{
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				PreCheckFunc:  isShootExtension,
+				HealthCheck:   general.CheckManagedResource(constants.ManagedResourceNamesShoot),
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				PreCheckFunc:  usesApplier,
+				HealthCheck:   newApplierHealthCheck(),
+			},
+			{
+				ConditionType: ConditionTypeFilterListApplied,
+				PreCheckFunc:  usesApplier,
+				HealthCheck:   newChecksumHealthCheck(),
+			},
+		},
 		sets.New[gardencorev1beta1.ConditionType](),
 	)
 }
 
+// isShootExtension returns true if the Extension is deployed for a shoot.
+func isShootExtension(_ context.Context, _ client.Client, obj client.Object, _ *extensionscontroller.Cluster) bool {
+	ex, ok := obj.(*extensionsv1alpha1.Extension)
+	return ok && extensionsv1alpha1helper.GetExtensionClassOrDefault(ex.Spec.Class) == extensionsv1alpha1.ExtensionClassShoot
+}
+
+// usesApplier returns true if the Extension is deployed for a shoot and its filter lists are enforced by the egress
+// filter applier. The network policies of the other enforcement backends are enforced by the networking of the shoot.
+func usesApplier(ctx context.Context, c client.Client, obj client.Object, cluster *extensionscontroller.Cluster) bool {
+	if !isShootExtension(ctx, c, obj, cluster) {
+		return false
+	}
+	ex := obj.(*extensionsv1alpha1.Extension)
+	if ex.Status.ProviderStatus == nil || ex.Status.ProviderStatus.Raw == nil {
+		return true
+	}
+	status := &v1alpha1.EgressFilterStatus{}
+	if err := json.Unmarshal(ex.Status.ProviderStatus.Raw, status); err != nil {
+		return true
+	}
+	return status.EnforcementBackend == "" || status.EnforcementBackend == v1alpha1.EnforcementBackendApplier
+}
+
 // AddToManager adds a controller with the default Options.
 func AddToManager(ctx context.Context, mgr manager.Manager) error {
 	return RegisterHealthChecks(ctx, mgr, DefaultAddOptions)
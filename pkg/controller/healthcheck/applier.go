@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// ConditionTypeFilterListApplied is the condition type reporting whether the egress filter appliers apply the filter
+// list rendered last.
+const ConditionTypeFilterListApplied = "EgressFilterListApplied"
+
+var (
+	// daemonSetProgressingThreshold is the time the egress filter appliers may be unavailable, e.g. on new nodes,
+	// before the DaemonSets are reported unhealthy.
+	daemonSetProgressingThreshold = 5 * time.Minute
+	// checksumProgressingThreshold is the time the rollout of a changed filter list may take before it is reported
+	// as lagging behind.
+	checksumProgressingThreshold = 10 * time.Minute
+)
+
+// listApplierDaemonSets lists the egress filter applier DaemonSets, i.e. one for the shoot, one per worker pool or one
+// per filter profile and one for the other nodes.
+func listApplierDaemonSets(ctx context.Context, c client.Client) ([]appsv1.DaemonSet, error) {
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := c.List(ctx, daemonSets, client.InNamespace(constants.NamespaceKubeSystem), client.MatchingLabels{constants.LabelKeyApplication: constants.ApplicationName}); err != nil {
+		return nil, fmt.Errorf("failed to list egress filter applier DaemonSets: %w", err)
+	}
+	return daemonSets.Items, nil
+}
+
+// checkDaemonSet returns an error if the DaemonSet is not rolled out or any of its pods is unavailable. The generic
+// DaemonSet check accepts unavailable pods up to maxUnavailable, which is 100% for the egress filter applier.
+func checkDaemonSet(ds *appsv1.DaemonSet) error {
+	switch {
+	case ds.Status.ObservedGeneration < ds.Generation:
+		return fmt.Errorf("observed generation outdated (%d/%d)", ds.Status.ObservedGeneration, ds.Generation)
+	case ds.Status.CurrentNumberScheduled < ds.Status.DesiredNumberScheduled:
+		return fmt.Errorf("not enough scheduled pods (%d/%d)", ds.Status.CurrentNumberScheduled, ds.Status.DesiredNumberScheduled)
+	case ds.Status.NumberMisscheduled > 0:
+		return fmt.Errorf("misscheduled pods found (%d)", ds.Status.NumberMisscheduled)
+	case ds.Status.NumberUnavailable > 0:
+		return fmt.Errorf("unavailable pods found (%d/%d)", ds.Status.NumberUnavailable, ds.Status.DesiredNumberScheduled)
+	}
+	return nil
+}
+
+// checkAppliedChecksum returns an error if the DaemonSet does not apply the filter list with the given checksum
+// on all of its nodes yet.
+func checkAppliedChecksum(ds *appsv1.DaemonSet, checksum string) error {
+	if applied := ds.Spec.Template.Annotations[constants.AnnotationChecksumEgressFilter]; applied != checksum {
+		return fmt.Errorf("filter list with checksum %s is not deployed yet, found %s", checksum, applied)
+	}
+	if ds.Status.ObservedGeneration < ds.Generation || ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled {
+		return fmt.Errorf("filter list with checksum %s is applied on %d/%d nodes", checksum, ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled)
+	}
+	return nil
+}
+
+// checkDaemonSets runs the given check for all DaemonSets and returns the single check result.
+func checkDaemonSets(daemonSets []appsv1.DaemonSet, check func(*appsv1.DaemonSet) error, progressingThreshold time.Duration) *healthcheck.SingleCheckResult {
+	if len(daemonSets) == 0 {
+		return &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: "no egress filter applier DaemonSets found",
+		}
+	}
+
+	var details []string
+	for _, ds := range daemonSets {
+		if err := check(&ds); err != nil {
+			details = append(details, fmt.Sprintf("DaemonSet %s: %s", ds.Name, err))
+		}
+	}
+	if len(details) > 0 {
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionFalse,
+			Detail:               strings.Join(details, "; "),
+			ProgressingThreshold: ptr.To(progressingThreshold),
+		}
+	}
+	return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}
+}
+
+// applierHealthCheck checks the egress filter applier DaemonSets in the shoot.
+type applierHealthCheck struct {
+	logger      logr.Logger
+	shootClient client.Client
+}
+
+// newApplierHealthCheck returns a health check for the egress filter applier DaemonSets in the shoot.
+func newApplierHealthCheck() healthcheck.HealthCheck {
+	return &applierHealthCheck{}
+}
+
+// InjectTargetClient injects the shoot client.
+func (h *applierHealthCheck) InjectTargetClient(shootClient client.Client) {
+	h.shootClient = shootClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (h *applierHealthCheck) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-egress-filter-applier", provider, extension))
+}
+
+// Check executes the health check.
+func (h *applierHealthCheck) Check(ctx context.Context, _ types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	daemonSets, err := listApplierDaemonSets(ctx, h.shootClient)
+	if err != nil {
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	return checkDaemonSets(daemonSets, checkDaemonSet, daemonSetProgressingThreshold), nil
+}
+
+// checksumHealthCheck checks that the egress filter applier DaemonSets in the shoot apply the filter list last
+// rendered by the actuator, as reported in the provider status of the Extension.
+type checksumHealthCheck struct {
+	logger      logr.Logger
+	seedClient  client.Client
+	shootClient client.Client
+}
+
+// newChecksumHealthCheck returns a health check for the filter list applied by the egress filter appliers.
+func newChecksumHealthCheck() healthcheck.HealthCheck {
+	return &checksumHealthCheck{}
+}
+
+// InjectSourceClient injects the seed client.
+func (h *checksumHealthCheck) InjectSourceClient(seedClient client.Client) {
+	h.seedClient = seedClient
+}
+
+// InjectTargetClient injects the shoot client.
+func (h *checksumHealthCheck) InjectTargetClient(shootClient client.Client) {
+	h.shootClient = shootClient
+}
+
+// SetLoggerSuffix injects the logger.
+func (h *checksumHealthCheck) SetLoggerSuffix(provider, extension string) {
+	h.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-egress-filter-checksum", provider, extension))
+}
+
+// Check executes the health check.
+func (h *checksumHealthCheck) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	ex := &extensionsv1alpha1.Extension{}
+	if err := h.seedClient.Get(ctx, request, ex); err != nil {
+		return nil, fmt.Errorf("failed to get extension: %w", err)
+	}
+
+	checksum, profileChecksums, err := renderedChecksums(ex)
+	if err != nil {
+		return nil, err
+	}
+	if checksum == "" {
+		return &healthcheck.SingleCheckResult{
+			Status:               gardencorev1beta1.ConditionFalse,
+			Detail:               "no filter list rendered yet",
+			ProgressingThreshold: ptr.To(checksumProgressingThreshold),
+		}, nil
+	}
+
+	daemonSets, err := listApplierDaemonSets(ctx, h.shootClient)
+	if err != nil {
+		h.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+	return checkDaemonSets(daemonSets, func(ds *appsv1.DaemonSet) error {
+		// The DaemonSets of filter profiles apply the filter lists of their profile
+		if profile, ok := ds.Labels[constants.LabelKeyProfile]; ok {
+			return checkAppliedChecksum(ds, profileChecksums[profile])
+		}
+		return checkAppliedChecksum(ds, checksum)
+	}, checksumProgressingThreshold), nil
+}
+
+// renderedChecksums returns the checksum of the filter list last rendered by the actuator and the ones of the filter
+// profiles by their name.
+func renderedChecksums(ex *extensionsv1alpha1.Extension) (string, map[string]string, error) {
+	if ex.Status.ProviderStatus == nil || ex.Status.ProviderStatus.Raw == nil {
+		return "", nil, nil
+	}
+	status := &v1alpha1.EgressFilterStatus{}
+	if err := json.Unmarshal(ex.Status.ProviderStatus.Raw, status); err != nil {
+		return "", nil, fmt.Errorf("failed to decode provider status: %w", err)
+	}
+	var profileChecksums map[string]string
+	for _, profile := range status.Profiles {
+		if profileChecksums == nil {
+			profileChecksums = map[string]string{}
+		}
+		profileChecksums[profile.Name] = profile.Checksum
+	}
+	return status.Checksum, profileChecksums, nil
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Egress filter applier health checks", func() {
+	var ds *appsv1.DaemonSet
+
+	BeforeEach(func() {
+		ds = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: constants.ApplicationName + "-worker-a", Generation: 2},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{constants.AnnotationChecksumEgressFilter: "abc"}},
+				},
+			},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     2,
+				DesiredNumberScheduled: 3,
+				CurrentNumberScheduled: 3,
+				UpdatedNumberScheduled: 3,
+				NumberAvailable:        3,
+			},
+		}
+	})
+
+	Describe("#checkDaemonSet", func() {
+		It("should succeed if all pods are available", func() {
+			Expect(checkDaemonSet(ds)).To(Succeed())
+		})
+
+		It("should fail if pods are unavailable", func() {
+			ds.Status.NumberAvailable = 0
+			ds.Status.NumberUnavailable = 3
+			Expect(checkDaemonSet(ds)).To(MatchError("unavailable pods found (3/3)"))
+		})
+
+		It("should fail if pods are not scheduled", func() {
+			ds.Status.CurrentNumberScheduled = 2
+			Expect(checkDaemonSet(ds)).To(MatchError("not enough scheduled pods (2/3)"))
+		})
+
+		It("should fail if the generation is not observed yet", func() {
+			ds.Status.ObservedGeneration = 1
+			Expect(checkDaemonSet(ds)).To(MatchError("observed generation outdated (1/2)"))
+		})
+	})
+
+	Describe("#checkAppliedChecksum", func() {
+		It("should succeed if the checksum is applied on all nodes", func() {
+			Expect(checkAppliedChecksum(ds, "abc")).To(Succeed())
+		})
+
+		It("should fail if the DaemonSet is not updated yet", func() {
+			Expect(checkAppliedChecksum(ds, "def")).To(MatchError("filter list with checksum def is not deployed yet, found abc"))
+		})
+
+		It("should fail if the rollout is not completed", func() {
+			ds.Status.UpdatedNumberScheduled = 1
+			Expect(checkAppliedChecksum(ds, "abc")).To(MatchError("filter list with checksum abc is applied on 1/3 nodes"))
+		})
+	})
+
+	Describe("#checkDaemonSets", func() {
+		It("should report missing DaemonSets", func() {
+			result := checkDaemonSets(nil, checkDaemonSet, time.Minute)
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(Equal("no egress filter applier DaemonSets found"))
+		})
+
+		It("should report every failing DaemonSet with the progressing threshold", func() {
+			other := ds.DeepCopy()
+			other.Name = constants.ApplicationName + "-worker-b"
+			other.Status.NumberUnavailable = 1
+
+			result := checkDaemonSets([]appsv1.DaemonSet{*ds, *other}, checkDaemonSet, time.Minute)
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+			Expect(result.Detail).To(Equal("DaemonSet egress-filter-applier-worker-b: unavailable pods found (1/3)"))
+			Expect(result.ProgressingThreshold).To(Equal(ptr.To(time.Minute)))
+		})
+
+		It("should succeed if all DaemonSets are healthy", func() {
+			Expect(checkDaemonSets([]appsv1.DaemonSet{*ds}, checkDaemonSet, time.Minute).Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		})
+	})
+
+	Describe("#renderedChecksums", func() {
+		It("should return the checksum of the provider status", func() {
+			ex := &extensionsv1alpha1.Extension{}
+			checksum, profileChecksums, err := renderedChecksums(ex)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checksum).To(BeEmpty())
+			Expect(profileChecksums).To(BeEmpty())
+
+			ex.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"EgressFilterStatus","checksum":"abc"}`)}
+			checksum, profileChecksums, err = renderedChecksums(ex)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checksum).To(Equal("abc"))
+			Expect(profileChecksums).To(BeEmpty())
+		})
+
+		It("should return the checksums of the filter profiles", func() {
+			ex := &extensionsv1alpha1.Extension{}
+			ex.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"EgressFilterStatus","checksum":"abc","profiles":[{"name":"gpu","checksum":"def","ipv4Entries":1,"ipv6Entries":0}]}`)}
+			checksum, profileChecksums, err := renderedChecksums(ex)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checksum).To(Equal("abc"))
+			Expect(profileChecksums).To(Equal(map[string]string{"gpu": "def"}))
+		})
+	})
+
+	Describe("#usesApplier", func() {
+		It("should only check shoot Extensions enforced by the egress filter applier", func() {
+			ex := &extensionsv1alpha1.Extension{}
+			Expect(usesApplier(nil, nil, ex, nil)).To(BeTrue())
+
+			ex.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"EgressFilterStatus","enforcementBackend":"applier"}`)}
+			Expect(usesApplier(nil, nil, ex, nil)).To(BeTrue())
+
+			ex.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"EgressFilterStatus","enforcementBackend":"cilium"}`)}
+			Expect(usesApplier(nil, nil, ex, nil)).To(BeFalse())
+
+			ex.Spec.Class = new(extensionsv1alpha1.ExtensionClassSeed)
+			ex.Status.ProviderStatus = nil
+			Expect(usesApplier(nil, nil, ex, nil)).To(BeFalse())
+		})
+	})
+})
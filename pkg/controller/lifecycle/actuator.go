@@ -6,14 +6,16 @@ package lifecycle
 
 import (
 	"bytes"
+	"cmp"
 	"compress/gzip"
 	"context"
 	_ "embed"
 	"fmt"
 	"io"
+	"maps"
 	"net"
+	"net/url"
 	"slices"
-	"strconv"
 	"time"
 
 	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
@@ -25,7 +27,6 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kubernetesclient "github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/extensions"
-	"github.com/gardener/gardener/pkg/utils"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/go-logr/logr"
@@ -39,14 +40,20 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/imagevector"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/helper"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/backend"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/exporter"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/fqdn"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/signature"
 )
 
 const (
@@ -55,9 +62,46 @@ const (
 )
 
 // NewActuator returns an actuator responsible for Extension resources.
-func NewActuator(mgr manager.Manager, serviceConfig config.Configuration, oauth2secret *config.OAuth2Secret, extensionClasses []extensionsv1alpha1.ExtensionClass) (extension.Actuator, error) {
+func NewActuator(mgr manager.Manager, serviceConfig config.Configuration, oauth2secret *config.OAuth2Secret, oauth2Secrets map[string]*config.OAuth2Secret, extensionClasses []extensionsv1alpha1.ExtensionClass) (extension.Actuator, error) {
+	a, err := newActuator(mgr, serviceConfig, oauth2secret, oauth2Secrets, extensionClasses)
+	if err != nil {
+		return nil, err
+	}
+
+	// Changes of the downloaded filter list and of the FQDN resolutions are rolled out to the shoots at a limited rate
+	notifier, _ := a.provider.(filterListChangeNotifier)
+	rollout, err := newFilterListRollout(a.client, notifier, a.providerReady, a.extensionClasses, a.serviceConfig.EgressFilter.FilterListRollout, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(rollout); err != nil {
+		return nil, err
+	}
+	if a.fqdnCache != nil {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return a.runFQDNRefresh(ctx, rollout)
+		})); err != nil {
+			return nil, err
+		}
+	}
+
+	// The filter list secrets are watched to reconcile the Extensions immediately when they change
+	a.shootClusters = newShootClusterCache(a.logger)
+	if err := mgr.Add(a.shootClusters); err != nil {
+		return nil, err
+	}
+	if err := addSecretController(mgr, a.decoder, a.shootClusters.events); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// newActuator returns an actuator with a set up filter list provider. It is shared by the Extension controller and
+// the runtime networking filter, which refresh the FQDN resolutions on their own.
+func newActuator(mgr manager.Manager, serviceConfig config.Configuration, oauth2secret *config.OAuth2Secret, oauth2Secrets map[string]*config.OAuth2Secret, extensionClasses []extensionsv1alpha1.ExtensionClass) (*actuator, error) {
 	a := &actuator{
 		client:           mgr.GetClient(),
+		apiReader:        mgr.GetAPIReader(),
 		config:           mgr.GetConfig(),
 		scheme:           mgr.GetScheme(),
 		decoder:          serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
@@ -65,32 +109,108 @@ func NewActuator(mgr manager.Manager, serviceConfig config.Configuration, oauth2
 		serviceConfig:    serviceConfig,
 		oauth2secret:     oauth2secret,
 		extensionClasses: extensionClasses,
+
+		renderedFilterLists: newRenderedFilterListCache(),
+		fqdnReferences:      newFQDNReferences(),
+	}
+
+	switch a.serviceConfig.EgressFilter.Mode {
+	case "", config.FilterModeBlockList, config.FilterModeAllowList:
+	default:
+		return nil, fmt.Errorf("unexpected egressFilter.mode: %s", a.serviceConfig.EgressFilter.Mode)
+	}
+
+	verifier, err := NewSignatureVerifier(a.serviceConfig.EgressFilter.SignatureVerification)
+	if err != nil {
+		return nil, err
+	}
+	a.verifier = verifier
+
+	fqdnCache, err := newFQDNCache(a.serviceConfig.EgressFilter.FQDNResolution)
+	if err != nil {
+		if a.serviceConfig.EgressFilter.FQDNResolution != nil {
+			return nil, fmt.Errorf("invalid egressFilter.fqdnResolution: %w", err)
+		}
+		a.logger.Info("FQDN resolution disabled", "error", err.Error())
+	} else {
+		a.fqdnCache = fqdnCache
 	}
 
 	switch a.serviceConfig.EgressFilter.FilterListProviderType {
 	case config.FilterListProviderTypeStatic:
 		a.provider = newStaticFilterListProvider(context.Background(), a.client, a.logger, a.serviceConfig.EgressFilter.StaticFilterList)
 	case config.FilterListProviderTypeDownload:
+		if len(a.serviceConfig.EgressFilter.DownloadSources) > 0 {
+			if a.serviceConfig.EgressFilter.DownloaderConfig != nil {
+				return nil, fmt.Errorf("egressFilter.downloaderConfig and egressFilter.downloadSources are mutually exclusive")
+			}
+			a.provider = newMultiSourceFilterListProvider(context.Background(), a.client, a.logger,
+				a.serviceConfig.EgressFilter.DownloadSources, oauth2Secrets, a.verifier)
+			break
+		}
 		a.provider = newDownloaderFilterListProvider(context.Background(), a.client, a.logger,
-			a.serviceConfig.EgressFilter.DownloaderConfig, a.oauth2secret)
+			a.serviceConfig.EgressFilter.DownloaderConfig, a.oauth2secret, a.verifier)
 	default:
 		return nil, fmt.Errorf("unexpected FilterListProviderType: %s", a.serviceConfig.EgressFilter.FilterListProviderType)
 	}
-	a.logger.Info("Update filter list")
-	return a, a.provider.Setup()
+	a.providerReady = make(chan struct{})
+	if err := mgr.Add(&filterListProviderSetup{actuator: a}); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// filterListProviderSetup sets up the filter list provider of the actuator, i.e. downloads the initial filter list, once
+// the manager has been started, so that its health and readiness endpoints are served meanwhile.
+type filterListProviderSetup struct {
+	actuator *actuator
+}
+
+// Start implements manager.Runnable, it fails if the filter list provider cannot be set up.
+func (s *filterListProviderSetup) Start(context.Context) error {
+	s.actuator.logger.Info("Update filter list")
+	if err := s.actuator.provider.Setup(); err != nil {
+		return err
+	}
+	close(s.actuator.providerReady)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, all replicas need the filter list.
+func (s *filterListProviderSetup) NeedLeaderElection() bool {
+	return false
+}
+
+// waitForFilterListProvider waits until the filter list provider has been set up or the context is cancelled.
+func (a *actuator) waitForFilterListProvider(ctx context.Context) error {
+	select {
+	case <-a.providerReady:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("filter list is not available yet: %w", ctx.Err())
+	}
 }
 
 type actuator struct {
 	client           client.Client
+	apiReader        client.Reader
 	config           *rest.Config
 	decoder          runtime.Decoder
 	extensionClasses []extensionsv1alpha1.ExtensionClass
 	serviceConfig    config.Configuration
 	oauth2secret     *config.OAuth2Secret
 	provider         FilterListProvider
+	verifier         *signature.Verifier
+	fqdnCache        *fqdn.Cache
 	logger           logr.Logger
 	scheme           *runtime.Scheme
 	shootClient      client.Client
+	shootClusters    *shootClusterCache
+
+	renderedFilterLists *renderedFilterListCache
+	fqdnReferences      *fqdnReferences
+	// providerReady is closed once the filter list provider has been set up.
+	providerReady chan struct{}
 }
 
 // Reconcile the Extension resource.
@@ -104,12 +224,20 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, ex *extensionsv
 			constants.KeyIPV6List: []byte("[]"),
 		}
 		blackholingEnabledByWorker map[string]bool
+		profiles                   []applierProfile
+		status                     = &config.EgressFilterStatus{Source: config.FilterListSourceNone}
 		namespace                  = ex.GetNamespace()
 		isShootDeployment          = isShootDeployment(ex)
 		cluster                    *extensions.Cluster
 		err                        error
 	)
 
+	if err := a.waitForFilterListProvider(ctx); err != nil {
+		return err
+	}
+	// The FQDNs referenced by the filter lists are recorded again while they are rendered
+	a.fqdnReferences.delete(namespace)
+
 	if isShootDeployment {
 		cluster, err = controller.GetCluster(ctx, a.client, namespace)
 		if err != nil {
@@ -133,21 +261,55 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, ex *extensionsv
 		return fmt.Errorf("failed to validate provider config: %w", err)
 	}
 
+	// The seed and garden runtime clusters always use the egress filter applier
+	backendType := config.EnforcementBackendApplier
+	if isShootDeployment {
+		var networkingType string
+		if cluster.Shoot.Spec.Networking != nil {
+			networkingType = ptr.Deref(cluster.Shoot.Spec.Networking.Type, "")
+		}
+		var err error
+		backendType, err = helper.EnforcementBackend(a.serviceConfig.EgressFilter, internalShootConfig.EgressFilter, networkingType)
+		if err != nil {
+			return err
+		}
+	}
+	status.EnforcementBackend = backendType
+
 	if a.serviceConfig.EgressFilter != nil {
 		blackholingEnabled = a.serviceConfig.EgressFilter.BlackholingEnabled
 		tagFilters := a.serviceConfig.EgressFilter.TagFilters
+		var shootSourceTagFilters []config.TagFilter
 
 		if a.serviceConfig.EgressFilter.SleepDuration != nil {
 			sleepDuration = a.serviceConfig.EgressFilter.SleepDuration.Duration.String()
 		}
 
+		// The seed and garden runtime clusters are never filtered in mode allowList to keep them operable
+		mode := config.FilterModeBlockList
+		if isShootDeployment {
+			mode = filterMode(a.serviceConfig.EgressFilter, internalShootConfig.EgressFilter)
+		}
+		status.Mode = mode
+		enforcement := enforcementMode(a.serviceConfig.EgressFilter, internalShootConfig.EgressFilter)
+		status.EnforcementMode = enforcement
+		evaluation := policyEvaluation(a.serviceConfig.EgressFilter, internalShootConfig.EgressFilter)
+		status.PolicyEvaluation = evaluation
+
 		if internalShootConfig.EgressFilter != nil {
 			blackholingEnabled = internalShootConfig.EgressFilter.BlackholingEnabled
 			staticFilterList = internalShootConfig.EgressFilter.StaticFilterList
+			if isShootDeployment {
+				staticFilterList = restrictStaticFilterList(a.serviceConfig.EgressFilter, staticFilterList, a.logger)
+			}
 
 			if len(internalShootConfig.EgressFilter.TagFilters) > 0 {
 				// Append shoot-specific tag filters to service-level filters
-				tagFilters = append(tagFilters, internalShootConfig.EgressFilter.TagFilters...)
+				if isShootDeployment {
+					tagFilters, shootSourceTagFilters = restrictShootTagFilters(a.serviceConfig.EgressFilter, tagFilters, internalShootConfig.EgressFilter.TagFilters)
+				} else {
+					tagFilters = slices.Concat(tagFilters, internalShootConfig.EgressFilter.TagFilters)
+				}
 			}
 
 			if isShootDeployment {
@@ -165,6 +327,12 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, ex *extensionsv
 			}
 		}
 
+		shootStaticFilterList := staticFilterList
+		if mode == config.FilterModeAllowList {
+			safeguards := allowListSafeguardEntries(a.serviceConfig.EgressFilter.AllowListSafeguards)
+			staticFilterList = append(slices.Clone(staticFilterList), safeguards...)
+		}
+
 		staticProvider, ok := a.provider.(*StaticFilterListProvider)
 		if ok {
 			staticProvider.filterList = a.serviceConfig.EgressFilter.StaticFilterList
@@ -181,19 +349,100 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, ex *extensionsv
 			projectFilterListSource = internalShootConfig.EgressFilter.ProjectFilterListSource
 			shootFilterListSource = internalShootConfig.EgressFilter.ShootFilterListSource
 		}
-		secretData, err = a.readAndRestrictFilterListSecretData(ctx, cluster, namespace, staticFilterList, tagFilters, projectFilterListSource, shootFilterListSource)
+		if shootFilterListSource == nil && a.shootClusters != nil {
+			a.shootClusters.release(namespace)
+		}
+		secretData, err = a.readAndRestrictFilterListSecretData(ctx, cluster, namespace, mode, evaluation, enforcement, staticFilterList, tagFilters, shootSourceTagFilters, projectFilterListSource, shootFilterListSource, status)
 		if err != nil {
 			return err
 		}
+		if !helper.AuditSupported(backendType) {
+			secretData = ignoreAuditedLists(secretData, a.logger.WithValues("namespace", namespace, "backend", backendType), status)
+		}
+
+		endpoints, err := a.protectedEndpoints(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		a.fqdnReferences.add(namespace, protectedEndpointFQDNs(endpoints)...)
+		resolvedEndpoints := resolveProtectedEndpoints(ctx, a.fqdnCache, endpoints, a.logger)
+		secretData = protectConnectivity(secretData, resolvedEndpoints, a.logger, status)
+
+		if isShootDeployment && internalShootConfig.EgressFilter != nil {
+			// Filter profiles extend the filter lists of the shoot for the selected nodes, their statistics are
+			// reported in the status of the profiles only. They are only supported by the egress filter applier,
+			// which cannot audit entries, the entries audited for the whole shoot are reported in its status.
+			for _, profile := range internalShootConfig.EgressFilter.Profiles {
+				profileMode := cmp.Or(profile.Mode, mode)
+				profileStaticFilterList := slices.Concat(shootStaticFilterList, restrictStaticFilterList(a.serviceConfig.EgressFilter, profile.StaticFilterList, a.logger))
+				if profileMode == config.FilterModeAllowList {
+					profileStaticFilterList = append(profileStaticFilterList, allowListSafeguardEntries(a.serviceConfig.EgressFilter.AllowListSafeguards)...)
+				}
+				profileTagFilters, profileShootSourceTagFilters := restrictShootTagFilters(a.serviceConfig.EgressFilter, tagFilters, slices.Concat(shootSourceTagFilters, profile.TagFilters))
+				profileSecretData, err := a.readAndRestrictFilterListSecretData(ctx, cluster, namespace, profileMode, evaluation, enforcement, profileStaticFilterList, profileTagFilters, profileShootSourceTagFilters, projectFilterListSource, shootFilterListSource, &config.EgressFilterStatus{})
+				if err != nil {
+					return fmt.Errorf("failed to read filter lists of profile %s: %w", profile.Name, err)
+				}
+				profileSecretData = ignoreAuditedLists(profileSecretData, a.logger.WithValues("namespace", namespace, "profile", profile.Name), nil)
+				profileSecretData = protectConnectivity(profileSecretData, resolvedEndpoints, a.logger, &config.EgressFilterStatus{})
+
+				profileBlackholingEnabled := ptr.Deref(profile.BlackholingEnabled, blackholingEnabled)
+				if profileBlackholingEnabled || !applierPortFilteringSupported() {
+					delete(profileSecretData, constants.KeyPortList)
+				}
+				profileSecretData, _ = splitFilterEntries(profileSecretData)
+				profiles = append(profiles, applierProfile{
+					name:               profile.Name,
+					blackholingEnabled: profileBlackholingEnabled,
+					nodeRequirements:   profileNodeRequirements(profile),
+					secretData:         profileSecretData,
+				})
+				status.Profiles = append(status.Profiles, profileStatus(profile.Name, profileSecretData))
+			}
+		}
+	}
+
+	if _, ok := secretData[constants.KeyPortList]; ok && backendType == config.EnforcementBackendApplier && !applierPortFilteringSupported() {
+		a.logger.Info("Ignoring port- and protocol-scoped filter entries, the egress filter applier does not support them", "namespace", namespace)
+		delete(secretData, constants.KeyPortList)
+		status.PortScopedEntriesIgnored = true
+	}
+	if _, ok := secretData[constants.KeyPortList]; ok && backendType == config.EnforcementBackendApplier && !isFirewallModeUsed(blackholingEnabled, blackholingEnabledByWorker) {
+		a.logger.Info("Ignoring port- and protocol-scoped filter entries, blackhole routes cannot express them", "namespace", namespace)
+		delete(secretData, constants.KeyPortList)
+		status.PortScopedEntriesIgnored = true
 	}
 
-	shootResources, err := getShootResources(blackholingEnabled, sleepDuration, constants.NamespaceKubeSystem, secretData, blackholingEnabledByWorker)
+	// The blocked connection exporter parses the kernel log entries of the egress filter applier
+	secretData, filterEntries := splitFilterEntries(secretData)
+	applier := &applierBackend{
+		blackholingEnabled:            blackholingEnabled,
+		sleepDuration:                 sleepDuration,
+		workerGroupBlackholingEnabled: blackholingEnabledByWorker,
+		profiles:                      profiles,
+	}
+	if filterEntries != nil {
+		applier.exporterVals = &exporterValues{config: a.serviceConfig.EgressFilter.BlockedConnectionExporter, filterEntries: filterEntries}
+	}
+	var workloads *config.Workloads
+	if isShootDeployment && internalShootConfig.EgressFilter != nil {
+		workloads = internalShootConfig.EgressFilter.Workloads
+	}
+	shootBackend, err := newBackend(backendType, applier, workloads)
+	if err != nil {
+		return err
+	}
+
+	shootResources, err := getShootResources(shootBackend, constants.NamespaceKubeSystem, secretData)
 	if err != nil {
 		return err
 	}
 
 	if isShootDeployment {
-		return managedresources.CreateForShoot(ctx, a.client, namespace, constants.ManagedResourceNamesShoot, "gardener-extension-shoot-networking-filter", false, shootResources)
+		if err := managedresources.CreateForShoot(ctx, a.client, namespace, constants.ManagedResourceNamesShoot, "gardener-extension-shoot-networking-filter", false, shootResources); err != nil {
+			return err
+		}
+		return a.updateStatus(ctx, ex, status, secretData)
 	}
 	name, err := a.getRuntimeOrSeedManagedResourceName()
 	if err != nil {
@@ -209,7 +458,10 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, ex *extensionsv
 			shootResources = map[string][]byte{}
 		}
 	}
-	return managedresources.CreateForSeed(ctx, a.client, namespace, name, false, shootResources)
+	if err := managedresources.CreateForSeed(ctx, a.client, namespace, name, false, shootResources); err != nil {
+		return err
+	}
+	return a.updateStatus(ctx, ex, status, secretData)
 }
 
 // Delete the Extension resource.
@@ -217,6 +469,12 @@ func (a *actuator) Delete(ctx context.Context, _ logr.Logger, ex *extensionsv1al
 	namespace := ex.GetNamespace()
 	twoMinutes := 2 * time.Minute
 
+	defer a.renderedFilterLists.delete(namespace)
+	defer a.fqdnReferences.delete(namespace)
+	if a.shootClusters != nil {
+		defer a.shootClusters.release(namespace)
+	}
+
 	timeoutShootCtx, cancelShootCtx := context.WithTimeout(ctx, twoMinutes)
 	defer cancelShootCtx()
 
@@ -266,68 +524,135 @@ func (a *actuator) Migrate(ctx context.Context, log logr.Logger, ex *extensionsv
 	return a.Delete(ctx, log, ex)
 }
 
-func (a *actuator) readAndRestrictFilterListSecretData(ctx context.Context, cluster *controller.Cluster, namespace string, staticFilterList []config.Filter, tagFilters []config.TagFilter, projectFilterListSource *config.SecretRef, shootFilterListSource *config.SecretRef) (map[string][]byte, error) {
-	var combinedFilterList []config.Filter
-
+func (a *actuator) readAndRestrictFilterListSecretData(ctx context.Context, cluster *controller.Cluster, namespace string, mode config.FilterMode, evaluation config.PolicyEvaluation, enforcement config.EnforcementMode, staticFilterList []config.Filter, tagFilters, shootSourceTagFilters []config.TagFilter, projectFilterListSource *config.SecretRef, shootFilterListSource *config.SecretRef, status *config.EgressFilterStatus) (map[string][]byte, error) {
 	// Priority order:
-	// 1. shootFilterListSource (if configured) - highest priority, falls through only if secret is missing
-	// 2. projectFilterListSource (if configured) - falls through only if secret is missing
-	// 3. downloaded data (from service config) - final fallback
+	// 1. shootFilterListSource (if configured) - highest priority, skipped only if secret is missing or its signature is rejected
+	// 2. projectFilterListSource (if configured) - skipped only if secret is missing or its signature is rejected
+	// 3. downloaded data (from service config) - lowest priority
+	// A source with merge strategy `replace` replaces the sources of lower priority, otherwise its entries are added to
+	// theirs, see mergeFilterLists. The static filter list is appended to the entries of the sources, so that its
+	// entries take precedence over the ones of the sources for the same network with policy evaluation longestPrefixMatch.
+
+	if a.verifier != nil {
+		status.SignatureVerification = &config.SignatureVerificationStatus{}
+	}
+
+	var sources []sourceFilterList
 
 	if shootFilterListSource != nil {
-		shootClient, err := a.getShootClient(ctx, cluster)
+		shootClient, err := a.getShootClient(ctx, cluster, shootFilterListSource)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create shoot client: %w", err)
 		}
 		shootFilters, err := a.readShootFilterList(ctx, shootClient, shootFilterListSource)
 		if err != nil {
-			if !apierrors.IsNotFound(err) {
+			switch {
+			case apierrors.IsNotFound(err):
+				a.logger.Info("shootFilterListSource secret not found, falling back to next source")
+			case isSignatureError(err):
+				a.logger.Info("shootFilterListSource rejected, falling back to next source", "error", err)
+				recordSignatureVerificationFailure(status, string(config.FilterListSourceShoot), err)
+			default:
 				return nil, fmt.Errorf("failed to read shootFilterListSource: %w", err)
 			}
-			a.logger.Info("shootFilterListSource secret not found, falling back to next source")
 		} else {
-			a.logger.Info("using shoot filter list", "shootEntries", len(shootFilters), "staticEntries", len(staticFilterList))
-			if len(tagFilters) > 0 {
-				shootFilters = filterByTags(shootFilters, tagFilters, a.logger)
+			strategy := a.sourceMergeStrategy(config.FilterListSourceShoot, shootFilterListSource)
+			a.logger.Info("using shoot filter list", "shootEntries", len(shootFilters), "staticEntries", len(staticFilterList), "mergeStrategy", strategy)
+			// The tag filters restricted to the entries of the shoot filter list source are applied as well, see
+			// restrictShootTagFilters
+			if sourceTagFilters := slices.Concat(tagFilters, shootSourceTagFilters); len(sourceTagFilters) > 0 {
+				shootFilters = filterByTags(shootFilters, sourceTagFilters, a.logger)
 			}
-			return a.generateSecretData(ctx, append(staticFilterList, shootFilters...))
+			sources = append(sources, sourceFilterList{source: config.FilterListSourceShoot, strategy: strategy, entries: shootFilters})
 		}
 	}
 
-	if projectFilterListSource != nil {
+	if projectFilterListSource != nil && !replacesLowerPriorities(sources) {
 		projectFilters, err := a.readProjectFilterList(ctx, namespace, projectFilterListSource)
 		if err != nil {
-			if !apierrors.IsNotFound(err) {
+			switch {
+			case apierrors.IsNotFound(err):
+				a.logger.Info("projectFilterListSource secret not found, falling back to downloaded data")
+			case isSignatureError(err):
+				a.logger.Info("projectFilterListSource rejected, falling back to downloaded data", "error", err)
+				recordSignatureVerificationFailure(status, string(config.FilterListSourceProject), err)
+			default:
 				return nil, fmt.Errorf("failed to read projectFilterListSource: %w", err)
 			}
-			a.logger.Info("projectFilterListSource secret not found, falling back to downloaded data")
-			combinedFilterList = a.combineDownloadedAndStaticFilters(staticFilterList, tagFilters)
 		} else {
-			a.logger.Info("using project filter list instead of downloaded data", "projectEntries", len(projectFilters), "staticEntries", len(staticFilterList))
+			strategy := a.sourceMergeStrategy(config.FilterListSourceProject, projectFilterListSource)
+			a.logger.Info("using project filter list", "projectEntries", len(projectFilters), "staticEntries", len(staticFilterList), "mergeStrategy", strategy)
 			if len(tagFilters) > 0 {
 				projectFilters = filterByTags(projectFilters, tagFilters, a.logger)
 			}
-			combinedFilterList = append(staticFilterList, projectFilters...)
+			sources = append(sources, sourceFilterList{source: config.FilterListSourceProject, strategy: strategy, entries: projectFilters})
 		}
-	} else {
-		combinedFilterList = a.combineDownloadedAndStaticFilters(staticFilterList, tagFilters)
 	}
 
-	return a.generateSecretData(ctx, combinedFilterList)
+	combinedFilterList := combineFilterLists(sources, func() []config.Filter {
+		return a.downloadedFilterList(tagFilters, status)
+	}, a.logger)
+	if len(sources) > 0 {
+		status.Source = sources[0].source
+		status.MergeStrategy = sources[0].strategy
+	}
+
+	return a.generateSecretData(ctx, namespace, slices.Concat(combinedFilterList, staticFilterList), mode, evaluation, enforcement, tagFilters, status)
 }
 
-func (a *actuator) generateSecretData(ctx context.Context, combinedFilterList []config.Filter) (map[string][]byte, error) {
-	// Generate IPv4/IPv6 lists from combined filter list
-	ipv4List, ipv6List, err := generateEgressFilterValues(combinedFilterList, a.logger)
-	if err != nil {
-		return nil, err
+// sourceMergeStrategy returns the merge strategy of the project or shoot filter list source restricted to the limit
+// of the extension configuration.
+func (a *actuator) sourceMergeStrategy(source config.FilterListSource, ref *config.SecretRef) config.MergeStrategy {
+	strategy := restrictMergeStrategy(ref.MergeStrategy, mergeStrategyLimit(a.serviceConfig.EgressFilter, source))
+	if ref.MergeStrategy != "" && strategy != ref.MergeStrategy {
+		a.logger.Info("Restricting merge strategy of filter list source to the limit of the extension configuration", "source", source, "mergeStrategy", ref.MergeStrategy, "limit", strategy)
 	}
+	return strategy
+}
 
-	a.logger.Info("filter lists generated", constants.KeyIPV4List, len(ipv4List), constants.KeyIPV6List, len(ipv6List))
+func (a *actuator) generateSecretData(ctx context.Context, namespace string, combinedFilterList []config.Filter, mode config.FilterMode, evaluation config.PolicyEvaluation, enforcement config.EnforcementMode, tagFilters []config.TagFilter, status *config.EgressFilterStatus) (map[string][]byte, error) {
+	// The Extension is reconciled again when the addresses of the referenced FQDNs change, see runFQDNRefresh
+	lockedEntries := lockedFilterEntries(a.serviceConfig.EgressFilter.LockedEntries, a.provider.GetFilterList())
+	a.fqdnReferences.add(namespace, slices.Concat(filterFQDNs(combinedFilterList), filterFQDNs(lockedEntries))...)
+	combinedFilterList = resolveFQDNEntries(ctx, a.fqdnCache, combinedFilterList, a.logger)
 
+	split := splitAuditedEntries(combinedFilterList, mode, enforcement, tagFilters)
 	secretData := map[string][]byte{
-		constants.KeyIPV4List: []byte(convertToPlainYamlList(ipv4List)),
-		constants.KeyIPV6List: []byte(convertToPlainYamlList(ipv6List)),
+		constants.KeyIPV4List: []byte(convertToPlainYamlList(nil)),
+		constants.KeyIPV6List: []byte(convertToPlainYamlList(nil)),
+	}
+	if split.enforce {
+		enforcedData, err := a.renderFilterLists(split.enforced, mode, evaluation, status)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(secretData, enforcedData)
+	}
+	// The audited lists are only logged by the calico backend instead of blocked, other backends ignore them
+	if split.audit {
+		auditedData, err := a.renderFilterLists(split.audited, mode, evaluation, status)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range auditedData {
+			secretData[auditKeys[key]] = value
+		}
+	}
+	// Locked entries are blocked regardless of the allowed networks, tag filters, merge strategy and enforcement mode
+	lockedEntries = resolveFQDNEntries(ctx, a.fqdnCache, lockedEntries, a.logger)
+	secretData = lockNetworks(secretData, lockedEntries, a.logger, status)
+	a.logger.Info("filter lists generated", constants.KeyIPV4List, len(plainYamlListEntries(secretData[constants.KeyIPV4List])),
+		constants.KeyIPV6List, len(plainYamlListEntries(secretData[constants.KeyIPV6List])),
+		constants.KeyIPV4AuditList, len(plainYamlListEntries(secretData[constants.KeyIPV4AuditList])),
+		constants.KeyIPV6AuditList, len(plainYamlListEntries(secretData[constants.KeyIPV6AuditList])))
+
+	// The filter entries of the blocked connection exporter are split off again before the resources are generated
+	if isExporterEnabled(a.serviceConfig.EgressFilter) {
+		filterEntries, err := exporter.EncodeEntries(slices.Concat(combinedFilterList, lockedEntries))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode filter entries: %w", err)
+		}
+		secretData[constants.KeyFilterEntries] = filterEntries
 	}
 
 	// Apply seed load balancer filtering if configured
@@ -352,12 +677,41 @@ func (a *actuator) generateSecretData(ctx context.Context, combinedFilterList []
 		if !modified {
 			a.logger.Info("filterList unmodified by seed load balancers")
 		}
+		recordLoadBalancerCarveOuts(status, secretData, filteredSecretData)
 		return filteredSecretData, nil
 	}
 
 	return secretData, nil
 }
 
+// renderFilterLists renders the IPv4/IPv6 lists and, if needed, the port list of the given filter list entries.
+func (a *actuator) renderFilterLists(filterList []config.Filter, mode config.FilterMode, evaluation config.PolicyEvaluation, status *config.EgressFilterStatus) (map[string][]byte, error) {
+	generate := generateEgressFilterValuesWithStatus
+	switch {
+	case evaluation == config.PolicyEvaluationLongestPrefixMatch:
+		generate = func(entries []config.Filter, logger logr.Logger, status *config.EgressFilterStatus) ([]string, []string, error) {
+			return generateLongestPrefixMatchValuesWithStatus(entries, mode, logger, status)
+		}
+	case mode == config.FilterModeAllowList:
+		generate = generateAllowListValuesWithStatus
+	}
+	ipv4List, ipv6List, err := generate(filterList, a.logger, status)
+	if err != nil {
+		return nil, err
+	}
+
+	secretData := map[string][]byte{
+		constants.KeyIPV4List: []byte(convertToPlainYamlList(ipv4List)),
+		constants.KeyIPV6List: []byte(convertToPlainYamlList(ipv6List)),
+	}
+	// The port list is only added if needed to keep the checksum of filter lists without scoped entries stable
+	// In mode allowList all public networks are blocked for all ports anyway
+	if portList := generatePortFilterList(filterList, a.logger); len(portList) > 0 && mode != config.FilterModeAllowList {
+		secretData[constants.KeyPortList] = []byte(convertToPlainYamlList(portList))
+	}
+	return secretData, nil
+}
+
 func (a *actuator) getRuntimeOrSeedManagedResourceName() (string, error) {
 	for _, class := range a.extensionClasses {
 		if class == extensionsv1alpha1.ExtensionClassSeed {
@@ -370,21 +724,28 @@ func (a *actuator) getRuntimeOrSeedManagedResourceName() (string, error) {
 	return "", fmt.Errorf("no managed resource name as extension classes unexpected")
 }
 
-// combineDownloadedAndStaticFilters applies tag filters to downloaded data and combines with static filters
-func (a *actuator) combineDownloadedAndStaticFilters(staticFilterList []config.Filter, tagFilters []config.TagFilter) []config.Filter {
+// downloadedFilterList returns the downloaded data with the tag filters applied
+func (a *actuator) downloadedFilterList(tagFilters []config.TagFilter, status *config.EgressFilterStatus) []config.Filter {
+	status.Source = config.FilterListSourceDownload
+	if _, ok := a.provider.(*StaticFilterListProvider); ok {
+		status.Source = config.FilterListSourceStatic
+	}
+	if reporter, ok := a.provider.(signatureVerificationReporter); ok && status.SignatureVerification != nil {
+		status.SignatureVerification.Failures = append(status.SignatureVerification.Failures, reporter.signatureVerificationFailures()...)
+	}
 	downloadedFilterList := a.provider.GetFilterList()
 	if len(tagFilters) > 0 {
 		downloadedFilterList = filterByTags(downloadedFilterList, tagFilters, a.logger)
 	}
-	return append(downloadedFilterList, staticFilterList...)
+	return downloadedFilterList
 }
 
 // readProjectFilterList reads filter list from a Secret synced to the shoot namespace.
 // The Secret must be referenced in Shoot.spec.resources for automatic syncing.
 func (a *actuator) readProjectFilterList(ctx context.Context, namespace string, ref *config.SecretRef) ([]config.Filter, error) {
 	key := client.ObjectKey{
-		Namespace: namespace,         // Shoot namespace in seed
-		Name:      "ref-" + ref.Name, // Gardener adds "ref-" prefix to synced resources
+		Namespace: namespace,                      // Shoot namespace in seed
+		Name:      projectSecretPrefix + ref.Name, // Gardener adds "ref-" prefix to synced resources
 	}
 
 	// Get the key, default to "filterList"
@@ -402,11 +763,15 @@ func (a *actuator) readProjectFilterList(ctx context.Context, namespace string,
 		return nil, fmt.Errorf("failed to get Secret %s/%s (ensure it's listed in Shoot.spec.resources): %w", key.Namespace, key.Name, err)
 	}
 
-	return a.parseSecretFilterList(secret, dataKey, "project filter list")
+	return a.parseSecretFilterList(secret, dataKey, ref, config.FilterListSourceProject)
 }
 
-// / getShootClient creates a client for the shoot cluster
-func (a *actuator) getShootClient(ctx context.Context, cluster *controller.Cluster) (client.Client, error) {
+// getShootClient returns a client for the shoot cluster. The client of the Extension controller reads the secret of
+// the shoot filter list source from the cache of the shoot cluster, which is watched for changes.
+func (a *actuator) getShootClient(ctx context.Context, cluster *controller.Cluster, ref *config.SecretRef) (client.Client, error) {
+	if a.shootClusters != nil {
+		return a.shootClusters.get(ctx, a.client, cluster.ObjectMeta.Name, shootSecretKey(ref))
+	}
 	_, shootClient, err := util.NewClientForShoot(ctx, a.client, cluster.ObjectMeta.Name, client.Options{}, extensionsconfig.RESTOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create shoot client: %w", err)
@@ -417,16 +782,7 @@ func (a *actuator) getShootClient(ctx context.Context, cluster *controller.Clust
 // readShootFilterList reads filter list from a Secret in the shoot cluster.
 // Unlike readProjectFilterList, this reads directly from the shoot, not from synced secrets.
 func (a *actuator) readShootFilterList(ctx context.Context, shootClient client.Client, ref *config.SecretRef) ([]config.Filter, error) {
-	// Get namespace, default to "kube-system"
-	namespace := ref.Namespace
-	if namespace == "" {
-		namespace = "kube-system"
-	}
-
-	key := client.ObjectKey{
-		Namespace: namespace,
-		Name:      ref.Name,
-	}
+	key := shootSecretKey(ref)
 
 	// Get the key, default to "filterList"
 	dataKey := ref.Key
@@ -443,17 +799,45 @@ func (a *actuator) readShootFilterList(ctx context.Context, shootClient client.C
 		return nil, fmt.Errorf("failed to get Secret %s/%s from shoot cluster: %w", key.Namespace, key.Name, err)
 	}
 
-	return a.parseSecretFilterList(secret, dataKey, "shoot filter list")
+	return a.parseSecretFilterList(secret, dataKey, ref, config.FilterListSourceShoot)
+}
+
+// shootSecretKey returns the key of the secret of the shoot filter list source in the shoot cluster.
+func shootSecretKey(ref *config.SecretRef) client.ObjectKey {
+	// Get namespace, default to "kube-system"
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = "kube-system"
+	}
+
+	return client.ObjectKey{
+		Namespace: namespace,
+		Name:      ref.Name,
+	}
 }
 
-// parseSecretFilterList extracts, decompresses (if needed), and parses a filter list from a Secret.
+// parseSecretFilterList verifies (if enabled), extracts, decompresses (if needed), and parses a filter list from a Secret.
 // This is shared logic between readProjectFilterList and readShootFilterList.
-func (a *actuator) parseSecretFilterList(secret *corev1.Secret, dataKey string, logPrefix string) ([]config.Filter, error) {
+func (a *actuator) parseSecretFilterList(secret *corev1.Secret, dataKey string, ref *config.SecretRef, source config.FilterListSource) ([]config.Filter, error) {
+	logPrefix := string(source) + " filter list"
 	data, ok := secret.Data[dataKey]
 	if !ok {
 		return nil, fmt.Errorf("key %q not found in Secret %s/%s", dataKey, secret.Namespace, secret.Name)
 	}
 
+	// Verify the signature of the data as stored in the Secret, i.e. before decompression
+	if a.verifier != nil {
+		signatureKey := ref.SignatureKey
+		if signatureKey == "" {
+			signatureKey = dataKey + signatureSuffix
+		}
+		keyName, err := verifyFilterListSignature(a.verifier, string(source), data, secret.Data[signatureKey])
+		if err != nil {
+			return nil, err
+		}
+		a.logger.Info("verified "+logPrefix+" signature", "key", keyName)
+	}
+
 	// Try to decompress if gzip-encoded
 	gr, err := gzip.NewReader(bytes.NewReader(data))
 	if err == nil {
@@ -464,14 +848,15 @@ func (a *actuator) parseSecretFilterList(secret *corev1.Secret, dataKey string,
 		}
 		a.logger.Info("decompressed "+logPrefix, "compressed", len(data), "decompressed", len(decompressed))
 		data = decompressed
-	} else if err != gzip.ErrHeader {
-		// ErrHeader means it's not gzipped (plain text), which is fine
+	} else if err != gzip.ErrHeader && err != io.EOF && err != io.ErrUnexpectedEOF {
+		// ErrHeader means it's not gzipped (plain text), which is fine.
+		// EOF means the data is shorter than a gzip header, e.g. a plain text list with a single entry.
 		// Any other error is a real problem
 		return nil, fmt.Errorf("failed to create gzip reader: %w", err)
 	}
 
-	// Parse filter list (supports both v1 and v2 formats)
-	filters, err := parseFilterList(data)
+	// Parse filter list (JSON supports both v1 and v2 formats)
+	filters, err := parseFilterListWithFormat(data, ref.Format)
 	if err != nil {
 		return nil, err
 	}
@@ -479,66 +864,52 @@ func (a *actuator) parseSecretFilterList(secret *corev1.Secret, dataKey string,
 	return filters, nil
 }
 
-// filterByTags applies tag-based policy overrides to the filter list.
-// All entries are included, but entries matching tag filters get their policies overridden.
-func filterByTags(filterList []config.Filter, tagFilters []config.TagFilter, logger logr.Logger) []config.Filter {
-	if len(tagFilters) == 0 {
-		return filterList
-	}
-
-	result := make([]config.Filter, len(filterList))
-	overrideCount := 0
-
-	for i, filter := range filterList {
-		result[i] = filter
-		if matchingFilter := getMatchingTagFilterWithPolicy(filter, tagFilters); matchingFilter != nil && matchingFilter.Policy != nil {
-			// Override policy if tag filter specifies one
-			result[i].Policy = *matchingFilter.Policy
-			overrideCount++
+// protectedEndpoints returns the endpoints which must stay reachable to keep the cluster operable, i.e. the configured
+// registries and for shoots the advertised addresses of the API server, the node, pod and service networks of the
+// shoot and the seed, and the load balancers of the seed's istio ingress gateways which also serve the VPN.
+func (a *actuator) protectedEndpoints(ctx context.Context, cluster *extensions.Cluster) ([]protectedEndpoint, error) {
+	var endpoints []protectedEndpoint
+	if cluster != nil && cluster.Shoot != nil {
+		for _, address := range cluster.Shoot.Status.AdvertisedAddresses {
+			if u, err := url.Parse(address.URL); err == nil && u.Hostname() != "" {
+				endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointAPIServer, u.Hostname())...)
+			}
 		}
-		// Keep original policy if no matching tag filter or no policy specified
-	}
-
-	logger.Info("applied tag-based policy overrides", "total", len(filterList), "overridden", overrideCount)
-	return result
-}
-
-// getMatchingTagFilterWithPolicy checks if a filter matches any of the tag filters
-// and returns the matching tag filter with the highest priority (last in list).
-// Returns the matching filter if found, nil otherwise.
-func getMatchingTagFilterWithPolicy(filter config.Filter, tagFilters []config.TagFilter) *config.TagFilter {
-	// If no tags on filter, it doesn't match any tag filter (keeps original policy)
-	if len(filter.Tags) == 0 {
-		return nil
-	}
-
-	// Track all matching tag filters (later ones have higher priority)
-	var lastMatchingFilter *config.TagFilter
-
-	// Check each tag filter for a match
-	for i := range tagFilters {
-		if tagFilterMatches(filter, &tagFilters[i]) {
-			lastMatchingFilter = &tagFilters[i]
+		if networking := cluster.Shoot.Spec.Networking; networking != nil {
+			endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointShootNodes, ptr.Deref(networking.Nodes, ""))...)
+			endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointShootPods, ptr.Deref(networking.Pods, ""))...)
+			endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointShootServices, ptr.Deref(networking.Services, ""))...)
+		}
+		if networking := cluster.Shoot.Status.Networking; networking != nil {
+			endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointShootNodes, networking.Nodes...)...)
+			endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointShootPods, networking.Pods...)...)
+			endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointShootServices, networking.Services...)...)
 		}
 	}
-
-	return lastMatchingFilter
-}
-
-// tagFilterMatches checks if a filter matches a specific tag filter.
-// Returns true if the filter has the tag with any of the specified values.
-func tagFilterMatches(filter config.Filter, tagFilter *config.TagFilter) bool {
-	for _, filterTag := range filter.Tags {
-		if filterTag.Name == tagFilter.Name {
-			// Check if any value matches
-			for _, filterValue := range filterTag.Values {
-				if slices.Contains(tagFilter.Values, filterValue) {
-					return true
-				}
+	if cluster != nil && cluster.Seed != nil {
+		networks := cluster.Seed.Spec.Networks
+		endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointSeedNodes, ptr.Deref(networks.Nodes, ""))...)
+		endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointSeedPods, networks.Pods)...)
+		endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointSeedServices, networks.Services)...)
+
+		// The istio ingress gateways are read uncached as the seed's services are not watched otherwise
+		services := &corev1.ServiceList{}
+		if err := a.apiReader.List(ctx, services, client.MatchingLabels{v1beta1constants.LabelApp: constants.IstioIngressGatewayAppLabelValue}); err != nil {
+			return nil, fmt.Errorf("failed to list istio ingress gateway services: %w", err)
+		}
+		for _, svc := range services.Items {
+			if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+				continue
+			}
+			for _, ingress := range svc.Status.LoadBalancer.Ingress {
+				endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointSeedIngress, cmp.Or(ingress.IP, ingress.Hostname))...)
 			}
 		}
 	}
-	return false
+	if a.serviceConfig.EgressFilter.EnsureConnectivity != nil {
+		endpoints = append(endpoints, newProtectedEndpoints(protectedEndpointRegistry, a.serviceConfig.EgressFilter.EnsureConnectivity.Registries...)...)
+	}
+	return endpoints, nil
 }
 
 func (a *actuator) collectSeedLoadBalancersIPs(ctx context.Context, namespaces []string) ([]net.IP, error) {
@@ -576,10 +947,11 @@ func (a *actuator) collectSeedLoadBalancersIPs(ctx context.Context, namespaces [
 
 // GetShootResources creates resources needed for the egress filter daemonset.
 func GetShootResources(blackholingEnabled bool, sleepDuration, namespace string, secretData map[string][]byte) (map[string][]byte, error) {
-	return getShootResources(blackholingEnabled, sleepDuration, namespace, secretData, nil)
+	return getShootResources(&applierBackend{blackholingEnabled: blackholingEnabled, sleepDuration: sleepDuration}, namespace, secretData)
 }
 
-func getShootResources(blackholingEnabled bool, sleepDuration, namespace string, secretData map[string][]byte, workerGroupBlackholingEnabled map[string]bool) (map[string][]byte, error) {
+// getShootResources serializes the resources of the enforcement backend for the filter list secret data.
+func getShootResources(enforcementBackend backend.Backend, namespace string, secretData map[string][]byte) (map[string][]byte, error) {
 	shootRegistry := managedresources.NewRegistry(kubernetesclient.ShootScheme, kubernetesclient.ShootCodec, kubernetesclient.ShootSerializer)
 
 	if secretData == nil {
@@ -591,36 +963,9 @@ func getShootResources(blackholingEnabled bool, sleepDuration, namespace string,
 		}
 	}
 
-	checksumEgressFilter := utils.ComputeSecretChecksum(secretData)
-
-	var objects []client.Object
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      constants.EgressFilterSecretName,
-			Namespace: namespace,
-		},
-		Type: corev1.SecretTypeOpaque,
-		Data: secretData,
-	}
-	objects = append(objects, secret)
-
-	// Two cases:
-	// Case A: No worker group-specific blocking => Only one DS for everyone
-	if workerGroupBlackholingEnabled == nil {
-		daemonset, err := buildDaemonset(checksumEgressFilter, blackholingEnabled, sleepDuration, namespace, "")
-		if err != nil {
-			return nil, err
-		}
-		objects = append(objects, daemonset)
-	} else {
-		// Case B: Worker group-specific blocking => One DS per worker group
-		for workerGroup, blackholingEnabled := range workerGroupBlackholingEnabled {
-			daemonset, err := buildDaemonset(checksumEgressFilter, blackholingEnabled, sleepDuration, namespace, workerGroup)
-			if err != nil {
-				return nil, err
-			}
-			objects = append(objects, daemonset)
-		}
+	objects, err := enforcementBackend.Resources(namespace, secretData)
+	if err != nil {
+		return nil, err
 	}
 
 	shootResources, err := shootRegistry.AddAllAndSerialize(objects...)
@@ -630,7 +975,10 @@ func getShootResources(blackholingEnabled bool, sleepDuration, namespace string,
 	return shootResources, nil
 }
 
-func buildDaemonset(checksumEgressFilter string, blackholingEnabled bool, sleepDuration, namespace string, workerGroup string) (client.Object, error) {
+// buildDaemonset builds the DaemonSet of the egress filter applier. If portFilteringEnabled is true and the applier
+// supports it, the applier also applies the port- and protocol-scoped rules, which is only supported without
+// blackholing.
+func buildDaemonset(checksumEgressFilter string, blackholingEnabled, portFilteringEnabled bool, sleepDuration, namespace string, nodes applierNodes) (client.Object, error) {
 	var (
 		requestCPU, _          = resource.ParseQuantity("5m")
 		requestMemory, _       = resource.ParseQuantity("20Mi")
@@ -640,8 +988,8 @@ func buildDaemonset(checksumEgressFilter string, blackholingEnabled bool, sleepD
 	)
 
 	labels := map[string]string{
-		"k8s-app":             "egress-filter-applier",
-		"gardener.cloud/role": "system-component",
+		constants.LabelKeyApplication: constants.ApplicationName,
+		"gardener.cloud/role":         "system-component",
 	}
 
 	imageName := constants.ImageEgressFilter
@@ -669,7 +1017,7 @@ func buildDaemonset(checksumEgressFilter string, blackholingEnabled bool, sleepD
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: labels,
 					Annotations: map[string]string{
-						"checksum/" + constants.EgressFilterSecretName: checksumEgressFilter,
+						constants.AnnotationChecksumEgressFilter: checksumEgressFilter,
 					},
 				},
 				Spec: corev1.PodSpec{
@@ -708,13 +1056,7 @@ func buildDaemonset(checksumEgressFilter string, blackholingEnabled bool, sleepD
 						Image:           image.String(),
 						ImagePullPolicy: corev1.PullIfNotPresent,
 						Command:         []string{"/filter-updater"},
-						Args: []string{
-							fmt.Sprintf("-blackholing=%s", strconv.FormatBool(blackholingEnabled)),
-							fmt.Sprintf("-filter-list-dir=%s", constants.FilterListPath),
-							fmt.Sprintf("-filter-list-ipv4=%s", constants.KeyIPV4List),
-							fmt.Sprintf("-filter-list-ipv6=%s", constants.KeyIPV6List),
-							fmt.Sprintf("-sleep-duration=%s", sleepDuration),
-						},
+						Args:            applierArgs(blackholingEnabled, portFilteringEnabled, sleepDuration),
 						Resources: corev1.ResourceRequirements{
 							Requests: corev1.ResourceList{
 								corev1.ResourceCPU:    requestCPU,
@@ -773,16 +1115,42 @@ func buildDaemonset(checksumEgressFilter string, blackholingEnabled bool, sleepD
 		Type: corev1.SeccompProfileTypeRuntimeDefault,
 	}
 
-	if workerGroup != "" {
+	if nodes.workerGroup != "" {
 		ds.Spec.Template.Spec.NodeSelector = map[string]string{
-			v1beta1constants.LabelWorkerPool: workerGroup,
+			v1beta1constants.LabelWorkerPool: nodes.workerGroup,
+		}
+		ds.Name = applierResourceName(nodes.workerGroup)
+	}
+	if nodes.profile != "" {
+		ds.Name = applierResourceName(nodes.profile)
+		// The label is not part of the selector, which must not change for existing DaemonSets
+		ds.Labels = maps.Clone(labels)
+		ds.Labels[constants.LabelKeyProfile] = nodes.profile
+		ds.Spec.Template.Spec.Volumes[0].Secret.SecretName = ds.Name
+	}
+	if len(nodes.nodeSelectorTerms) > 0 {
+		ds.Spec.Template.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: nodes.nodeSelectorTerms},
 		}
-		ds.Name = fmt.Sprintf("%s-%s", ds.Name, workerGroup)
 	}
 
 	return ds, nil
 }
 
+// isFirewallModeUsed returns true if the egress filter is applied with the firewall approach on any node, i.e. if
+// blackholing is disabled for the shoot or any worker group.
+func isFirewallModeUsed(blackholingEnabled bool, blackholingEnabledByWorker map[string]bool) bool {
+	if blackholingEnabledByWorker == nil {
+		return !blackholingEnabled
+	}
+	for _, enabled := range blackholingEnabledByWorker {
+		if !enabled {
+			return true
+		}
+	}
+	return false
+}
+
 func isShootDeployment(ex *extensionsv1alpha1.Extension) bool {
 	return extensionsv1alpha1helper.GetExtensionClassOrDefault(ex.Spec.Class) == extensionsv1alpha1.ExtensionClassShoot
 }
@@ -49,7 +49,8 @@ func AddToManager(ctx context.Context, mgr manager.Manager) error {
 		extensionClasses = []extensionsv1alpha1.ExtensionClass{extensionsv1alpha1.ExtensionClassGarden}
 	}
 
-	actuator, err := NewActuator(mgr, DefaultAddOptions.ServiceConfig.Configuration, DefaultAddOptions.ServiceConfig.OAuth2Secret, extensionClasses)
+	actuator, err := NewActuator(mgr, DefaultAddOptions.ServiceConfig.Configuration, DefaultAddOptions.ServiceConfig.OAuth2Secret,
+		DefaultAddOptions.ServiceConfig.OAuth2Secrets, extensionClasses)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"net/netip"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+)
+
+var (
+	// publicRanges are the ranges blocked in mode `allowList` unless they are private, reserved or allowed.
+	// For IPv6 only global unicast addresses (RFC4291) are blocked, so that e.g. neighbor discovery keeps working.
+	publicRanges = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/0"),
+		netip.MustParsePrefix("2000::/3"),
+	}
+	// nonPublicIPv4Ranges are IPv4 ranges which are not blocked in mode `allowList` in addition to the private ranges.
+	nonPublicIPv4Ranges = []netip.Prefix{
+		// "this network" (RFC1122)
+		netip.MustParsePrefix("0.0.0.0/8"),
+		// Multicast (RFC5771)
+		netip.MustParsePrefix("224.0.0.0/4"),
+		// Reserved and limited broadcast (RFC1112, RFC919)
+		netip.MustParsePrefix("240.0.0.0/4"),
+	}
+)
+
+// filterMode returns the filter mode of the shoot configuration if set, otherwise the one of the extension configuration.
+func filterMode(serviceConfig, shootConfig *config.EgressFilter) config.FilterMode {
+	if shootConfig != nil && shootConfig.Mode != "" {
+		return shootConfig.Mode
+	}
+	if serviceConfig != nil && serviceConfig.Mode != "" {
+		return serviceConfig.Mode
+	}
+	return config.FilterModeBlockList
+}
+
+// generateAllowListValuesWithStatus generates the IPv4/IPv6 lists of mode `allowList`. All public networks are blocked
+// except the allowed networks of the filter list. Blocked networks of the filter list are blocked anyway and port- or
+// protocol-scoped entries cannot be expressed as exceptions, so both are ignored.
+// If status is not nil, the carve-outs by allowed networks and ignored scoped entries are recorded in it.
+func generateAllowListValuesWithStatus(entries []config.Filter, logger logr.Logger, status *config.EgressFilterStatus) ([]string, []string, error) {
+	var allowed cidrset.Builder
+	blocked := blockedPublicRanges()
+
+	// FQDN entries are expected to be resolved into network entries before, see resolveFQDNEntries.
+	var scoped int
+	for _, entry := range entries {
+		if entry.Policy != config.PolicyAllowAccess || entry.FQDN != "" {
+			continue
+		}
+		if entry.Protocol != "" {
+			scoped++
+			continue
+		}
+		prefix, err := parsePrefix(entry.Network)
+		if err != nil {
+			logger.Error(err, "Error parsing CIDR from allow list, ignoring it", "offending CIDR", entry.Network)
+			continue
+		}
+		allowed.AddPrefix(prefix)
+	}
+	if scoped > 0 {
+		logger.Info("Ignoring port- and protocol-scoped allowed networks in mode allowList", "entries", scoped)
+		if status != nil {
+			status.PortScopedEntriesIgnored = true
+		}
+	}
+
+	carveOutAllowedNetworks(blocked, allowed.Set(), logger, status)
+
+	ipv4List, ipv6List := prefixListToStringLists(blocked.Set().Prefixes())
+	return ipv4List, ipv6List, nil
+}
+
+// blockedPublicRanges returns the public ranges blocked in mode `allowList` unless they are allowed.
+func blockedPublicRanges() *cidrset.Builder {
+	blocked := &cidrset.Builder{}
+	for _, prefix := range publicRanges {
+		blocked.AddPrefix(prefix)
+	}
+	for _, prefix := range append(append(privateIPv4Ranges, nonPublicIPv4Ranges...), privateIPv6Ranges...) {
+		blocked.RemovePrefix(prefix)
+	}
+	return blocked
+}
+
+// allowListSafeguardEntries returns `ALLOW_ACCESS` entries for the safeguards of mode `allowList`.
+// The endpoints of the cluster are kept reachable in all modes, see protectConnectivity.
+func allowListSafeguardEntries(safeguards *config.AllowListSafeguards) []config.Filter {
+	if safeguards == nil {
+		return nil
+	}
+	var result []config.Filter
+	for _, network := range safeguards.Networks {
+		result = append(result, config.Filter{Network: network, Policy: config.PolicyAllowAccess})
+	}
+	for _, name := range safeguards.FQDNs {
+		result = append(result, config.Filter{FQDN: normalizeFQDN(name), Policy: config.PolicyAllowAccess})
+	}
+	return result
+}
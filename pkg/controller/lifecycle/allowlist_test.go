@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"net/netip"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+)
+
+var _ = Describe("Allow-list mode", func() {
+	DescribeTable("#filterMode", func(serviceConfig, shootConfig *config.EgressFilter, expected config.FilterMode) {
+		Expect(filterMode(serviceConfig, shootConfig)).To(Equal(expected))
+	},
+		Entry("default", nil, nil, config.FilterModeBlockList),
+		Entry("extension configuration", &config.EgressFilter{Mode: config.FilterModeAllowList}, &config.EgressFilter{}, config.FilterModeAllowList),
+		Entry("shoot configuration", &config.EgressFilter{Mode: config.FilterModeAllowList}, &config.EgressFilter{Mode: config.FilterModeBlockList}, config.FilterModeBlockList),
+	)
+
+	Describe("#generateAllowListValuesWithStatus", func() {
+		toSet := func(ipv4List, ipv6List []string) *cidrset.Set {
+			var builder cidrset.Builder
+			for _, cidr := range append(ipv4List, ipv6List...) {
+				builder.AddPrefix(netip.MustParsePrefix(cidr))
+			}
+			return builder.Set()
+		}
+
+		It("should block all public networks without allowed networks", func() {
+			ipv4List, ipv6List, err := generateAllowListValuesWithStatus(nil, logr.Discard(), nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ipv4List).To(ContainElements("1.0.0.0/8", "128.0.0.0/3", "11.0.0.0/8"))
+			Expect(ipv6List).To(Equal([]string{"2000::/3"}))
+
+			blocked := toSet(ipv4List, ipv6List)
+			for _, addr := range []string{"8.8.8.8", "100.63.255.255", "100.128.0.0", "223.255.255.255", "2001:db8::1"} {
+				Expect(blocked.Contains(netip.MustParseAddr(addr))).To(BeTrue(), addr)
+			}
+			for _, addr := range []string{"0.0.0.1", "10.1.2.3", "100.64.0.1", "127.0.0.1", "169.254.169.254", "172.16.0.1", "192.168.1.1", "224.0.0.1", "255.255.255.255", "::1", "fe80::1", "fd00::1", "ff02::1"} {
+				Expect(blocked.Contains(netip.MustParseAddr(addr))).To(BeFalse(), addr)
+			}
+		})
+
+		It("should carve out allowed networks and ignore blocked and port-scoped entries", func() {
+			status := &config.EgressFilterStatus{}
+			ipv4List, ipv6List, err := generateAllowListValuesWithStatus([]config.Filter{
+				{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess},
+				{Network: "2001:db8::/32", Policy: config.PolicyAllowAccess},
+				{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP},
+				{Network: "10.0.0.0/8", Policy: config.PolicyBlockAccess},
+				{Network: "invalid", Policy: config.PolicyAllowAccess},
+			}, logr.Discard(), status)
+			Expect(err).NotTo(HaveOccurred())
+
+			blocked := toSet(ipv4List, ipv6List)
+			Expect(blocked.OverlapsPrefix(netip.MustParsePrefix("192.0.2.0/24"))).To(BeFalse())
+			Expect(blocked.OverlapsPrefix(netip.MustParsePrefix("2001:db8::/32"))).To(BeFalse())
+			Expect(blocked.ContainsPrefix(netip.MustParsePrefix("198.51.100.0/24"))).To(BeTrue())
+			Expect(blocked.Contains(netip.MustParseAddr("10.1.2.3"))).To(BeFalse())
+			Expect(blocked.Contains(netip.MustParseAddr("2001:db9::1"))).To(BeTrue())
+
+			Expect(status.IPv4.AllowCarveOuts).To(Equal(1))
+			Expect(status.IPv6.AllowCarveOuts).To(Equal(1))
+			Expect(status.PortScopedEntriesIgnored).To(BeTrue())
+			Expect(status.DroppedPrivateEntriesCount).To(BeZero())
+		})
+	})
+
+	DescribeTable("#allowListSafeguardEntries", func(safeguards *config.AllowListSafeguards, expected []config.Filter) {
+		Expect(allowListSafeguardEntries(safeguards)).To(Equal(expected))
+	},
+		Entry("none", nil, nil),
+		Entry("networks and FQDNs",
+			&config.AllowListSafeguards{Networks: []string{"192.0.2.0/24"}, FQDNs: []string{"Registry.Example.com."}},
+			[]config.Filter{
+				{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess},
+				{FQDN: "registry.example.com", Policy: config.PolicyAllowAccess},
+			},
+		),
+	)
+})
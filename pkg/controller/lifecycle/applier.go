@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+const applierFlagPortList = "filter-list-ports"
+
+// applierFlags are the flags of the filter updater of the egress filter applier image in the image vector. The filter
+// updater exits on unknown flags, so further flags must only be passed once the image supports them.
+var applierFlags = sets.New(
+	"blackholing",
+	"filter-list-dir",
+	"filter-list-ipv4",
+	"filter-list-ipv6",
+	"sleep-duration",
+)
+
+// applierPortFilteringSupported returns true if the egress filter applier applies port- and protocol-scoped rules.
+func applierPortFilteringSupported() bool {
+	return applierFlags.Has(applierFlagPortList)
+}
+
+// applierArgs returns the arguments of the filter updater of the egress filter applier. The port list is only passed
+// if portFilteringEnabled is true and the applier supports it.
+func applierArgs(blackholingEnabled, portFilteringEnabled bool, sleepDuration string) []string {
+	args := []string{
+		fmt.Sprintf("-blackholing=%s", strconv.FormatBool(blackholingEnabled)),
+		fmt.Sprintf("-filter-list-dir=%s", constants.FilterListPath),
+		fmt.Sprintf("-filter-list-ipv4=%s", constants.KeyIPV4List),
+		fmt.Sprintf("-filter-list-ipv6=%s", constants.KeyIPV6List),
+		fmt.Sprintf("-sleep-duration=%s", sleepDuration),
+	}
+	if portFilteringEnabled && applierPortFilteringSupported() {
+		args = append(args, fmt.Sprintf("-%s=%s", applierFlagPortList, constants.KeyPortList))
+	}
+	return args
+}
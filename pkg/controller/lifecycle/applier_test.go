@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// filterUpdaterFlags are the flags accepted by the filter updater of the egress filter applier image 0.20.1.
+var filterUpdaterFlags = []string{"blackholing", "filter-list-dir", "filter-list-ipv4", "filter-list-ipv6", "sleep-duration"}
+
+var _ = Describe("Applier", func() {
+	It("should use the applier image the flags are known for", func() {
+		data, err := os.ReadFile("../../../imagevector/images.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		var imageVector struct {
+			Images []struct {
+				Name string `json:"name"`
+				Tag  string `json:"tag"`
+			} `json:"images"`
+		}
+		Expect(yaml.Unmarshal(data, &imageVector)).To(Succeed())
+
+		var tag string
+		for _, image := range imageVector.Images {
+			if image.Name == constants.ImageEgressFilter {
+				tag = image.Tag
+			}
+		}
+		Expect(tag).To(Equal("0.20.1"), "update filterUpdaterFlags and applierFlags for the new applier image")
+	})
+
+	It("should only pass flags the applier accepts", func() {
+		Expect(filterUpdaterFlags).To(ContainElements(applierFlags.UnsortedList()))
+		for _, blackholingEnabled := range []bool{false, true} {
+			for _, portFilteringEnabled := range []bool{false, true} {
+				for _, arg := range applierArgs(blackholingEnabled, portFilteringEnabled, "1h") {
+					name, _, ok := strings.Cut(strings.TrimPrefix(arg, "-"), "=")
+					Expect(ok).To(BeTrue(), arg)
+					Expect(filterUpdaterFlags).To(ContainElement(name), arg)
+				}
+			}
+		}
+	})
+
+	It("should not pass the port list as long as the applier does not support it", func() {
+		Expect(applierPortFilteringSupported()).To(BeFalse())
+		Expect(applierArgs(false, true, "1h")).To(Equal([]string{
+			"-blackholing=false",
+			"-filter-list-dir=lists",
+			"-filter-list-ipv4=ipv4-list",
+			"-filter-list-ipv6=ipv6-list",
+			"-sleep-duration=1h",
+		}))
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// auditKeys maps the keys of the enforced filter lists in the secret to the keys of the audited ones.
+var auditKeys = map[string]string{
+	constants.KeyIPV4List: constants.KeyIPV4AuditList,
+	constants.KeyIPV6List: constants.KeyIPV6AuditList,
+	constants.KeyPortList: constants.KeyPortAuditList,
+}
+
+// ignoreAuditedLists removes the audited filter lists from the secret data of an enforcement backend which cannot
+// audit entries. The audited entries are not part of the enforced filter lists, so they are neither blocked nor logged.
+// If status is not nil, the number of ignored networks and port-scoped rules is recorded in it.
+func ignoreAuditedLists(secretData map[string][]byte, logger logr.Logger, status *config.EgressFilterStatus) map[string][]byte {
+	var ignored int
+	for _, key := range auditKeys {
+		if value, ok := secretData[key]; ok {
+			ignored += len(plainYamlListEntries(value))
+			delete(secretData, key)
+		}
+	}
+	if ignored > 0 {
+		logger.Info("Ignoring audited filter entries, the enforcement backend cannot audit them", "entries", ignored)
+	}
+	if status != nil {
+		status.IgnoredAuditedEntries = ignored
+	}
+	return secretData
+}
+
+// enforcementMode returns the enforcement mode of the shoot configuration if set, otherwise the one of the extension
+// configuration.
+func enforcementMode(serviceConfig, shootConfig *config.EgressFilter) config.EnforcementMode {
+	if shootConfig != nil && shootConfig.EnforcementMode != "" {
+		return shootConfig.EnforcementMode
+	}
+	if serviceConfig != nil && serviceConfig.EnforcementMode != "" {
+		return serviceConfig.EnforcementMode
+	}
+	return config.EnforcementModeEnforce
+}
+
+// entryEnforcementMode returns the enforcement mode of the tag filter with the highest priority matching the entry
+// which sets one, of several ones with the same priority the last one, otherwise the given default.
+func entryEnforcementMode(filter config.Filter, tagFilters []config.TagFilter, defaultMode config.EnforcementMode) config.EnforcementMode {
+	var matchingFilter *config.TagFilter
+	for i := range tagFilters {
+		if tagFilters[i].EnforcementMode != "" && tagFilterMatches(filter, &tagFilters[i]) && tagFilterPrecedes(&tagFilters[i], matchingFilter) {
+			matchingFilter = &tagFilters[i]
+		}
+	}
+	if matchingFilter == nil {
+		return defaultMode
+	}
+	return matchingFilter.EnforcementMode
+}
+
+// enforcementSplit contains the entries of the enforced and the audited filter lists. Each list is only rendered if
+// its flag is set, as an empty filter list still blocks all public networks in mode `allowList`.
+type enforcementSplit struct {
+	enforced []config.Filter
+	audited  []config.Filter
+	enforce  bool
+	audit    bool
+}
+
+// splitAuditedEntries splits the filter list into the entries which are enforced and the ones which are only audited.
+// Entries which do not block anything, e.g. allowed networks carving out blocked ones, are part of both lists.
+// In mode `allowList` the allowed networks define the blocked ones, so the whole filter list is either enforced or
+// audited. The audited list is only rendered if any entries are audited to keep the checksum of enforced lists stable.
+func splitAuditedEntries(filterList []config.Filter, mode config.FilterMode, defaultMode config.EnforcementMode, tagFilters []config.TagFilter) enforcementSplit {
+	if mode == config.FilterModeAllowList {
+		if defaultMode == config.EnforcementModeAudit {
+			return enforcementSplit{audited: filterList, audit: true}
+		}
+		return enforcementSplit{enforced: filterList, enforce: true}
+	}
+
+	split := enforcementSplit{enforce: true, audit: defaultMode == config.EnforcementModeAudit}
+	for _, filter := range filterList {
+		if filter.Policy != config.PolicyBlockAccess {
+			split.enforced = append(split.enforced, filter)
+			split.audited = append(split.audited, filter)
+			continue
+		}
+		if entryEnforcementMode(filter, tagFilters, defaultMode) == config.EnforcementModeAudit {
+			split.audited = append(split.audited, filter)
+			split.audit = true
+		} else {
+			split.enforced = append(split.enforced, filter)
+		}
+	}
+	if !split.audit {
+		return enforcementSplit{enforced: filterList, enforce: true}
+	}
+	return split
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Audit mode", func() {
+	var (
+		botnet   = config.Filter{Network: "192.0.2.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "threat", Values: []string{"botnet"}}}}
+		phishing = config.Filter{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "threat", Values: []string{"phishing"}}}}
+		untagged = config.Filter{Network: "203.0.113.0/24", Policy: config.PolicyBlockAccess}
+		allowed  = config.Filter{Network: "192.0.2.128/25", Policy: config.PolicyAllowAccess}
+
+		filterList = []config.Filter{botnet, phishing, untagged, allowed}
+	)
+
+	DescribeTable("#enforcementMode", func(serviceConfig, shootConfig *config.EgressFilter, expected config.EnforcementMode) {
+		Expect(enforcementMode(serviceConfig, shootConfig)).To(Equal(expected))
+	},
+		Entry("default", nil, nil, config.EnforcementModeEnforce),
+		Entry("extension configuration", &config.EgressFilter{EnforcementMode: config.EnforcementModeAudit}, &config.EgressFilter{}, config.EnforcementModeAudit),
+		Entry("shoot configuration", &config.EgressFilter{EnforcementMode: config.EnforcementModeAudit}, &config.EgressFilter{EnforcementMode: config.EnforcementModeEnforce}, config.EnforcementModeEnforce),
+	)
+
+	Describe("#splitAuditedEntries", func() {
+		It("should enforce all entries by default", func() {
+			Expect(splitAuditedEntries(filterList, config.FilterModeBlockList, config.EnforcementModeEnforce, nil)).To(Equal(enforcementSplit{enforced: filterList, enforce: true}))
+		})
+
+		It("should enforce all entries if no tag filter audits matching entries", func() {
+			tagFilters := []config.TagFilter{{Name: "threat", Values: []string{"malware"}, EnforcementMode: config.EnforcementModeAudit}}
+			Expect(splitAuditedEntries(filterList, config.FilterModeBlockList, config.EnforcementModeEnforce, tagFilters)).To(Equal(enforcementSplit{enforced: filterList, enforce: true}))
+		})
+
+		It("should audit the entries of audited tags", func() {
+			tagFilters := []config.TagFilter{
+				{Name: "threat", Values: []string{"botnet", "phishing"}, EnforcementMode: config.EnforcementModeAudit},
+				{Name: "threat", Values: []string{"botnet"}, Policy: new(config.PolicyBlockAccess)},
+			}
+			Expect(splitAuditedEntries(filterList, config.FilterModeBlockList, config.EnforcementModeEnforce, tagFilters)).To(Equal(enforcementSplit{
+				enforced: []config.Filter{untagged, allowed},
+				audited:  []config.Filter{botnet, phishing, allowed},
+				enforce:  true,
+				audit:    true,
+			}))
+		})
+
+		It("should enforce the entries of enforced tags in audit mode", func() {
+			tagFilters := []config.TagFilter{
+				{Name: "threat", Values: []string{"botnet", "phishing"}, EnforcementMode: config.EnforcementModeAudit},
+				{Name: "threat", Values: []string{"botnet"}, EnforcementMode: config.EnforcementModeEnforce},
+			}
+			Expect(splitAuditedEntries(filterList, config.FilterModeBlockList, config.EnforcementModeAudit, tagFilters)).To(Equal(enforcementSplit{
+				enforced: []config.Filter{botnet, allowed},
+				audited:  []config.Filter{phishing, untagged, allowed},
+				enforce:  true,
+				audit:    true,
+			}))
+		})
+
+		It("should audit the whole filter list in mode allowList", func() {
+			tagFilters := []config.TagFilter{{Name: "threat", Values: []string{"botnet"}, EnforcementMode: config.EnforcementModeEnforce}}
+			Expect(splitAuditedEntries(filterList, config.FilterModeAllowList, config.EnforcementModeAudit, tagFilters)).To(Equal(enforcementSplit{audited: filterList, audit: true}))
+			Expect(splitAuditedEntries(nil, config.FilterModeAllowList, config.EnforcementModeAudit, nil)).To(Equal(enforcementSplit{audit: true}))
+		})
+	})
+
+	Describe("#ignoreAuditedLists", func() {
+		It("should remove the audited filter lists and report the number of their entries", func() {
+			secretData := map[string][]byte{
+				constants.KeyIPV4List:      []byte(convertToPlainYamlList([]string{"192.0.2.0/24"})),
+				constants.KeyIPV6List:      []byte(convertToPlainYamlList(nil)),
+				constants.KeyIPV4AuditList: []byte(convertToPlainYamlList([]string{"198.51.100.0/24", "203.0.113.0/24"})),
+				constants.KeyIPV6AuditList: []byte(convertToPlainYamlList([]string{"2001:db8::/32"})),
+			}
+			status := &config.EgressFilterStatus{}
+
+			Expect(ignoreAuditedLists(secretData, logr.Discard(), status)).To(Equal(map[string][]byte{
+				constants.KeyIPV4List: []byte(convertToPlainYamlList([]string{"192.0.2.0/24"})),
+				constants.KeyIPV6List: []byte(convertToPlainYamlList(nil)),
+			}))
+			Expect(status.IgnoredAuditedEntries).To(Equal(3))
+		})
+
+		It("should not report ignored entries without audited filter lists", func() {
+			secretData := map[string][]byte{constants.KeyIPV4List: []byte(convertToPlainYamlList([]string{"192.0.2.0/24"}))}
+			status := &config.EgressFilterStatus{IgnoredAuditedEntries: 1}
+
+			Expect(ignoreAuditedLists(secretData, logr.Discard(), status)).To(HaveLen(1))
+			Expect(status.IgnoredAuditedEntries).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/backend"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// newBackend returns the enforcement backend of the given type. The applier backend is only used for this type.
+// The egress filter applier filters the traffic of whole nodes, so it cannot select workloads, while the network
+// policies of the other backends cannot apply filter profiles to selected nodes.
+func newBackend(backendType config.EnforcementBackend, applier *applierBackend, workloads *config.Workloads) (backend.Backend, error) {
+	if backendType != config.EnforcementBackendApplier && len(applier.profiles) > 0 {
+		return nil, fmt.Errorf("filter profiles are not supported with enforcement backend %s", backendType)
+	}
+	switch backendType {
+	case config.EnforcementBackendApplier:
+		if workloads != nil {
+			return nil, fmt.Errorf("workloads cannot be selected with enforcement backend %s", backendType)
+		}
+		return applier, nil
+	case config.EnforcementBackendCilium:
+		return backend.Cilium{Workloads: workloads}, nil
+	case config.EnforcementBackendCalico:
+		return backend.Calico{Workloads: workloads}, nil
+	}
+	return nil, fmt.Errorf("unsupported enforcement backend %q", backendType)
+}
+
+// applierBackend deploys the egress filter applier, which applies the filter lists as iptables rules or blackhole
+// routes on the nodes, and optionally the blocked connection exporter.
+type applierBackend struct {
+	blackholingEnabled            bool
+	sleepDuration                 string
+	workerGroupBlackholingEnabled map[string]bool
+	profiles                      []applierProfile
+	exporterVals                  *exporterValues
+}
+
+var _ backend.Backend = &applierBackend{}
+
+// Resources implements backend.Backend.
+func (b *applierBackend) Resources(namespace string, secretData map[string][]byte) ([]client.Object, error) {
+	checksumEgressFilter := utils.ComputeSecretChecksum(secretData)
+	_, portFilteringEnabled := secretData[constants.KeyPortList]
+
+	var objects []client.Object
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.EgressFilterSecretName,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: secretData,
+	}
+	objects = append(objects, secret)
+
+	switch {
+	case b.workerGroupBlackholingEnabled != nil:
+		// Worker group-specific blocking => One DS per worker group
+		for workerGroup, blackholingEnabled := range b.workerGroupBlackholingEnabled {
+			daemonset, err := buildDaemonset(checksumEgressFilter, blackholingEnabled, portFilteringEnabled && !blackholingEnabled, b.sleepDuration, namespace, applierNodes{workerGroup: workerGroup})
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, daemonset)
+		}
+	case len(b.profiles) > 0:
+		// Filter profiles => One Secret and DS per profile and one DS for the other nodes
+		profileNodes, otherNodes := profileApplierNodes(b.profiles)
+		for _, profile := range b.profiles {
+			nodes, ok := profileNodes[profile.name]
+			if !ok {
+				continue
+			}
+			profileSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      applierResourceName(profile.name),
+					Namespace: namespace,
+				},
+				Type: corev1.SecretTypeOpaque,
+				Data: profile.secretData,
+			}
+			_, profilePortFilteringEnabled := profile.secretData[constants.KeyPortList]
+			daemonset, err := buildDaemonset(utils.ComputeSecretChecksum(profile.secretData), profile.blackholingEnabled, profilePortFilteringEnabled && !profile.blackholingEnabled, b.sleepDuration, namespace, nodes)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, profileSecret, daemonset)
+		}
+		if otherNodes != nil {
+			daemonset, err := buildDaemonset(checksumEgressFilter, b.blackholingEnabled, portFilteringEnabled && !b.blackholingEnabled, b.sleepDuration, namespace, *otherNodes)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, daemonset)
+		}
+	default:
+		// No worker group-specific blocking => Only one DS for everyone
+		daemonset, err := buildDaemonset(checksumEgressFilter, b.blackholingEnabled, portFilteringEnabled && !b.blackholingEnabled, b.sleepDuration, namespace, applierNodes{})
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, daemonset)
+	}
+
+	if b.exporterVals != nil {
+		exporterObjects, err := buildExporter(namespace, b.exporterVals)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, exporterObjects...)
+	}
+	return objects, nil
+}
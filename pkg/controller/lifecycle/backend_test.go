@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/backend"
+)
+
+var _ = Describe("Enforcement backends", func() {
+	Describe("#newBackend", func() {
+		It("should return the backend of the type", func() {
+			applier := &applierBackend{}
+			Expect(newBackend(config.EnforcementBackendApplier, applier, nil)).To(BeIdenticalTo(applier))
+			Expect(newBackend(config.EnforcementBackendCilium, applier, nil)).To(Equal(backend.Cilium{}))
+			Expect(newBackend(config.EnforcementBackendCalico, applier, nil)).To(Equal(backend.Calico{}))
+		})
+
+		It("should pass the selected workloads to the network policy backends", func() {
+			workloads := &config.Workloads{Exempted: []config.WorkloadSelector{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "scanner"}}}}}
+			Expect(newBackend(config.EnforcementBackendCilium, &applierBackend{}, workloads)).To(Equal(backend.Cilium{Workloads: workloads}))
+			Expect(newBackend(config.EnforcementBackendCalico, &applierBackend{}, workloads)).To(Equal(backend.Calico{Workloads: workloads}))
+
+			_, err := newBackend(config.EnforcementBackendApplier, &applierBackend{}, workloads)
+			Expect(err).To(MatchError("workloads cannot be selected with enforcement backend applier"))
+		})
+
+		It("should reject filter profiles with the network policy backends", func() {
+			applier := &applierBackend{profiles: []applierProfile{{name: "restricted"}}}
+			Expect(newBackend(config.EnforcementBackendApplier, applier, nil)).To(BeIdenticalTo(applier))
+
+			_, err := newBackend(config.EnforcementBackendCilium, applier, nil)
+			Expect(err).To(MatchError("filter profiles are not supported with enforcement backend cilium"))
+		})
+
+		It("should fail for unresolved backends", func() {
+			_, err := newBackend(config.EnforcementBackendAuto, &applierBackend{}, nil)
+			Expect(err).To(MatchError(`unsupported enforcement backend "auto"`))
+		})
+	})
+})
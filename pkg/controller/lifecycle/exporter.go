@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/imagevector"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/exporter"
+)
+
+const (
+	// annotationChecksumExporter is the pod template annotation with the checksum of the filter entries of the exporter.
+	annotationChecksumExporter = "checksum/" + constants.ExporterSecretName
+	// kernelLogPath is the path of the kernel log read by the exporter.
+	kernelLogPath = "/dev/kmsg"
+)
+
+// exporterValues contains the values of the blocked connection exporter deployed alongside the egress filter applier.
+type exporterValues struct {
+	config        *config.BlockedConnectionExporter
+	filterEntries []byte
+}
+
+// isExporterEnabled returns true if the blocked connection exporter is enabled.
+func isExporterEnabled(egressFilter *config.EgressFilter) bool {
+	return egressFilter != nil && egressFilter.BlockedConnectionExporter != nil && egressFilter.BlockedConnectionExporter.Enabled
+}
+
+// splitFilterEntries removes the filter entries of the blocked connection exporter from the secret data of the egress
+// filter applier, so that they neither change its checksum nor are mounted by it.
+func splitFilterEntries(secretData map[string][]byte) (map[string][]byte, []byte) {
+	filterEntries, ok := secretData[constants.KeyFilterEntries]
+	if !ok {
+		return secretData, nil
+	}
+	result := make(map[string][]byte, len(secretData)-1)
+	for key, value := range secretData {
+		if key != constants.KeyFilterEntries {
+			result[key] = value
+		}
+	}
+	return result, filterEntries
+}
+
+// exporterArgs returns the arguments of the blocked connection exporter for the given configuration.
+func exporterArgs(exporterConfig *config.BlockedConnectionExporter) []string {
+	var (
+		eventInterval = exporter.DefaultEventInterval
+		topOffenders  = exporter.DefaultTopOffenders
+	)
+	if exporterConfig.EventInterval != nil {
+		eventInterval = exporterConfig.EventInterval.Duration
+	}
+	if exporterConfig.TopOffenders != nil {
+		topOffenders = int(*exporterConfig.TopOffenders)
+	}
+	return []string{
+		fmt.Sprintf("--kernel-log-path=%s", kernelLogPath),
+		fmt.Sprintf("--filter-entries-path=/%s/%s", constants.ExporterEntriesPath, constants.KeyFilterEntries),
+		fmt.Sprintf("--event-interval=%s", eventInterval),
+		fmt.Sprintf("--top-offenders=%d", topOffenders),
+	}
+}
+
+// buildExporter builds the resources of the blocked connection exporter, which runs on all nodes of the egress filter
+// appliers.
+func buildExporter(namespace string, values *exporterValues) ([]client.Object, error) {
+	var (
+		requestCPU, _          = resource.ParseQuantity("5m")
+		requestMemory, _       = resource.ParseQuantity("32Mi")
+		defaultMode      int32 = 0400
+		hostPathType           = corev1.HostPathCharDev
+	)
+
+	labels := map[string]string{
+		constants.LabelKeyApplication: constants.ExporterName,
+		"gardener.cloud/role":         "system-component",
+	}
+
+	image, err := imagevector.ImageVector().FindImage(constants.ImageEgressFilterExporter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find image version for %s: %v", constants.ImageEgressFilterExporter, err)
+	}
+	image = image.WithOptionalTag(version.Get().GitVersion)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.ExporterSecretName,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{constants.KeyFilterEntries: values.filterEntries},
+	}
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.ExporterName,
+			Namespace: namespace,
+		},
+		AutomountServiceAccountToken: new(false),
+	}
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gardener.cloud:" + constants.ExporterName,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{corev1.GroupName},
+				Resources: []string{"pods"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{eventsv1.GroupName},
+				Resources: []string{"events"},
+				Verbs:     []string{"create", "patch", "update"},
+			},
+		},
+	}
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterRole.Name,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: namespace,
+		}},
+	}
+
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.ExporterName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			RevisionHistoryLimit: new(int32(2)),
+			Selector:             &metav1.LabelSelector{MatchLabels: labels},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "100%"},
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+					Annotations: map[string]string{
+						annotationChecksumExporter: utils.ComputeSecretChecksum(secret.Data),
+					},
+				},
+				Spec: corev1.PodSpec{
+					PriorityClassName:            "system-node-critical",
+					ServiceAccountName:           serviceAccount.Name,
+					AutomountServiceAccountToken: new(true),
+					Tolerations: []corev1.Toleration{
+						{
+							Effect:   corev1.TaintEffectNoSchedule,
+							Operator: corev1.TolerationOpExists,
+						},
+						{
+							Key:      "CriticalAddonsOnly",
+							Operator: corev1.TolerationOpExists,
+						},
+						{
+							Effect:   corev1.TaintEffectNoExecute,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+					SecurityContext: &corev1.PodSecurityContext{
+						SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+					},
+					Containers: []corev1.Container{{
+						Name:            constants.ExporterName,
+						Image:           image.String(),
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Args:            exporterArgs(values.config),
+						Env: []corev1.EnvVar{{
+							Name:      exporter.EnvNodeName,
+							ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"}},
+						}},
+						Ports: []corev1.ContainerPort{
+							{Name: "metrics", ContainerPort: 8080, Protocol: corev1.ProtocolTCP},
+							{Name: "healthz", ContainerPort: 8081, Protocol: corev1.ProtocolTCP},
+						},
+						LivenessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("healthz")},
+							},
+						},
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{Path: "/readyz", Port: intstr.FromString("healthz")},
+							},
+						},
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    requestCPU,
+								corev1.ResourceMemory: requestMemory,
+							},
+						},
+						// Like for the node-problem-detector, reading the kernel log of the node requires access to the
+						// /dev/kmsg device of the host.
+						SecurityContext: &corev1.SecurityContext{
+							Privileged: new(true),
+							RunAsUser:  new(int64(0)),
+						},
+						VolumeMounts: []corev1.VolumeMount{
+							{
+								Name:      constants.ExporterEntriesPath,
+								ReadOnly:  true,
+								MountPath: fmt.Sprintf("/%s", constants.ExporterEntriesPath),
+							},
+							{
+								Name:      "kmsg",
+								ReadOnly:  true,
+								MountPath: kernelLogPath,
+							},
+						},
+					}},
+					Volumes: []corev1.Volume{
+						{
+							Name: constants.ExporterEntriesPath,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName:  secret.Name,
+									DefaultMode: &defaultMode,
+								},
+							},
+						},
+						{
+							Name: "kmsg",
+							VolumeSource: corev1.VolumeSource{
+								HostPath: &corev1.HostPathVolumeSource{
+									Path: kernelLogPath,
+									Type: &hostPathType,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return []client.Object{secret, serviceAccount, clusterRole, clusterRoleBinding, ds}, nil
+}
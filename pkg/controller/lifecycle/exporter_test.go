@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Blocked connection exporter", func() {
+	Describe("#splitFilterEntries", func() {
+		It("should split off the filter entries", func() {
+			secretData := map[string][]byte{
+				constants.KeyIPV4List:      []byte("- 1.2.3.0/24"),
+				constants.KeyIPV6List:      []byte("[]"),
+				constants.KeyFilterEntries: []byte("entries"),
+			}
+
+			applierData, filterEntries := splitFilterEntries(secretData)
+			Expect(applierData).To(Equal(map[string][]byte{
+				constants.KeyIPV4List: []byte("- 1.2.3.0/24"),
+				constants.KeyIPV6List: []byte("[]"),
+			}))
+			Expect(filterEntries).To(Equal([]byte("entries")))
+			Expect(secretData).To(HaveKey(constants.KeyFilterEntries))
+		})
+
+		It("should return the secret data unchanged without filter entries", func() {
+			secretData := map[string][]byte{constants.KeyIPV4List: []byte("[]"), constants.KeyIPV6List: []byte("[]")}
+
+			applierData, filterEntries := splitFilterEntries(secretData)
+			Expect(applierData).To(Equal(secretData))
+			Expect(filterEntries).To(BeNil())
+		})
+	})
+
+	DescribeTable("#exporterArgs",
+		func(exporterConfig *config.BlockedConnectionExporter, expected ...string) {
+			Expect(exporterArgs(exporterConfig)).To(ContainElements(expected))
+		},
+		Entry("defaults", &config.BlockedConnectionExporter{Enabled: true},
+			"--kernel-log-path=/dev/kmsg", "--filter-entries-path=/entries/filter-entries", "--event-interval=10m0s", "--top-offenders=5"),
+		Entry("configured values", &config.BlockedConnectionExporter{
+			Enabled:       true,
+			EventInterval: &metav1.Duration{Duration: time.Hour},
+			TopOffenders:  new(int32(0)),
+		}, "--event-interval=1h0m0s", "--top-offenders=0"),
+	)
+
+	DescribeTable("#isExporterEnabled",
+		func(egressFilter *config.EgressFilter, expected bool) {
+			Expect(isExporterEnabled(egressFilter)).To(Equal(expected))
+		},
+		Entry("no egress filter", nil, false),
+		Entry("not configured", &config.EgressFilter{}, false),
+		Entry("disabled", &config.EgressFilter{BlockedConnectionExporter: &config.BlockedConnectionExporter{}}, false),
+		Entry("enabled", &config.EgressFilter{BlockedConnectionExporter: &config.BlockedConnectionExporter{Enabled: true}}, true),
+	)
+})
@@ -8,37 +8,39 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/netip"
 	"strings"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 )
 
-var privateIPv4Ranges []net.IPNet
-var privateIPv6Ranges []net.IPNet
-
-func init() {
-	// localhost (RFC1122)
-	_, ipv4Range127, _ := net.ParseCIDR("127.0.0.0/8")
-	// localhost ipv6 (RFC4291)
-	_, ipv6Range1, _ := net.ParseCIDR("::1/128")
-	// Private IP ranges (RFC1918)
-	_, ipv4Range10, _ := net.ParseCIDR("10.0.0.0/8")
-	_, ipv4Range172, _ := net.ParseCIDR("172.16.0.0/12")
-	_, ipv4Range192, _ := net.ParseCIDR("192.168.0.0/16")
-	// Carrier grade NAT (RFC6598)
-	_, ipv4Range100, _ := net.ParseCIDR("100.64.0.0/10")
-	// Link local (RFC3927)
-	_, ipv4Range169, _ := net.ParseCIDR("169.254.0.0/16")
-	// IPv6 link local (RFC4291)
-	_, ipv6RangeFE80, _ := net.ParseCIDR("fe80::/10")
-	// IPv6 unique local unicast (RFC4193)
-	_, ipv6RangeFC00, _ := net.ParseCIDR("fc00::/7")
-	privateIPv4Ranges = []net.IPNet{*ipv4Range127, *ipv4Range10, *ipv4Range172, *ipv4Range192, *ipv4Range100, *ipv4Range169}
-	privateIPv6Ranges = []net.IPNet{*ipv6Range1, *ipv6RangeFE80, *ipv6RangeFC00}
-}
+var (
+	privateIPv4Ranges = []netip.Prefix{
+		// localhost (RFC1122)
+		netip.MustParsePrefix("127.0.0.0/8"),
+		// Private IP ranges (RFC1918)
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("172.16.0.0/12"),
+		netip.MustParsePrefix("192.168.0.0/16"),
+		// Carrier grade NAT (RFC6598)
+		netip.MustParsePrefix("100.64.0.0/10"),
+		// Link local (RFC3927)
+		netip.MustParsePrefix("169.254.0.0/16"),
+	}
+	privateIPv6Ranges = []netip.Prefix{
+		// localhost ipv6 (RFC4291)
+		netip.MustParsePrefix("::1/128"),
+		// IPv6 link local (RFC4291)
+		netip.MustParsePrefix("fe80::/10"),
+		// IPv6 unique local unicast (RFC4193)
+		netip.MustParsePrefix("fc00::/7"),
+	}
+)
 
 // parseFilterList parses JSON data and detects whether it's v1 or v2 format.
 // Returns the parsed filter list in v1 format.
@@ -49,12 +51,13 @@ func parseFilterList(data []byte) ([]config.Filter, error) {
 		return nil, fmt.Errorf("failed to parse JSON structure: %w", err)
 	}
 
-	// Detect format: v2 has "entries" field, v1 has "network" field
+	// Detect format: v2 has "entries" field, v1 has "network" or "fqdn" field
 	isV2Format := false
 	if len(raw) > 0 {
 		_, hasEntries := raw[0]["entries"]
 		_, hasNetwork := raw[0]["network"]
-		isV2Format = hasEntries && !hasNetwork
+		_, hasFQDN := raw[0]["fqdn"]
+		isV2Format = hasEntries && !hasNetwork && !hasFQDN
 	}
 
 	var filters []config.Filter
@@ -79,61 +82,172 @@ func parseFilterList(data []byte) ([]config.Filter, error) {
 	return filters, nil
 }
 
+// convertV2ToV1 converts a v2 format filter list to v1 format
+func convertV2ToV1(filterListV2 []config.FilterListV2) ([]config.Filter, error) {
+	var result []config.Filter
+	for _, list := range filterListV2 {
+		for _, entry := range list.Entries {
+			policy, err := convertPolicyV2ToV1(entry.Policy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid policy for network %s: %w", entry.Target, err)
+			}
+			filter := config.Filter{
+				Network:  entry.Target,
+				Policy:   policy,
+				Protocol: entry.Protocol,
+				Ports:    entry.Ports,
+				Tags:     entry.Tags, // Preserve tags from v2 format
+			}
+			if isFQDN(entry.Target) {
+				filter.Network = ""
+				filter.FQDN = normalizeFQDN(entry.Target)
+			}
+			result = append(result, filter)
+		}
+	}
+	return result, nil
+}
+
+// isFQDN returns true if the target is a fully qualified domain name and not an IP address or CIDR.
+func isFQDN(target string) bool {
+	name := normalizeFQDN(target)
+	if !strings.Contains(name, ".") {
+		return false
+	}
+	if _, err := netip.ParseAddr(name); err == nil {
+		return false
+	}
+	return len(validation.IsDNS1123Subdomain(name)) == 0
+}
+
+// normalizeFQDN returns the FQDN in lower case without trailing dot.
+func normalizeFQDN(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// convertPolicyV2ToV1 converts v2 policy format to v1 format
+func convertPolicyV2ToV1(policyV2 config.Policy) (config.Policy, error) {
+	switch policyV2 {
+	case config.PolicyBlock:
+		return config.PolicyBlockAccess, nil
+	case config.PolicyAllow:
+		return config.PolicyAllowAccess, nil
+	default:
+		return "", fmt.Errorf("unknown policy value: %s", policyV2)
+	}
+}
+
 func generateEgressFilterValues(entries []config.Filter, logger logr.Logger) ([]string, []string, error) {
+	return generateEgressFilterValuesWithStatus(entries, logger, nil)
+}
+
+// generateEgressFilterValuesWithStatus generates the IPv4/IPv6 lists like generateEgressFilterValues.
+// If status is not nil, dropped private networks and carve-outs by allowed networks are recorded in it.
+func generateEgressFilterValuesWithStatus(entries []config.Filter, logger logr.Logger, status *config.EgressFilterStatus) ([]string, []string, error) {
 	if len(entries) == 0 {
 		return []string{}, []string{}, nil
 	}
 
-	ipv4Nets := []net.IPNet{}
-	ipv6Nets := []net.IPNet{}
+	var blocked, allowed cidrset.Builder
 
 	// First pass: collect all BLOCK_ACCESS entries
-OUTER:
+	// FQDN entries are expected to be resolved into network entries before, see resolveFQDNEntries.
+	// Port- and protocol-scoped entries are handled separately, see generatePortFilterList.
 	for _, entry := range entries {
-		if entry.Policy == config.PolicyBlockAccess {
-			ip, ipnet, err := net.ParseCIDR(entry.Network)
+		if entry.Policy == config.PolicyBlockAccess && entry.FQDN == "" && entry.Protocol == "" {
+			prefix, err := parsePrefix(entry.Network)
 			if err != nil {
 				logger.Error(err, "Error parsing CIDR from filter list, ignoring it", "offending CIDR", entry.Network)
 				continue
 			}
-			if ip.To4() != nil {
-				for _, privateNet := range privateIPv4Ranges {
-					if privateNet.Contains(ip) || ipnet.Contains(privateNet.IP) {
-						logger.Info("Identified overlapping CIDR in filter list, ignoring it", "offending CIDR", ipnet.String(), "reserved range", privateNet.String())
-						continue OUTER
-					}
-				}
-				ipv4Nets = append(ipv4Nets, *ipnet)
-			} else {
-				for _, privateNet := range privateIPv6Ranges {
-					if privateNet.Contains(ip) || ipnet.Contains(privateNet.IP) {
-						logger.Info("Identified overlapping CIDR in filter list, ignoring it", "offending CIDR", ipnet.String(), "reserved range", privateNet.String())
-						continue OUTER
-					}
-				}
-				ipv6Nets = append(ipv6Nets, *ipnet)
+			if privateNet, ok := overlappingPrivateRange(prefix); ok {
+				logger.Info("Identified overlapping CIDR in filter list, ignoring it", "offending CIDR", prefix.String(), "reserved range", privateNet.String())
+				recordDroppedPrivateEntry(status, prefix.String())
+				continue
 			}
+			blocked.AddPrefix(prefix)
 		}
 	}
 
-	// Second pass: process ALLOW_ACCESS entries by carving them out from blocked ranges
+	// Second pass: collect all ALLOW_ACCESS entries
 	for _, entry := range entries {
-		if entry.Policy == config.PolicyAllowAccess {
-			ip, allowNet, err := net.ParseCIDR(entry.Network)
+		if entry.Policy == config.PolicyAllowAccess && entry.FQDN == "" && entry.Protocol == "" {
+			prefix, err := parsePrefix(entry.Network)
 			if err != nil {
 				logger.Error(err, "Error parsing CIDR from allow list, ignoring it", "offending CIDR", entry.Network)
 				continue
 			}
+			allowed.AddPrefix(prefix)
+		}
+	}
 
-			if ip.To4() != nil {
-				ipv4Nets = removeNetFromNetList(logger, ipv4Nets, *allowNet)
+	carveOutAllowedNetworks(&blocked, allowed.Set(), logger, status)
+
+	ipv4List, ipv6List := prefixListToStringLists(blocked.Set().Prefixes())
+	return ipv4List, ipv6List, nil
+}
+
+// overlappingPrivateRange returns the private range overlapping the prefix, if any.
+func overlappingPrivateRange(prefix netip.Prefix) (netip.Prefix, bool) {
+	privateRanges := privateIPv6Ranges
+	if prefix.Addr().Is4() {
+		privateRanges = privateIPv4Ranges
+	}
+	for _, privateNet := range privateRanges {
+		if privateNet.Overlaps(prefix) {
+			return privateNet, true
+		}
+	}
+	return netip.Prefix{}, false
+}
+
+// carveOutAllowedNetworks removes the allowed networks from the blocked networks.
+// If status is not nil, the number of blocked networks split or removed is recorded in it.
+func carveOutAllowedNetworks(blocked *cidrset.Builder, allowedSet *cidrset.Set, logger logr.Logger, status *config.EgressFilterStatus) {
+	var ipv4CarveOuts, ipv6CarveOuts int
+	for _, prefix := range blocked.Set().Prefixes() {
+		if allowedSet.OverlapsPrefix(prefix) {
+			if prefix.Addr().Is4() {
+				ipv4CarveOuts++
 			} else {
-				ipv6Nets = removeNetFromNetList(logger, ipv6Nets, *allowNet)
+				ipv6CarveOuts++
 			}
 		}
 	}
+	if ipv4CarveOuts+ipv6CarveOuts > 0 {
+		logger.Info("Carving out allowed networks from blocked ranges", "ipv4BlockedRanges", ipv4CarveOuts, "ipv6BlockedRanges", ipv6CarveOuts)
+	}
+	if status != nil {
+		status.IPv4.AllowCarveOuts += ipv4CarveOuts
+		status.IPv6.AllowCarveOuts += ipv6CarveOuts
+	}
+	blocked.RemoveSet(allowedSet)
+}
 
-	return ipNetListToStringList(ipv4Nets), ipNetListToStringList(ipv6Nets), nil
+// parsePrefix parses a CIDR and returns it with the host bits masked.
+// IPv4-mapped IPv6 prefixes are converted to IPv4 prefixes.
+func parsePrefix(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+	}
+	return prefix.Masked(), nil
+}
+
+// prefixListToStringLists splits the prefixes into lists of IPv4 and IPv6 CIDRs.
+func prefixListToStringLists(prefixes []netip.Prefix) ([]string, []string) {
+	ipv4List, ipv6List := []string{}, []string{}
+	for _, prefix := range prefixes {
+		if prefix.Addr().Is4() {
+			ipv4List = append(ipv4List, prefix.String())
+		} else {
+			ipv6List = append(ipv6List, prefix.String())
+		}
+	}
+	return ipv4List, ipv6List
 }
 
 func convertToPlainYamlList(list []string) string {
@@ -150,205 +264,74 @@ func convertToPlainYamlList(list []string) string {
 	return sb.String()
 }
 
-func ipNetListfromPlainYamlList(yaml string) []net.IPNet {
+func prefixListFromPlainYamlList(yaml string) []netip.Prefix {
 	if strings.TrimSpace(yaml) == "[]" {
 		return nil
 	}
-	var result []net.IPNet
+	var result []netip.Prefix
 	for s := range strings.SplitSeq(yaml, "\n") {
 		s = strings.Trim(s, "- ")
-		_, ipnet, err := net.ParseCIDR(s)
-		if err != nil || ipnet == nil {
+		prefix, err := parsePrefix(s)
+		if err != nil {
 			continue
 		}
-		result = append(result, *ipnet)
+		result = append(result, prefix)
 	}
 	return result
 }
 
 func appendStaticIPs(logger logr.Logger, secretData map[string][]byte, filterList []config.Filter) (map[string][]byte, error) {
-	newSecretData := map[string][]byte{}
-
 	staticIPv4List, staticIPv6List, err := generateEgressFilterValues(filterList, logger)
 	if err != nil {
 		return nil, err
 	}
 
-	staticIPv4Data := ipNetListfromPlainYamlList(convertToPlainYamlList(staticIPv4List))
-	staticIPv6Data := ipNetListfromPlainYamlList(convertToPlainYamlList(staticIPv6List))
-
-	ipV4List := ipNetListfromPlainYamlList(string(secretData[constants.KeyIPV4List]))
-	ipV6List := ipNetListfromPlainYamlList(string(secretData[constants.KeyIPV6List]))
-
-	ipV4List = append(ipV4List, staticIPv4Data...)
-	ipV6List = append(ipV6List, staticIPv6Data...)
-
-	newSecretData[constants.KeyIPV4List] = []byte(convertToPlainYamlList(ipNetListToStringList(ipV4List)))
-	newSecretData[constants.KeyIPV6List] = []byte(convertToPlainYamlList(ipNetListToStringList(ipV6List)))
-
-	return newSecretData, nil
-}
-
-func filterSecretDataForIPs(logger logr.Logger, secretData map[string][]byte, lbIPs []net.IP) (map[string][]byte, error) {
-	filteredSecretData := map[string][]byte{}
-	for key, value := range secretData {
-		switch key {
-		case constants.KeyIPV4List:
-			ipV4List := ipNetListfromPlainYamlList(string(value))
-			ipV4List = filterIPNetListForIPs(logger, ipV4List, lbIPs)
-			strList := ipNetListToStringList(ipV4List)
-			value = []byte(convertToPlainYamlList(strList))
-		case constants.KeyIPV6List:
-			ipV6List := ipNetListfromPlainYamlList(string(value))
-			ipV6List = filterIPNetListForIPs(logger, ipV6List, lbIPs)
-			strList := ipNetListToStringList(ipV6List)
-			value = []byte(convertToPlainYamlList(strList))
+	var list cidrset.Builder
+	for _, data := range []string{
+		string(secretData[constants.KeyIPV4List]),
+		string(secretData[constants.KeyIPV6List]),
+		convertToPlainYamlList(staticIPv4List),
+		convertToPlainYamlList(staticIPv6List),
+	} {
+		for _, prefix := range prefixListFromPlainYamlList(data) {
+			list.AddPrefix(prefix)
 		}
-		filteredSecretData[key] = value
 	}
-	return filteredSecretData, nil
-}
 
-// ipToCIDR converts an IP address to a CIDR network (/32 for IPv4, /128 for IPv6)
-func ipToCIDR(ip net.IP) net.IPNet {
-	ones := 32
-	if ip.To4() == nil {
-		ones = 128
-	}
-	return net.IPNet{
-		IP:   ip,
-		Mask: net.CIDRMask(ones, ones),
-	}
+	ipv4List, ipv6List := prefixListToStringLists(list.Set().Prefixes())
+	return map[string][]byte{
+		constants.KeyIPV4List: []byte(convertToPlainYamlList(ipv4List)),
+		constants.KeyIPV6List: []byte(convertToPlainYamlList(ipv6List)),
+	}, nil
 }
 
-func filterIPNetListForIPs(logger logr.Logger, filterList []net.IPNet, lbIPs []net.IP) []net.IPNet {
+func filterSecretDataForIPs(logger logr.Logger, secretData map[string][]byte, lbIPs []net.IP) (map[string][]byte, error) {
+	var lbIPSetBuilder cidrset.Builder
 	for _, lbIP := range lbIPs {
-		ipAsCIDR := ipToCIDR(lbIP)
-
-		var toBeSplitted []net.IPNet
-		for i := len(filterList) - 1; i >= 0; i-- {
-			if filterList[i].Contains(lbIP) {
-				logger.Info("Identified load balancer IP in filtered CIDR. Splitting CIDR to remove IP.", "loadBalancerIP", lbIP.String(), "cidr", filterList[i].String())
-				toBeSplitted = append(toBeSplitted, filterList[i])
-				filterList = append(filterList[:i], filterList[i+1:]...)
-			}
-		}
-		if len(toBeSplitted) > 0 {
-			for _, cidr := range toBeSplitted {
-				splitted := removeNetFromCIDR(logger, cidr, ipAsCIDR)
-				filterList = append(filterList, splitted...)
-			}
+		if addr, ok := netip.AddrFromSlice(lbIP); ok {
+			lbIPSetBuilder.Add(addr.Unmap())
 		}
 	}
-	return filterList
-}
+	lbIPSet := lbIPSetBuilder.Set()
 
-func ipNetListToStringList(filterList []net.IPNet) []string {
-	result := make([]string, len(filterList))
-	for i, v := range filterList {
-		result[i] = v.String()
-	}
-	return result
-}
-
-// removeNetFromNetList removes an allowed network from a list of blocked networks by splitting overlapping ranges
-func removeNetFromNetList(logger logr.Logger, blockList []net.IPNet, allowNet net.IPNet) []net.IPNet {
-	var result []net.IPNet
-
-	for _, blockNet := range blockList {
-		if overlaps(blockNet, allowNet) {
-			logger.Info("Carving out allowed network from blocked range", "allowedNetwork", allowNet.String(), "blockedRange", blockNet.String())
-			split := removeNetFromCIDR(logger, blockNet, allowNet)
-			result = append(result, split...)
-		} else {
-			result = append(result, blockNet)
-		}
-	}
-
-	return result
-}
-
-// overlaps checks if two CIDRs overlap
-func overlaps(cidr1, cidr2 net.IPNet) bool {
-	return cidr1.Contains(cidr2.IP) || cidr2.Contains(cidr1.IP)
-}
-
-// removeNetFromCIDR removes an allowed network from a blocked CIDR and returns the remaining CIDRs
-func removeNetFromCIDR(logger logr.Logger, blockNet net.IPNet, allowNet net.IPNet) []net.IPNet {
-	// If no overlap, return the original blocked network
-	if !overlaps(blockNet, allowNet) {
-		return []net.IPNet{blockNet}
-	}
-
-	// If the allowed network completely contains the blocked network, remove it entirely
-	if allowNet.Contains(blockNet.IP) {
-		blockMaskSize, _ := blockNet.Mask.Size()
-		allowMaskSize, _ := allowNet.Mask.Size()
-		if allowMaskSize <= blockMaskSize {
-			return []net.IPNet{}
-		}
-	}
-
-	// The blocked network must contain the allowed network, so split it
-	return splitCIDRAroundSubnet(blockNet, allowNet)
-}
-
-// splitCIDRAroundSubnet splits a CIDR to exclude a subnet
-func splitCIDRAroundSubnet(cidr net.IPNet, subnet net.IPNet) []net.IPNet {
-	var result []net.IPNet
-
-	cidrOnes, _ := cidr.Mask.Size()
-	subnetOnes, _ := subnet.Mask.Size()
-
-	// If subnet is equal to or larger than cidr, nothing remains
-	if subnetOnes <= cidrOnes {
-		return result
-	}
-
-	currentNet := cidr
-	for prefixLen := cidrOnes; prefixLen < subnetOnes; prefixLen++ {
-		// Split current network into two halves
-		half1, half2 := splitCIDRInHalf(currentNet)
-
-		// Check which half contains the subnet
-		if half1.Contains(subnet.IP) {
-			// Subnet is in first half, keep second half
-			result = append(result, half2)
-			currentNet = half1
-		} else {
-			// Subnet is in second half, keep first half
-			result = append(result, half1)
-			currentNet = half2
+	filteredSecretData := map[string][]byte{}
+	for key, value := range secretData {
+		switch key {
+		case constants.KeyIPV4List, constants.KeyIPV6List, constants.KeyIPV4AuditList, constants.KeyIPV6AuditList:
+			var list cidrset.Builder
+			for _, prefix := range prefixListFromPlainYamlList(string(value)) {
+				if lbIPSet.OverlapsPrefix(prefix) {
+					logger.Info("Identified load balancer IP in filtered CIDR. Splitting CIDR to remove IP.", "cidr", prefix.String())
+				}
+				list.AddPrefix(prefix)
+			}
+			list.RemoveSet(lbIPSet)
+			ipv4List, ipv6List := prefixListToStringLists(list.Set().Prefixes())
+			value = []byte(convertToPlainYamlList(append(ipv4List, ipv6List...)))
+		case constants.KeyPortList, constants.KeyPortAuditList:
+			value = removeFromPortList(value, lbIPSet)
 		}
+		filteredSecretData[key] = value
 	}
-
-	return result
-}
-
-// splitCIDRInHalf splits a CIDR into two equal halves
-func splitCIDRInHalf(cidr net.IPNet) (net.IPNet, net.IPNet) {
-	ones, bits := cidr.Mask.Size()
-	newMask := net.CIDRMask(ones+1, bits)
-
-	// First half uses the current IP
-	half1 := net.IPNet{
-		IP:   cidr.IP,
-		Mask: newMask,
-	}
-
-	// Second half needs IP with the next bit flipped
-	ip := make(net.IP, len(cidr.IP))
-	copy(ip, cidr.IP)
-
-	// Flip the bit at position 'ones'
-	bytePos := ones / 8
-	bitPos := 7 - (ones % 8)
-	ip[bytePos] |= 1 << bitPos
-
-	half2 := net.IPNet{
-		IP:   ip,
-		Mask: newMask,
-	}
-
-	return half1, half2
+	return filteredSecretData, nil
 }
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+func randomFilterList(blocked, allowed int) []config.Filter {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	randomCIDR := func(minBits int) string {
+		return fmt.Sprintf("%d.%d.%d.%d/%d", 11+rnd.IntN(89), rnd.IntN(256), rnd.IntN(256), rnd.IntN(256), minBits+rnd.IntN(33-minBits))
+	}
+
+	filterList := make([]config.Filter, 0, blocked+allowed)
+	for range blocked {
+		filterList = append(filterList, config.Filter{Network: randomCIDR(12), Policy: config.PolicyBlockAccess})
+	}
+	for range allowed {
+		filterList = append(filterList, config.Filter{Network: randomCIDR(16), Policy: config.PolicyAllowAccess})
+	}
+	return filterList
+}
+
+func benchmarkGenerateEgressFilterValues(b *testing.B, allowed int) {
+	filterList := randomFilterList(constants.FilterListMaxEntries, allowed)
+	for b.Loop() {
+		if _, _, err := generateEgressFilterValues(filterList, logr.Discard()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGenerateEgressFilterValuesWith1000Allowed(b *testing.B) {
+	benchmarkGenerateEgressFilterValues(b, 1000)
+}
+
+func BenchmarkGenerateEgressFilterValuesWith5000Allowed(b *testing.B) {
+	benchmarkGenerateEgressFilterValues(b, 5000)
+}
+
+func BenchmarkFilterSecretDataForIPs(b *testing.B) {
+	ipv4List, ipv6List, err := generateEgressFilterValues(randomFilterList(constants.FilterListMaxEntries, 0), logr.Discard())
+	if err != nil {
+		b.Fatal(err)
+	}
+	secretData := map[string][]byte{
+		constants.KeyIPV4List: []byte(convertToPlainYamlList(ipv4List)),
+		constants.KeyIPV6List: []byte(convertToPlainYamlList(ipv6List)),
+	}
+	rnd := rand.New(rand.NewPCG(3, 4))
+	var lbIPs []net.IP
+	for range 1000 {
+		lbIPs = append(lbIPs, net.IPv4(byte(11+rnd.IntN(89)), byte(rnd.IntN(256)), byte(rnd.IntN(256)), byte(rnd.IntN(256))))
+	}
+
+	for b.Loop() {
+		if _, err := filterSecretDataForIPs(logr.Discard(), secretData, lbIPs); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
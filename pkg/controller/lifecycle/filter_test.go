@@ -10,7 +10,6 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
@@ -59,7 +58,7 @@ var _ = Describe("Filter methods", func() {
 	},
 		Entry("nil", nil, []string{}, []string{}),
 		Entry("empty list", emptyList, []string{}, []string{}),
-		Entry("good list", goodList, []string{"1.2.3.4/31", "1.2.3.0/24"}, []string{"::2/128"}),
+		Entry("good list", goodList, []string{"1.2.3.0/24"}, []string{"::2/128"}),
 		Entry("ignore invalid CIDRs", invalidCIDRList, []string{}, []string{}),
 		Entry("ignore overlapping CIDRs", overlappingCIDRList, []string{}, []string{}),
 		Entry("allow access splits blocked range",
@@ -67,7 +66,7 @@ var _ = Describe("Filter methods", func() {
 				{Network: "203.0.113.0/24", Policy: config.PolicyBlockAccess},
 				{Network: "203.0.113.128/32", Policy: config.PolicyAllowAccess},
 			},
-			[]string{"203.0.113.0/25", "203.0.113.192/26", "203.0.113.160/27", "203.0.113.144/28", "203.0.113.136/29", "203.0.113.132/30", "203.0.113.130/31", "203.0.113.129/32"},
+			[]string{"203.0.113.0/25", "203.0.113.129/32", "203.0.113.130/31", "203.0.113.132/30", "203.0.113.136/29", "203.0.113.144/28", "203.0.113.160/27", "203.0.113.192/26"},
 			[]string{},
 		),
 		Entry("multiple allow access entries",
@@ -76,7 +75,7 @@ var _ = Describe("Filter methods", func() {
 				{Network: "198.51.100.64/26", Policy: config.PolicyAllowAccess},
 				{Network: "198.51.100.128/26", Policy: config.PolicyAllowAccess},
 			},
-			[]string{"198.51.100.192/26", "198.51.100.0/26"},
+			[]string{"198.51.100.0/26", "198.51.100.192/26"},
 			[]string{},
 		),
 		Entry("allow access with no overlap",
@@ -95,6 +94,28 @@ var _ = Describe("Filter methods", func() {
 			[]string{},
 			[]string{},
 		),
+		Entry("duplicate and adjacent blocked ranges are merged",
+			[]config.Filter{
+				{Network: "203.0.113.128/25", Policy: config.PolicyBlockAccess},
+				{Network: "203.0.113.0/25", Policy: config.PolicyBlockAccess},
+				{Network: "203.0.113.0/25", Policy: config.PolicyBlockAccess},
+				{Network: "2001:db8:1::/48", Policy: config.PolicyBlockAccess},
+				{Network: "2001:db8::/48", Policy: config.PolicyBlockAccess},
+			},
+			[]string{"203.0.113.0/24"},
+			[]string{"2001:db8::/47"},
+		),
+		Entry("allow access spanning several blocked ranges",
+			[]config.Filter{
+				{Network: "203.0.113.0/26", Policy: config.PolicyBlockAccess},
+				{Network: "203.0.113.64/26", Policy: config.PolicyBlockAccess},
+				{Network: "203.0.113.192/26", Policy: config.PolicyBlockAccess},
+				{Network: "203.0.113.32/27", Policy: config.PolicyAllowAccess},
+				{Network: "203.0.113.64/27", Policy: config.PolicyAllowAccess},
+			},
+			[]string{"203.0.113.0/27", "203.0.113.96/27", "203.0.113.192/26"},
+			[]string{},
+		),
 	)
 
 	DescribeTable("#convertToPlainYamlList", func(list []string, expectedYaml string) {
@@ -106,36 +127,6 @@ var _ = Describe("Filter methods", func() {
 		Entry("good list", []string{"1.2.3.4/31", "1.2.3.0/24"}, "- 1.2.3.4/31\n- 1.2.3.0/24\n"),
 	)
 
-	DescribeTable("#removeNetFromCIDR (IP removal)", func(ipstr, cidr string, expectedCIDRs []string) {
-		logger := log.Log.WithName("test")
-		ip := net.ParseIP(ipstr)
-		Expect(ip).NotTo(BeNil())
-
-		ipAsCIDR := ipToCIDR(ip)
-		_, pipnet, err := net.ParseCIDR(cidr)
-		Expect(err).To(BeNil())
-		ipnet := *pipnet
-		actual := removeNetFromCIDR(logger, ipnet, ipAsCIDR)
-		var actualCIDRs []string
-		for _, a := range actual {
-			actualCIDRs = append(actualCIDRs, a.String())
-		}
-		Expect(actualCIDRs).To(Equal(expectedCIDRs))
-	},
-		Entry("1.2.3.4 in 1.2.4.0/24", "1.2.3.4", "1.2.4.0/24", []string{"1.2.4.0/24"}),
-		Entry("1.2.3.4 in 1.2.3.4/32", "1.2.3.4", "1.2.3.4/32", nil),
-		Entry("1.2.3.4 in 1.2.3.4/31", "1.2.3.4", "1.2.3.4/31", []string{"1.2.3.5/32"}),
-		Entry("1.2.3.5 in 1.2.3.4/31", "1.2.3.5", "1.2.3.4/31", []string{"1.2.3.4/32"}),
-		Entry("1.2.3.4 in 1.2.3.4/30", "1.2.3.4", "1.2.3.4/30", []string{"1.2.3.6/31", "1.2.3.5/32"}),
-		Entry("1.2.3.5 in 1.2.3.4/30", "1.2.3.5", "1.2.3.4/30", []string{"1.2.3.6/31", "1.2.3.4/32"}),
-		Entry("1.2.3.6 in 1.2.3.4/30", "1.2.3.6", "1.2.3.4/30", []string{"1.2.3.4/31", "1.2.3.7/32"}),
-		Entry("1.2.3.7 in 1.2.3.4/30", "1.2.3.7", "1.2.3.4/30", []string{"1.2.3.4/31", "1.2.3.6/32"}),
-		Entry("45.67.89.101 in 45.67.88.0/22", "45.67.89.101", "45.67.88.0/22",
-			[]string{"45.67.90.0/23", "45.67.88.0/24", "45.67.89.128/25", "45.67.89.0/26", "45.67.89.64/27", "45.67.89.112/28", "45.67.89.104/29", "45.67.89.96/30", "45.67.89.102/31", "45.67.89.100/32"}),
-		Entry("2001:db8::ff00:42:8329 in 2001:db8::ff00:42:8300/120", "2001:db8::ff00:42:8329", "2001:db8::ff00:42:8300/120",
-			[]string{"2001:db8::ff00:42:8380/121", "2001:db8::ff00:42:8340/122", "2001:db8::ff00:42:8300/123", "2001:db8::ff00:42:8330/124", "2001:db8::ff00:42:8320/125", "2001:db8::ff00:42:832c/126", "2001:db8::ff00:42:832a/127", "2001:db8::ff00:42:8328/128"}),
-	)
-
 	var (
 		empty = map[string]string{
 			constants.KeyIPV4List: "[]",
@@ -149,8 +140,8 @@ var _ = Describe("Filter methods", func() {
 `,
 		}
 		expected1 = map[string]string{
-			constants.KeyIPV4List: `- 1.2.3.8/30
-- 1.2.3.6/32
+			constants.KeyIPV4List: `- 1.2.3.6/32
+- 1.2.3.8/30
 `,
 			constants.KeyIPV6List: `- 2001::2/127
 `,
@@ -169,12 +160,10 @@ var _ = Describe("Filter methods", func() {
 `,
 		}
 		expected2 = map[string]string{
-			constants.KeyIPV4List: `- 1.0.0.64/29
-- 1.2.3.8/30
-- 1.0.0.64/28
+			constants.KeyIPV4List: `- 1.0.0.64/28
 - 1.2.3.0/30
 - 1.2.3.6/32
-- 1.2.3.6/32
+- 1.2.3.8/30
 `,
 			constants.KeyIPV6List: `- 2001::2/127
 - 2001:db8::ff00:42:8328/128
@@ -5,55 +5,104 @@
 package lifecycle
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	configvalidation "github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/validation"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/metrics"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/signature"
 )
 
 const (
 	minRefreshPeriod = 30 * time.Minute
+
+	defaultDownloadTimeout      = 10 * time.Second
+	defaultDownloadRetries      = 3
+	defaultDownloadRetryBackoff = 5 * time.Second
+
+	// maxErrorBodyLength is the maximum length of a response body included in error messages.
+	maxErrorBodyLength = 512
+	// maxSignatureLength is the maximum size of a downloaded detached signature.
+	maxSignatureLength = 64 * 1024
 )
 
 type FilterListProvider interface {
 	Setup() error
-	ReadSecretData(ctx context.Context) (map[string][]byte, error)
 	GetFilterList() []config.Filter
 }
 
+// filterListChangeNotifier is implemented by filter list providers whose filter list changes at runtime.
+type filterListChangeNotifier interface {
+	// FilterListChecksum returns the checksum of the current filter list.
+	FilterListChecksum() string
+	// FilterListChanged returns a channel receiving a value when the checksum of the filter list changed.
+	// Changes are coalesced until the value is received.
+	FilterListChanged() <-chan struct{}
+}
+
+// filterListChecksum returns the checksum of the filter list.
+func filterListChecksum(filterList []config.Filter) string {
+	data, _ := json.Marshal(filterList)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// notifyChanged sends a change notification without blocking, a pending notification already covers the change.
+func notifyChanged(changed chan<- struct{}) {
+	select {
+	case changed <- struct{}{}:
+	default:
+	}
+}
+
 type basicFilterListProvider struct {
 	ctx    context.Context
 	client client.Client
 	logger logr.Logger
 }
 
-// ReadSecretData reads the secret data of a secret in the extension deployment namespace.
-func (p *basicFilterListProvider) ReadSecretData(ctx context.Context) (map[string][]byte, error) {
+// getFilterListSecret reads the filter list secret in the extension deployment namespace.
+func (p *basicFilterListProvider) getFilterListSecret(ctx context.Context) (*corev1.Secret, error) {
 	namespace, err := getExtensionDeploymentNamespace()
 	if err != nil {
 		return nil, err
 	}
 	secret := &corev1.Secret{}
 	key := client.ObjectKey{Name: constants.FilterListSecretName, Namespace: namespace}
-	err = p.client.Get(ctx, key, secret)
-	if err != nil {
+	if err := p.client.Get(ctx, key, secret); err != nil {
 		return nil, err
 	}
-	return secret.Data, nil
+	return secret, nil
 }
 
 type StaticFilterListProvider struct {
@@ -90,22 +139,41 @@ func (p *StaticFilterListProvider) GetFilterList() []config.Filter {
 
 type DownloaderFilterListProvider struct {
 	basicFilterListProvider
+	// sourceName is the name of the download source or empty for egressFilter.downloaderConfig.
+	sourceName       string
+	defaultTags      []config.Tag
 	downloaderConfig *config.DownloaderConfig
 	oauth2Secret     *config.OAuth2Secret
-	ticker           *time.Ticker
-	tickerDone       chan bool
-	filterList       []config.Filter // Store the raw filter list in memory
+	// verifier verifies the signatures of the filter lists. It is nil if signature verification is disabled.
+	verifier   *signature.Verifier
+	ticker     *time.Ticker
+	tickerDone chan bool
+
+	lock         sync.RWMutex
+	filterList   []config.Filter // Store the raw filter list in memory
+	checksum     string
+	etag         string
+	lastModified string
+	// signatureFailure is the reason why the latest filter list was rejected by the signature verification.
+	signatureFailure string
+	// changed receives a value when a downloaded filter list differs from the previous one. It is shared by the
+	// download sources of a MultiSourceFilterListProvider.
+	changed chan struct{}
 }
 
-var _ FilterListProvider = &DownloaderFilterListProvider{}
+var (
+	_ FilterListProvider            = &DownloaderFilterListProvider{}
+	_ signatureVerificationReporter = &DownloaderFilterListProvider{}
+	_ filterListChangeNotifier      = &DownloaderFilterListProvider{}
+)
 
 func NewDownloaderFilterListProvider(ctx context.Context, client client.Client, logger logr.Logger,
-	downloaderConfig *config.DownloaderConfig, oauth2Secret *config.OAuth2Secret) *DownloaderFilterListProvider {
-	return newDownloaderFilterListProvider(ctx, client, logger, downloaderConfig, oauth2Secret)
+	downloaderConfig *config.DownloaderConfig, oauth2Secret *config.OAuth2Secret, verifier *signature.Verifier) *DownloaderFilterListProvider {
+	return newDownloaderFilterListProvider(ctx, client, logger, downloaderConfig, oauth2Secret, verifier)
 }
 
 func newDownloaderFilterListProvider(ctx context.Context, client client.Client, logger logr.Logger,
-	downloaderConfig *config.DownloaderConfig, oauth2Secret *config.OAuth2Secret) *DownloaderFilterListProvider {
+	downloaderConfig *config.DownloaderConfig, oauth2Secret *config.OAuth2Secret, verifier *signature.Verifier) *DownloaderFilterListProvider {
 
 	return &DownloaderFilterListProvider{
 		basicFilterListProvider: basicFilterListProvider{
@@ -115,21 +183,44 @@ func newDownloaderFilterListProvider(ctx context.Context, client client.Client,
 		},
 		downloaderConfig: downloaderConfig,
 		oauth2Secret:     oauth2Secret,
+		verifier:         verifier,
+		changed:          make(chan struct{}, 1),
 	}
 }
 
+func newDownloadSourceFilterListProvider(ctx context.Context, client client.Client, logger logr.Logger,
+	source *config.DownloadSource, oauth2Secret *config.OAuth2Secret, verifier *signature.Verifier) *DownloaderFilterListProvider {
+	p := newDownloaderFilterListProvider(ctx, client, logger.WithValues("source", source.Name), &source.DownloaderConfig, oauth2Secret, verifier)
+	p.sourceName = source.Name
+	p.defaultTags = source.DefaultTags
+	return p
+}
+
 func (p *DownloaderFilterListProvider) Setup() error {
-	if p.downloaderConfig == nil {
-		return fmt.Errorf("missing egressFilter.downloaderConfig")
+	if err := p.setup(); err != nil {
+		p.stopTicker()
+		return err
 	}
-	err := p.downloadAndStore()
-	if err != nil {
+	return nil
+}
+
+// setup validates the configuration, initializes the filter list and starts the refresh ticker.
+// The ticker is started even if no filter list is available yet, so that the download is retried.
+func (p *DownloaderFilterListProvider) setup() error {
+	if err := p.validate(); err != nil {
 		return err
 	}
+
+	if err := p.loadLastKnownGood(); err != nil {
+		p.logger.Info("cannot load last known good filter list", "error", err)
+	}
+	err := p.downloadAndStore()
+	if err != nil && len(p.GetFilterList()) > 0 {
+		p.logger.Info("using last known good filter list", "entries", len(p.GetFilterList()))
+		err = nil
+	}
+
 	if p.downloaderConfig.RefreshPeriod != nil {
-		if p.downloaderConfig.RefreshPeriod.Duration < minRefreshPeriod {
-			return fmt.Errorf("egressFilter.downloaderConfig.RefreshPeriod is too small: %.0f s < %.0f s", p.downloaderConfig.RefreshPeriod.Seconds(), minRefreshPeriod.Seconds())
-		}
 		p.ticker = time.NewTicker(p.downloaderConfig.RefreshPeriod.Duration)
 		p.tickerDone = make(chan bool)
 		go func() {
@@ -143,6 +234,33 @@ func (p *DownloaderFilterListProvider) Setup() error {
 			}
 		}()
 	}
+	return err
+}
+
+func (p *DownloaderFilterListProvider) validate() error {
+	fldPath := "egressFilter.downloaderConfig"
+	if p.sourceName != "" {
+		fldPath = fmt.Sprintf("egressFilter.downloadSources[%s]", p.sourceName)
+	}
+
+	if p.downloaderConfig == nil {
+		return fmt.Errorf("missing %s", fldPath)
+	}
+	if p.downloaderConfig.Timeout != nil && p.downloaderConfig.Timeout.Duration <= 0 {
+		return fmt.Errorf("%s.timeout must be positive", fldPath)
+	}
+	if p.downloaderConfig.Retries != nil && *p.downloaderConfig.Retries < 0 {
+		return fmt.Errorf("%s.retries must not be negative", fldPath)
+	}
+	if p.downloaderConfig.RetryBackoff != nil && p.downloaderConfig.RetryBackoff.Duration < 0 {
+		return fmt.Errorf("%s.retryBackoff must not be negative", fldPath)
+	}
+	if p.downloaderConfig.RefreshPeriod != nil && p.downloaderConfig.RefreshPeriod.Duration < minRefreshPeriod {
+		return fmt.Errorf("%s.RefreshPeriod is too small: %.0f s < %.0f s", fldPath, p.downloaderConfig.RefreshPeriod.Seconds(), minRefreshPeriod.Seconds())
+	}
+	if _, err := getFilterListParser(p.downloaderConfig.Format); err != nil {
+		return fmt.Errorf("%s.format: %w", fldPath, err)
+	}
 	return nil
 }
 
@@ -155,100 +273,511 @@ func (p *DownloaderFilterListProvider) stopTicker() {
 }
 
 func (p *DownloaderFilterListProvider) downloadAndStore() error {
-	filterList, err := p.download()
-	metrics.ReportDownload(err == nil)
+	result, err := p.downloadWithRetries()
+	metrics.ReportDownload(p.sourceName, err == nil)
 	if err != nil {
 		p.logger.Info("download failed", "error", err)
+		if isSignatureError(err) {
+			p.lock.Lock()
+			p.signatureFailure = err.Error()
+			p.lock.Unlock()
+		}
 		return err
 	}
+	if result.notModified {
+		p.logger.Info("download ok, filter list not modified")
+		return nil
+	}
 	p.logger.Info("download ok")
-	p.filterList = filterList
 
+	checksum := filterListChecksum(result.filterList)
+	p.lock.Lock()
+	changed := checksum != p.checksum
+	p.filterList = result.filterList
+	p.checksum = checksum
+	p.etag = result.etag
+	p.lastModified = result.lastModified
+	p.signatureFailure = ""
+	p.lock.Unlock()
+	metrics.ReportSourceEntries(p.sourceName, len(result.filterList))
+	if changed {
+		p.logger.Info("filter list changed", "checksum", checksum)
+		notifyChanged(p.changed)
+	}
+
+	if err := p.storeLastKnownGood(result); err != nil {
+		p.logger.Info("cannot store last known good filter list", "error", err)
+	}
 	return nil
 }
 
 func (p *DownloaderFilterListProvider) GetFilterList() []config.Filter {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
 	return p.filterList
 }
 
-func (p *DownloaderFilterListProvider) download() ([]config.Filter, error) {
-	req, err := http.NewRequest(http.MethodGet, p.downloaderConfig.Endpoint, nil)
+func (p *DownloaderFilterListProvider) FilterListChecksum() string {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.checksum
+}
+
+func (p *DownloaderFilterListProvider) FilterListChanged() <-chan struct{} {
+	return p.changed
+}
+
+// signatureSource returns the name of the source used for the signature verification.
+func (p *DownloaderFilterListProvider) signatureSource() string {
+	if p.sourceName == "" {
+		return string(config.FilterListSourceDownload)
+	}
+	return p.sourceName
+}
+
+func (p *DownloaderFilterListProvider) signatureVerificationFailures() []config.SignatureVerificationFailure {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.signatureFailure == "" {
+		return nil
+	}
+	return []config.SignatureVerificationFailure{{Source: p.signatureSource(), Reason: p.signatureFailure}}
+}
+
+// downloadResult is the result of a filter list download.
+type downloadResult struct {
+	filterList   []config.Filter
+	data         []byte
+	signature    []byte
+	etag         string
+	lastModified string
+	notModified  bool
+}
+
+// retriableError marks download errors which may be resolved by retrying.
+type retriableError struct {
+	err error
+}
+
+func (e *retriableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retriableError) Unwrap() error {
+	return e.err
+}
+
+// downloadWithRetries downloads the filter list and retries on transport errors and server side failures
+// with exponential backoff.
+func (p *DownloaderFilterListProvider) downloadWithRetries() (*downloadResult, error) {
+	retries, backoff := defaultDownloadRetries, defaultDownloadRetryBackoff
+	if p.downloaderConfig.Retries != nil {
+		retries = int(*p.downloaderConfig.Retries)
+	}
+	if p.downloaderConfig.RetryBackoff != nil {
+		backoff = p.downloaderConfig.RetryBackoff.Duration
+	}
+
+	for attempt := 0; ; attempt++ {
+		result, err := p.download()
+		var retriable *retriableError
+		if err == nil || !errors.As(err, &retriable) || attempt >= retries {
+			return result, err
+		}
+		p.logger.Info("download failed, retrying", "error", err, "attempt", attempt+1, "backoff", backoff)
+		select {
+		case <-p.ctx.Done():
+			return nil, p.ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (p *DownloaderFilterListProvider) download() (*downloadResult, error) {
+	timeout := defaultDownloadTimeout
+	if p.downloaderConfig.Timeout != nil {
+		timeout = p.downloaderConfig.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(p.ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.downloaderConfig.Endpoint, nil)
 	if err != nil {
 		return nil, err
 	}
 	if p.downloaderConfig.OAuth2Endpoint != nil {
 		token, err := p.getAccessToken(*p.downloaderConfig.OAuth2Endpoint, p.oauth2Secret)
 		if err != nil {
-			return nil, fmt.Errorf("retrieving access token failed: %w", err)
+			return nil, &retriableError{fmt.Errorf("retrieving access token failed: %w", err)}
 		}
 		req.Header.Add("Authorization", "Bearer "+token)
 	}
-	cl := &http.Client{
-		Timeout: 10 * time.Second,
+	if parser, err := getFilterListParser(p.downloaderConfig.Format); err == nil {
+		req.Header.Set("Accept", parser.accept)
+	}
+
+	p.lock.RLock()
+	if len(p.filterList) > 0 {
+		if p.etag != "" {
+			req.Header.Set("If-None-Match", p.etag)
+		}
+		if p.lastModified != "" {
+			req.Header.Set("If-Modified-Since", p.lastModified)
+		}
 	}
+	p.lock.RUnlock()
 
-	resp, err := cl.Do(req) // #nosec G704 -- downloaderConfig is only supported in seed configuration
+	resp, err := http.DefaultClient.Do(req) // #nosec G704 -- downloaderConfig is only supported in seed configuration
 	if err != nil {
-		return nil, err
+		return nil, &retriableError{err}
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified {
+		return &downloadResult{notModified: true}, nil
+	}
+
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
+		return nil, &retriableError{err}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := fmt.Errorf("unexpected status code %s: '%s'", resp.Status, truncate(string(b), maxErrorBodyLength))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return nil, &retriableError{err}
+		}
 		return nil, err
 	}
 
-	// Parse filter list using common parser
-	filterList, err := parseFilterList(b)
-	if err != nil {
-		wrappedErr := fmt.Errorf("could not unmarshal body: '%s'", string(b))
-		return nil, fmt.Errorf("unmarshalling body failed: %w: %w", err, wrappedErr)
+	var sig []byte
+	if p.verifier != nil {
+		sig, err = p.downloadSignature(ctx, req.Header.Get("Authorization"))
+		if err != nil {
+			return nil, err
+		}
+		keyName, err := verifyFilterListSignature(p.verifier, p.signatureSource(), b, sig)
+		if err != nil {
+			return nil, err
+		}
+		p.logger.Info("verified filter list signature", "key", keyName)
 	}
 
+	filterList, err := parseDownloadedFilterList(b, p.downloaderConfig.Format)
+	if err != nil {
+		return nil, err
+	}
+	filterList = addDefaultTags(filterList, p.defaultTags)
 	p.logger.Info("downloaded filter list", "entries", len(filterList))
 
+	return &downloadResult{
+		filterList:   filterList,
+		data:         b,
+		signature:    sig,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// downloadSignature downloads the detached signature of the filter list.
+// A missing signature results in an empty signature, which is rejected by the verification.
+func (p *DownloaderFilterListProvider) downloadSignature(ctx context.Context, authorization string) ([]byte, error) {
+	endpoint := p.downloaderConfig.SignatureEndpoint
+	if endpoint == "" {
+		endpoint = p.downloaderConfig.Endpoint + signatureSuffix
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := http.DefaultClient.Do(req) // #nosec G704 -- downloaderConfig is only supported in seed configuration
+	if err != nil {
+		return nil, &retriableError{fmt.Errorf("downloading signature failed: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxSignatureLength))
+	if err != nil {
+		return nil, &retriableError{fmt.Errorf("downloading signature failed: %w", err)}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := fmt.Errorf("unexpected status code of signature %s: '%s'", resp.Status, truncate(string(b), maxErrorBodyLength))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			return nil, &retriableError{err}
+		}
+		return nil, err
+	}
+	return b, nil
+}
+
+// parseDownloadedFilterList parses and validates a downloaded filter list in the given format.
+func parseDownloadedFilterList(data []byte, format config.FilterListFormat) ([]config.Filter, error) {
+	filterList, err := parseFilterListWithFormat(data, format)
+	if err != nil {
+		wrappedErr := fmt.Errorf("could not unmarshal body: '%s'", truncate(string(data), maxErrorBodyLength))
+		return nil, fmt.Errorf("unmarshalling body failed: %w: %w", err, wrappedErr)
+	}
+
 	if len(filterList) > constants.FilterListMaxEntries {
 		return nil, fmt.Errorf("filterList too large: %d entries (max %d)", len(filterList), constants.FilterListMaxEntries)
 	}
 
 	for i, filter := range filterList {
-		if _, _, err := net.ParseCIDR(filter.Network); err != nil {
+		if filter.FQDN != "" {
+			if filter.Network != "" {
+				return nil, fmt.Errorf("filterList[%d]: network and fqdn are mutually exclusive", i)
+			}
+			if !isFQDN(filter.FQDN) {
+				return nil, fmt.Errorf("filterList[%d].fqdn: %q is not a valid FQDN", i, filter.FQDN)
+			}
+		} else if _, _, err := net.ParseCIDR(filter.Network); err != nil {
 			return nil, fmt.Errorf("filterList[%d].network: %q  %w", i, filter.Network, err)
 		}
+		if errs := configvalidation.ValidateFilterScope(&filter, field.NewPath(fmt.Sprintf("filterList[%d]", i))); len(errs) > 0 {
+			return nil, errs.ToAggregate()
+		}
 	}
 	return filterList, nil
 }
 
-// convertV2ToV1 converts a v2 format filter list to v1 format
-func convertV2ToV1(filterListV2 []config.FilterListV2) ([]config.Filter, error) {
-	var result []config.Filter
-	for _, list := range filterListV2 {
-		for _, entry := range list.Entries {
-			policy, err := convertPolicyV2ToV1(entry.Policy)
-			if err != nil {
-				return nil, fmt.Errorf("invalid policy for network %s: %w", entry.Target, err)
+// addDefaultTags adds the default tags to all entries which do not have a tag with the same name.
+func addDefaultTags(filterList []config.Filter, defaultTags []config.Tag) []config.Filter {
+	if len(defaultTags) == 0 {
+		return filterList
+	}
+	for i := range filterList {
+		tags := filterList[i].Tags
+		for _, defaultTag := range defaultTags {
+			if !slices.ContainsFunc(tags, func(tag config.Tag) bool { return tag.Name == defaultTag.Name }) {
+				tags = append(tags, defaultTag)
 			}
-			filter := config.Filter{
-				Network: entry.Target,
-				Policy:  policy,
-				Tags:    entry.Tags, // Preserve tags from v2 format
+		}
+		filterList[i].Tags = tags
+	}
+	return filterList
+}
+
+// loadLastKnownGood initializes the filter list from the copy persisted in the filter list secret.
+func (p *DownloaderFilterListProvider) loadLastKnownGood() error {
+	secret, err := p.getFilterListSecret(p.ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	data, ok := secret.Data[p.secretKey(constants.KeyFilterList)]
+	if !ok {
+		return nil
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to create gzip reader: %w", err)
+	}
+	defer gr.Close()
+	decompressed, err := io.ReadAll(gr)
+	if err != nil {
+		return fmt.Errorf("failed to decompress gzip data: %w", err)
+	}
+	if p.verifier != nil {
+		if _, err := verifyFilterListSignature(p.verifier, p.signatureSource(), decompressed, secret.Data[p.secretKey(constants.KeyFilterListSignature)]); err != nil {
+			return err
+		}
+	}
+	filterList, err := parseDownloadedFilterList(decompressed, p.downloaderConfig.Format)
+	if err != nil {
+		return err
+	}
+	filterList = addDefaultTags(filterList, p.defaultTags)
+	p.logger.Info("loaded last known good filter list", "entries", len(filterList))
+	metrics.ReportSourceEntries(p.sourceName, len(filterList))
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.filterList = filterList
+	p.checksum = filterListChecksum(filterList)
+	p.etag = string(secret.Data[p.secretKey(constants.KeyFilterListETag)])
+	p.lastModified = string(secret.Data[p.secretKey(constants.KeyFilterListLastModified)])
+	return nil
+}
+
+// secretKey returns the key in the filter list secret used to persist the given data of the download source.
+func (p *DownloaderFilterListProvider) secretKey(key string) string {
+	if p.sourceName == "" {
+		return key
+	}
+	return p.sourceName + "." + key
+}
+
+// storeLastKnownGood persists the downloaded filter list gzip compressed in the filter list secret.
+func (p *DownloaderFilterListProvider) storeLastKnownGood(result *downloadResult) error {
+	namespace, err := getExtensionDeploymentNamespace()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(result.data); err != nil {
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		return err
+	}
+
+	// download sources share the secret and store their filter lists concurrently, so conflicting creations and
+	// updates are retried
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: constants.FilterListSecretName, Namespace: namespace}}
+		_, err := controllerutil.CreateOrUpdate(p.ctx, p.client, secret, func() error {
+			if secret.Data == nil {
+				secret.Data = map[string][]byte{}
+			}
+			secret.Data[p.secretKey(constants.KeyFilterList)] = buf.Bytes()
+			secret.Data[p.secretKey(constants.KeyFilterListETag)] = []byte(result.etag)
+			secret.Data[p.secretKey(constants.KeyFilterListLastModified)] = []byte(result.lastModified)
+			if result.signature != nil {
+				secret.Data[p.secretKey(constants.KeyFilterListSignature)] = result.signature
+			} else {
+				delete(secret.Data, p.secretKey(constants.KeyFilterListSignature))
 			}
-			result = append(result, filter)
+			return nil
+		})
+		return err
+	})
+}
+
+// MultiSourceFilterListProvider downloads the filter lists of several named sources and merges them.
+// The sources are isolated from each other: a source which cannot be downloaded keeps its last known good
+// filter list and does not affect the filter lists of the other sources.
+type MultiSourceFilterListProvider struct {
+	basicFilterListProvider
+	sources []*DownloaderFilterListProvider
+	changed chan struct{}
+}
+
+var (
+	_ FilterListProvider            = &MultiSourceFilterListProvider{}
+	_ signatureVerificationReporter = &MultiSourceFilterListProvider{}
+	_ filterListChangeNotifier      = &MultiSourceFilterListProvider{}
+)
+
+func NewMultiSourceFilterListProvider(ctx context.Context, client client.Client, logger logr.Logger,
+	sources []config.DownloadSource, oauth2Secrets map[string]*config.OAuth2Secret, verifier *signature.Verifier) *MultiSourceFilterListProvider {
+	return newMultiSourceFilterListProvider(ctx, client, logger, sources, oauth2Secrets, verifier)
+}
+
+func newMultiSourceFilterListProvider(ctx context.Context, client client.Client, logger logr.Logger,
+	sources []config.DownloadSource, oauth2Secrets map[string]*config.OAuth2Secret, verifier *signature.Verifier) *MultiSourceFilterListProvider {
+	p := &MultiSourceFilterListProvider{
+		basicFilterListProvider: basicFilterListProvider{
+			ctx:    ctx,
+			client: client,
+			logger: logger.WithName("flp-multi-source"),
+		},
+		changed: make(chan struct{}, 1),
+	}
+	for i := range sources {
+		source := newDownloadSourceFilterListProvider(ctx, client, logger, &sources[i], oauth2Secrets[sources[i].Name], verifier)
+		source.changed = p.changed
+		p.sources = append(p.sources, source)
+	}
+	return p
+}
+
+// Setup initializes all download sources. It only fails if the configuration is invalid or no source
+// provides a filter list.
+func (p *MultiSourceFilterListProvider) Setup() error {
+	if len(p.sources) == 0 {
+		return fmt.Errorf("missing egressFilter.downloadSources")
+	}
+	names := sets.New[string]()
+	for _, source := range p.sources {
+		if errs := validation.IsDNS1123Label(source.sourceName); len(errs) > 0 {
+			return fmt.Errorf("invalid name of egressFilter.downloadSources %q: %s", source.sourceName, strings.Join(errs, ", "))
+		}
+		if names.Has(source.sourceName) {
+			return fmt.Errorf("duplicate name of egressFilter.downloadSources %q", source.sourceName)
+		}
+		names.Insert(source.sourceName)
+		if err := source.validate(); err != nil {
+			return err
 		}
 	}
-	return result, nil
+
+	// the sources are downloaded in parallel, so that the retries of failing sources do not add up
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(p.sources))
+	)
+	for i, source := range p.sources {
+		wg.Go(func() {
+			if err := source.setup(); err != nil {
+				p.logger.Info("download source has no filter list, ignoring it until the next refresh", "source", source.sourceName, "error", err)
+				errs[i] = fmt.Errorf("download source %q: %w", source.sourceName, err)
+			}
+		})
+	}
+	wg.Wait()
+	if !slices.Contains(errs, nil) {
+		p.stopTickers()
+		return errors.Join(errs...)
+	}
+	return nil
 }
 
-// convertPolicyV2ToV1 converts v2 policy format to v1 format
-func convertPolicyV2ToV1(policyV2 config.Policy) (config.Policy, error) {
-	switch policyV2 {
-	case config.PolicyBlock:
-		return config.PolicyBlockAccess, nil
-	case config.PolicyAllow:
-		return config.PolicyAllowAccess, nil
-	default:
-		return "", fmt.Errorf("unknown policy value: %s", policyV2)
+func (p *MultiSourceFilterListProvider) stopTickers() {
+	for _, source := range p.sources {
+		source.stopTicker()
+	}
+}
+
+// GetFilterList returns the merged filter lists of all download sources.
+func (p *MultiSourceFilterListProvider) GetFilterList() []config.Filter {
+	var result []config.Filter
+	for _, source := range p.sources {
+		result = append(result, source.GetFilterList()...)
+	}
+	return result
+}
+
+// FilterListChecksum returns the checksum of the merged filter lists of all download sources.
+func (p *MultiSourceFilterListProvider) FilterListChecksum() string {
+	return filterListChecksum(p.GetFilterList())
+}
+
+// FilterListChanged returns a channel receiving a value when the filter list of any download source changed.
+func (p *MultiSourceFilterListProvider) FilterListChanged() <-chan struct{} {
+	return p.changed
+}
+
+func (p *MultiSourceFilterListProvider) signatureVerificationFailures() []config.SignatureVerificationFailure {
+	var result []config.SignatureVerificationFailure
+	for _, source := range p.sources {
+		result = append(result, source.signatureVerificationFailures()...)
+	}
+	return result
+}
+
+func truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
 	}
+	return s[:maxLength] + "..."
 }
 
 func (p *DownloaderFilterListProvider) getAccessToken(endpoint string, oauth2secret *config.OAuth2Secret) (string, error) {
@@ -1,20 +1,35 @@
 package lifecycle
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"k8s.io/apimachinery/pkg/runtime"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
 )
 
 var _ = Describe("DownloaderFilterListProvider", func() {
@@ -30,15 +45,16 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 	BeforeEach(func() {
 		ctx = context.Background()
 		logger = logr.Discard()
-		client = fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+		client = fake.NewClientBuilder().Build()
 		downloaderConf = &config.DownloaderConfig{
-			Endpoint: "http://example.com/filters",
+			Endpoint:     "http://example.com/filters",
+			RetryBackoff: &metav1.Duration{Duration: time.Millisecond},
 		}
 		oauth2Secret = &config.OAuth2Secret{
 			ClientID:     "id",
 			ClientSecret: "secret",
 		}
-		provider = NewDownloaderFilterListProvider(ctx, client, logger, downloaderConf, oauth2Secret)
+		provider = NewDownloaderFilterListProvider(ctx, client, logger, downloaderConf, oauth2Secret, nil)
 	})
 
 	Describe("#download", func() {
@@ -56,7 +72,7 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 
 			result, err := provider.download()
 			Expect(err).To(BeNil())
-			Expect(result).To(Equal(filters))
+			Expect(result.filterList).To(Equal(filters))
 		})
 
 		It("should fail on invalid JSON", func() {
@@ -82,8 +98,7 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 
 			_, err := provider.download()
 			Expect(err).To(HaveOccurred())
-			fmt.Println(err)
-			Expect(err.Error()).To(ContainSubstring("unmarshalling body failed"))
+			Expect(err.Error()).To(ContainSubstring("unexpected status code 502 Bad Gateway"))
 			Expect(err.Error()).To(ContainSubstring("no healthy upstream"))
 		})
 
@@ -123,11 +138,11 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 
 			result, err := provider.download()
 			Expect(err).To(BeNil())
-			Expect(result).To(HaveLen(2))
-			Expect(result[0].Network).To(Equal("10.0.0.0/8"))
-			Expect(result[0].Policy).To(Equal(config.PolicyBlockAccess))
-			Expect(result[1].Network).To(Equal("192.168.1.0/24"))
-			Expect(result[1].Policy).To(Equal(config.PolicyAllowAccess))
+			Expect(result.filterList).To(HaveLen(2))
+			Expect(result.filterList[0].Network).To(Equal("10.0.0.0/8"))
+			Expect(result.filterList[0].Policy).To(Equal(config.PolicyBlockAccess))
+			Expect(result.filterList[1].Network).To(Equal("192.168.1.0/24"))
+			Expect(result.filterList[1].Policy).To(Equal(config.PolicyAllowAccess))
 		})
 
 		It("should correctly detect v1 format when both fields exist", func() {
@@ -145,9 +160,9 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 
 			result, err := provider.download()
 			Expect(err).To(BeNil())
-			Expect(result).To(HaveLen(1))
-			Expect(result[0].Network).To(Equal("172.16.0.0/12"))
-			Expect(result[0].Policy).To(Equal(config.PolicyBlockAccess))
+			Expect(result.filterList).To(HaveLen(1))
+			Expect(result.filterList[0].Network).To(Equal("172.16.0.0/12"))
+			Expect(result.filterList[0].Policy).To(Equal(config.PolicyBlockAccess))
 		})
 
 		It("should preserve tags when converting v2 to v1 format", func() {
@@ -182,25 +197,258 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 
 			result, err := provider.download()
 			Expect(err).To(BeNil())
-			Expect(result).To(HaveLen(2))
+			Expect(result.filterList).To(HaveLen(2))
 
 			// Verify first entry with tags
-			Expect(result[0].Network).To(Equal("10.0.0.0/8"))
-			Expect(result[0].Policy).To(Equal(config.PolicyBlockAccess))
-			Expect(result[0].Tags).To(HaveLen(2))
-			Expect(result[0].Tags[0].Name).To(Equal("S"))
-			Expect(result[0].Tags[0].Values).To(ConsistOf("1"))
-			Expect(result[0].Tags[1].Name).To(Equal("Region"))
-			Expect(result[0].Tags[1].Values).To(ConsistOf("EU"))
+			Expect(result.filterList[0].Network).To(Equal("10.0.0.0/8"))
+			Expect(result.filterList[0].Policy).To(Equal(config.PolicyBlockAccess))
+			Expect(result.filterList[0].Tags).To(HaveLen(2))
+			Expect(result.filterList[0].Tags[0].Name).To(Equal("S"))
+			Expect(result.filterList[0].Tags[0].Values).To(ConsistOf("1"))
+			Expect(result.filterList[0].Tags[1].Name).To(Equal("Region"))
+			Expect(result.filterList[0].Tags[1].Values).To(ConsistOf("EU"))
 
 			// Verify second entry with tags
-			Expect(result[1].Network).To(Equal("192.168.1.0/24"))
-			Expect(result[1].Policy).To(Equal(config.PolicyAllowAccess))
-			Expect(result[1].Tags).To(HaveLen(1))
-			Expect(result[1].Tags[0].Name).To(Equal("S"))
-			Expect(result[1].Tags[0].Values).To(ConsistOf("2"))
+			Expect(result.filterList[1].Network).To(Equal("192.168.1.0/24"))
+			Expect(result.filterList[1].Policy).To(Equal(config.PolicyAllowAccess))
+			Expect(result.filterList[1].Tags).To(HaveLen(1))
+			Expect(result.filterList[1].Tags[0].Name).To(Equal("S"))
+			Expect(result.filterList[1].Tags[0].Values).To(ConsistOf("2"))
+		})
+
+	})
+
+	Describe("#downloadAndStore", func() {
+		var (
+			filters = []config.Filter{
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+			}
+			body []byte
+		)
+
+		BeforeEach(func() {
+			GinkgoT().Setenv(constants.FilterNamespaceEnvName, "extension-shoot-networking-filter")
+			body, _ = json.Marshal(filters)
+		})
+
+		readSecret := func() *corev1.Secret {
+			secret := &corev1.Secret{}
+			Expect(client.Get(ctx, types.NamespacedName{Namespace: "extension-shoot-networking-filter", Name: constants.FilterListSecretName}, secret)).To(Succeed())
+			return secret
+		}
+
+		It("should use conditional requests and keep the filter list if not modified", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				if r.Header.Get("If-None-Match") == `"v1"` {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set("ETag", `"v1"`)
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+			provider.downloaderConfig.Endpoint = server.URL
+
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+			Expect(requests.Load()).To(Equal(int32(2)))
+
+			secret := readSecret()
+			Expect(string(secret.Data[constants.KeyFilterListETag])).To(Equal(`"v1"`))
+			gr, err := gzip.NewReader(bytes.NewReader(secret.Data[constants.KeyFilterList]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(io.ReadAll(gr)).To(Equal(body))
+		})
+
+		It("should notify about changes of the filter list", func() {
+			var version atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("ETag", fmt.Sprintf(`"v%d"`, version.Load()))
+				w.WriteHeader(http.StatusOK)
+				if version.Load() <= 0 {
+					_, _ = w.Write(body)
+					return
+				}
+				_, _ = w.Write([]byte(`[{"network":"5.6.7.0/24","policy":"BLOCK_ACCESS"}]`))
+			}))
+			defer server.Close()
+			provider.downloaderConfig.Endpoint = server.URL
+
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.FilterListChanged()).To(Receive())
+			checksum := provider.FilterListChecksum()
+			Expect(checksum).To(Equal(filterListChecksum(filters)))
+
+			// the same filter list with another ETag is no change
+			version.Store(-1)
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.FilterListChanged()).NotTo(Receive())
+			Expect(provider.FilterListChecksum()).To(Equal(checksum))
+
+			version.Store(1)
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.FilterListChanged()).To(Receive())
+			Expect(provider.FilterListChanged()).NotTo(Receive())
+			Expect(provider.FilterListChecksum()).NotTo(Equal(checksum))
+		})
+
+		It("should retry on server errors", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+			provider.downloaderConfig.Endpoint = server.URL
+
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+			Expect(requests.Load()).To(Equal(int32(3)))
+		})
+
+		It("should not retry on client errors and keep the previous filter list", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write(body)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer server.Close()
+			provider.downloaderConfig.Endpoint = server.URL
+
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.downloadAndStore()).To(MatchError(ContainSubstring("unexpected status code 404")))
+			Expect(provider.GetFilterList()).To(Equal(filters))
+			Expect(requests.Load()).To(Equal(int32(2)))
+		})
+
+		It("should start with the last known good filter list if the download fails", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write(body)
+			}))
+			provider.downloaderConfig.Endpoint = server.URL
+			Expect(provider.downloadAndStore()).To(Succeed())
+			server.Close()
+
+			provider = NewDownloaderFilterListProvider(ctx, client, logger, downloaderConf, oauth2Secret, nil)
+			provider.downloaderConfig.Retries = new(int32(0))
+			Expect(provider.Setup()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+		})
+
+		It("should fail on startup without last known good filter list if the download fails", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer server.Close()
+			provider.downloaderConfig.Endpoint = server.URL
+			provider.downloaderConfig.Retries = new(int32(1))
+
+			Expect(provider.Setup()).To(MatchError(ContainSubstring("unexpected status code 502")))
 		})
+	})
+
+	Describe("#download with signature verification", func() {
+		var (
+			filters    = []config.Filter{{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess}}
+			body       []byte
+			privateKey ed25519.PrivateKey
+			signatures map[string][]byte
+			server     *httptest.Server
+		)
+
+		BeforeEach(func() {
+			GinkgoT().Setenv(constants.FilterNamespaceEnvName, "extension-shoot-networking-filter")
+			body, _ = json.Marshal(filters)
+
+			publicKey, key, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			privateKey = key
+			der, err := x509.MarshalPKIXPublicKey(publicKey)
+			Expect(err).NotTo(HaveOccurred())
+			verifier, err := NewSignatureVerifier(&config.SignatureVerification{TrustedKeys: []config.TrustedKey{
+				{Name: "feed", PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			signatures = map[string][]byte{}
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/filters":
+					_, _ = w.Write(body)
+				default:
+					sig, ok := signatures[r.URL.Path]
+					if !ok {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					_, _ = w.Write(sig)
+				}
+			}))
+			DeferCleanup(server.Close)
+
+			downloaderConf.Endpoint = server.URL + "/filters"
+			downloaderConf.Retries = new(int32(0))
+			provider = NewDownloaderFilterListProvider(ctx, client, logger, downloaderConf, oauth2Secret, verifier)
+		})
+
+		It("should accept a filter list with a valid signature and persist the signature", func() {
+			signatures["/filters.sig"] = []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, body)))
+
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+			Expect(provider.signatureVerificationFailures()).To(BeEmpty())
+
+			secret := &corev1.Secret{}
+			Expect(client.Get(ctx, types.NamespacedName{Namespace: "extension-shoot-networking-filter", Name: constants.FilterListSecretName}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue(constants.KeyFilterListSignature, signatures["/filters.sig"]))
+
+			provider = NewDownloaderFilterListProvider(ctx, client, logger, downloaderConf, oauth2Secret, provider.verifier)
+			Expect(provider.loadLastKnownGood()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+		})
+
+		It("should use the configured signature endpoint", func() {
+			signatures["/signatures/filters"] = ed25519.Sign(privateKey, body)
+			downloaderConf.SignatureEndpoint = server.URL + "/signatures/filters"
 
+			Expect(provider.downloadAndStore()).To(Succeed())
+			Expect(provider.GetFilterList()).To(Equal(filters))
+		})
+
+		It("should reject a filter list without signature", func() {
+			err := provider.downloadAndStore()
+			Expect(err).To(MatchError(ContainSubstring("missing signature")))
+			Expect(isSignatureError(err)).To(BeTrue())
+			Expect(provider.GetFilterList()).To(BeEmpty())
+			Expect(provider.signatureVerificationFailures()).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{"Source": Equal("download"), "Reason": ContainSubstring("missing signature")}),
+			))
+		})
+
+		It("should reject a filter list with an invalid signature and keep the previous filter list", func() {
+			signatures["/filters.sig"] = ed25519.Sign(privateKey, body)
+			Expect(provider.downloadAndStore()).To(Succeed())
+
+			body = []byte(`[{"network":"0.0.0.0/1","policy":"ALLOW_ACCESS"}]`)
+			Expect(provider.downloadAndStore()).To(MatchError(ContainSubstring("invalid signature")))
+			Expect(provider.GetFilterList()).To(Equal(filters))
+			Expect(provider.signatureVerificationFailures()).To(HaveLen(1))
+		})
 	})
 
 	Describe("#getAccessToken", func() {
@@ -225,3 +473,134 @@ var _ = Describe("DownloaderFilterListProvider", func() {
 		})
 	})
 })
+
+var _ = Describe("MultiSourceFilterListProvider", func() {
+	var (
+		ctx    context.Context
+		client client.Client
+
+		threatFeed = []config.Filter{
+			{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "category", Values: []string{"malware"}}}},
+		}
+		sanctionsList = []config.Filter{
+			{Network: "5.6.7.0/24", Policy: config.PolicyBlockAccess},
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		client = fake.NewClientBuilder().Build()
+		GinkgoT().Setenv(constants.FilterNamespaceEnvName, "extension-shoot-networking-filter")
+	})
+
+	serve := func(statusCode int, filters []config.Filter) *httptest.Server {
+		body, _ := json.Marshal(filters)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCode)
+			_, _ = w.Write(body)
+		}))
+		DeferCleanup(server.Close)
+		return server
+	}
+
+	source := func(name, endpoint string, defaultTags ...config.Tag) config.DownloadSource {
+		return config.DownloadSource{
+			Name:        name,
+			DefaultTags: defaultTags,
+			DownloaderConfig: config.DownloaderConfig{
+				Endpoint: endpoint,
+				Retries:  new(int32(0)),
+			},
+		}
+	}
+
+	It("should merge the filter lists of all sources and add the default tags", func() {
+		provider := NewMultiSourceFilterListProvider(ctx, client, logr.Discard(), []config.DownloadSource{
+			source("threats", serve(http.StatusOK, threatFeed).URL, config.Tag{Name: "category", Values: []string{"other"}}),
+			source("sanctions", serve(http.StatusOK, sanctionsList).URL, config.Tag{Name: "category", Values: []string{"sanctions"}}),
+		}, nil, nil)
+
+		Expect(provider.Setup()).To(Succeed())
+		Expect(provider.GetFilterList()).To(Equal([]config.Filter{
+			{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "category", Values: []string{"malware"}}}},
+			{Network: "5.6.7.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "category", Values: []string{"sanctions"}}}},
+		}))
+
+		secret := &corev1.Secret{}
+		Expect(client.Get(ctx, types.NamespacedName{Namespace: "extension-shoot-networking-filter", Name: constants.FilterListSecretName}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKey("threats." + constants.KeyFilterList))
+		Expect(secret.Data).To(HaveKey("sanctions." + constants.KeyFilterList))
+	})
+
+	It("should keep the filter lists of other sources if one source fails", func() {
+		provider := NewMultiSourceFilterListProvider(ctx, client, logr.Discard(), []config.DownloadSource{
+			source("threats", serve(http.StatusBadGateway, nil).URL),
+			source("sanctions", serve(http.StatusOK, sanctionsList).URL),
+		}, nil, nil)
+
+		Expect(provider.Setup()).To(Succeed())
+		Expect(provider.GetFilterList()).To(Equal(sanctionsList))
+		Expect(provider.FilterListChanged()).To(Receive())
+		Expect(provider.FilterListChecksum()).To(Equal(filterListChecksum(sanctionsList)))
+	})
+
+	It("should download the sources in parallel", func() {
+		var (
+			started sync.WaitGroup
+			body, _ = json.Marshal(sanctionsList)
+		)
+		started.Add(2)
+		// each server only responds once both downloads have started
+		serveTogether := func() string {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				started.Done()
+				started.Wait()
+				_, _ = w.Write(body)
+			}))
+			DeferCleanup(server.Close)
+			return server.URL
+		}
+		provider := NewMultiSourceFilterListProvider(ctx, client, logr.Discard(), []config.DownloadSource{
+			source("threats", serveTogether()),
+			source("sanctions", serveTogether()),
+		}, nil, nil)
+
+		Expect(provider.Setup()).To(Succeed())
+		Expect(provider.GetFilterList()).To(HaveLen(2))
+
+		secret := &corev1.Secret{}
+		Expect(client.Get(ctx, types.NamespacedName{Namespace: "extension-shoot-networking-filter", Name: constants.FilterListSecretName}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKey("threats." + constants.KeyFilterList))
+		Expect(secret.Data).To(HaveKey("sanctions." + constants.KeyFilterList))
+	})
+
+	It("should fail if no source provides a filter list", func() {
+		provider := NewMultiSourceFilterListProvider(ctx, client, logr.Discard(), []config.DownloadSource{
+			source("threats", serve(http.StatusBadGateway, nil).URL),
+			source("sanctions", serve(http.StatusNotFound, nil).URL),
+		}, nil, nil)
+
+		err := provider.Setup()
+		Expect(err).To(MatchError(ContainSubstring(`download source "threats"`)))
+		Expect(err).To(MatchError(ContainSubstring(`download source "sanctions"`)))
+	})
+
+	It("should reject duplicate source names", func() {
+		provider := NewMultiSourceFilterListProvider(ctx, client, logr.Discard(), []config.DownloadSource{
+			source("threats", "http://example.com/a"),
+			source("threats", "http://example.com/b"),
+		}, nil, nil)
+
+		Expect(provider.Setup()).To(MatchError(ContainSubstring("duplicate name")))
+	})
+})
+
+var _ = DescribeTable("#parseDownloadedFilterList errors", func(data, expectedError string) {
+	_, err := parseDownloadedFilterList([]byte(data), config.FilterListFormatJSON)
+	Expect(err).To(MatchError(ContainSubstring(expectedError)))
+},
+	Entry("invalid network", `[{"network":"foo","policy":"BLOCK_ACCESS"}]`, `filterList[0].network: "foo"`),
+	Entry("network and fqdn", `[{"network":"1.2.3.4/32","fqdn":"example.com","policy":"BLOCK_ACCESS"}]`, "network and fqdn are mutually exclusive"),
+	Entry("ports without protocol", `[{"network":"1.2.3.4/32","policy":"BLOCK_ACCESS","ports":[{"port":25}]}]`, "filterList[0].protocol: Required value"),
+	Entry("invalid port range", `[{"network":"1.2.3.4/32","policy":"BLOCK_ACCESS","protocol":"TCP","ports":[{"port":25,"endPort":24}]}]`, "filterList[0].ports[0].endPort: Invalid value"),
+)
@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// filterListParser parses filter list data of a specific format.
+type filterListParser struct {
+	// parse parses the data and returns the entries in v1 format.
+	parse func(data []byte) ([]config.Filter, error)
+	// accept is the value of the Accept header used to download filter lists of this format.
+	accept string
+}
+
+// filterListParsers contains the parsers of all supported filter list formats.
+var filterListParsers = map[config.FilterListFormat]filterListParser{
+	config.FilterListFormatJSON: {parse: parseFilterList, accept: "application/json"},
+	config.FilterListFormatText: {parse: parseTextFilterList, accept: "text/plain"},
+	config.FilterListFormatCSV:  {parse: parseCSVFilterList, accept: "text/csv"},
+	config.FilterListFormatSTIX: {parse: parseSTIXFilterList, accept: "application/taxii+json;version=2.1, application/json"},
+}
+
+// getFilterListParser returns the parser of the given format. The JSON parser is used if the format is empty.
+func getFilterListParser(format config.FilterListFormat) (filterListParser, error) {
+	if format == "" {
+		format = config.FilterListFormatJSON
+	}
+	parser, ok := filterListParsers[format]
+	if !ok {
+		return filterListParser{}, fmt.Errorf("unsupported filter list format %q", format)
+	}
+	return parser, nil
+}
+
+// parseFilterListWithFormat parses the filter list data in the given format.
+func parseFilterListWithFormat(data []byte, format config.FilterListFormat) ([]config.Filter, error) {
+	parser, err := getFilterListParser(format)
+	if err != nil {
+		return nil, err
+	}
+	return parser.parse(data)
+}
+
+// parseTextFilterList parses a list with one network, IP address or FQDN per line as published by e.g. Spamhaus
+// DROP or FireHOL. Everything after `#` or `;` is ignored, as well as additional fields after the network.
+// All entries are blocked.
+func parseTextFilterList(data []byte) ([]config.Filter, error) {
+	var result []config.Filter
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		if i := strings.IndexAny(line, "#;"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		filter, err := newTargetFilter(fields[0], config.PolicyBlockAccess)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		result = append(result, filter)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// parseCSVFilterList parses a CSV list with a header line. The `network` (or `target`) column is required and
+// contains a network, IP address or FQDN.
+// The optional `policy` column contains the access policy in v1 or v2 notation and defaults to blocking.
+// All other columns are converted to tags named after the column, multiple values are separated by `|`.
+// Lines starting with `#` are ignored.
+func parseCSVFilterList(data []byte) ([]config.Filter, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	networkColumn, policyColumn := -1, -1
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		switch strings.ToLower(header[i]) {
+		case "network", "target":
+			networkColumn = i
+		case "policy":
+			policyColumn = i
+		}
+	}
+	if networkColumn < 0 {
+		return nil, fmt.Errorf("missing column %q in CSV header", "network")
+	}
+
+	var result []config.Filter
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		filter, err := newTargetFilter(strings.TrimSpace(record[networkColumn]), config.PolicyBlockAccess)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if policyColumn >= 0 && strings.TrimSpace(record[policyColumn]) != "" {
+			filter.Policy, err = normalizePolicy(config.Policy(strings.TrimSpace(record[policyColumn])))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if i == networkColumn || i == policyColumn || value == "" || header[i] == "" {
+				continue
+			}
+			filter.Tags = append(filter.Tags, config.Tag{Name: header[i], Values: strings.Split(value, "|")})
+		}
+		result = append(result, filter)
+	}
+	return result, nil
+}
+
+// stixObjects is a STIX 2.1 bundle or a TAXII 2.1 envelope. Both contain the STIX objects in the `objects` field.
+type stixObjects struct {
+	Objects []stixIndicator `json:"objects"`
+}
+
+// stixIndicator contains the fields of a STIX 2.1 indicator which are relevant for the filter list.
+type stixIndicator struct {
+	Type           string     `json:"type"`
+	Pattern        string     `json:"pattern"`
+	PatternType    string     `json:"pattern_type"`
+	ValidUntil     *time.Time `json:"valid_until,omitempty"`
+	Revoked        bool       `json:"revoked,omitempty"`
+	IndicatorTypes []string   `json:"indicator_types,omitempty"`
+	Labels         []string   `json:"labels,omitempty"`
+}
+
+var (
+	// stixComparisonRegexp matches a single comparison expression of a STIX pattern.
+	stixComparisonRegexp = regexp.MustCompile(`\[\s*([^\]]*?)\s*\]`)
+	// stixValueRegexp matches an equality comparison of an IPv4 address, IPv6 address or domain name object.
+	stixValueRegexp = regexp.MustCompile(`^(ipv4-addr|ipv6-addr|domain-name):value\s*=\s*'([^']+)'$`)
+)
+
+// parseSTIXFilterList parses the indicators of a STIX 2.1 bundle or TAXII 2.1 envelope.
+// Only indicators with STIX patterns consisting of IPv4 address, IPv6 address or domain name equality comparisons
+// combined with `OR` are used, revoked or expired indicators are skipped. The indicator types and labels are converted to tags.
+// All entries are blocked.
+func parseSTIXFilterList(data []byte) ([]config.Filter, error) {
+	var objects stixObjects
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("failed to parse STIX objects: %w", err)
+	}
+
+	now := time.Now()
+	var result []config.Filter
+	for _, indicator := range objects.Objects {
+		if indicator.Type != "indicator" || (indicator.PatternType != "" && indicator.PatternType != "stix") ||
+			indicator.Revoked || (indicator.ValidUntil != nil && indicator.ValidUntil.Before(now)) {
+			continue
+		}
+		filters, ok := parseSTIXPattern(indicator.Pattern)
+		if !ok {
+			continue
+		}
+		var tags []config.Tag
+		if len(indicator.IndicatorTypes) > 0 {
+			tags = append(tags, config.Tag{Name: "indicator_types", Values: indicator.IndicatorTypes})
+		}
+		if len(indicator.Labels) > 0 {
+			tags = append(tags, config.Tag{Name: "labels", Values: indicator.Labels})
+		}
+		for _, filter := range filters {
+			filter.Tags = slices.Clone(tags)
+			result = append(result, filter)
+		}
+	}
+	return result, nil
+}
+
+// parseSTIXPattern returns the blocking filters of a STIX pattern if it only consists of IPv4 address, IPv6 address
+// or domain name equality comparisons combined with `OR`.
+func parseSTIXPattern(pattern string) ([]config.Filter, bool) {
+	matches := stixComparisonRegexp.FindAllStringSubmatchIndex(pattern, -1)
+	if len(matches) == 0 {
+		return nil, false
+	}
+	var filters []config.Filter
+	last := 0
+	for _, match := range matches {
+		if operator := strings.TrimSpace(pattern[last:match[0]]); operator != "" && operator != "OR" {
+			return nil, false
+		}
+		last = match[1]
+		for comparison := range strings.SplitSeq(pattern[match[2]:match[3]], " OR ") {
+			submatch := stixValueRegexp.FindStringSubmatch(strings.TrimSpace(comparison))
+			if submatch == nil {
+				return nil, false
+			}
+			if submatch[1] == "domain-name" {
+				if !isFQDN(submatch[2]) {
+					return nil, false
+				}
+				filters = append(filters, config.Filter{FQDN: normalizeFQDN(submatch[2]), Policy: config.PolicyBlockAccess})
+				continue
+			}
+			network, err := normalizeNetwork(submatch[2])
+			if err != nil {
+				return nil, false
+			}
+			filters = append(filters, config.Filter{Network: network, Policy: config.PolicyBlockAccess})
+		}
+	}
+	if strings.TrimSpace(pattern[last:]) != "" {
+		return nil, false
+	}
+	return filters, true
+}
+
+// newTargetFilter returns a filter for the target, which is either a network, an IP address or an FQDN.
+func newTargetFilter(target string, policy config.Policy) (config.Filter, error) {
+	if isFQDN(target) {
+		return config.Filter{FQDN: normalizeFQDN(target), Policy: policy}, nil
+	}
+	network, err := normalizeNetwork(target)
+	if err != nil {
+		return config.Filter{}, err
+	}
+	return config.Filter{Network: network, Policy: policy}, nil
+}
+
+// normalizeNetwork returns the given CIDR or IP address as CIDR.
+func normalizeNetwork(network string) (string, error) {
+	if strings.Contains(network, "/") {
+		prefix, err := netip.ParsePrefix(network)
+		if err != nil {
+			return "", fmt.Errorf("invalid network %q: %w", network, err)
+		}
+		return prefix.String(), nil
+	}
+	addr, err := netip.ParseAddr(network)
+	if err != nil {
+		return "", fmt.Errorf("invalid network %q: %w", network, err)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()).String(), nil
+}
+
+// normalizePolicy returns the given policy in v1 notation.
+func normalizePolicy(policy config.Policy) (config.Policy, error) {
+	switch policy {
+	case config.PolicyBlockAccess, config.PolicyAllowAccess:
+		return policy, nil
+	default:
+		return convertPolicyV2ToV1(policy)
+	}
+}
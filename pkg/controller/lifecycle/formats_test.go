@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Filter list formats", func() {
+	DescribeTable("#parseFilterListWithFormat", func(format config.FilterListFormat, data string, expected []config.Filter) {
+		filterList, err := parseFilterListWithFormat([]byte(data), format)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filterList).To(Equal(expected))
+	},
+		Entry("json is the default format", config.FilterListFormat(""),
+			`[{"network":"1.2.3.4/32","policy":"BLOCK_ACCESS"}]`,
+			[]config.Filter{{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess}},
+		),
+		Entry("json v2", config.FilterListFormatJSON,
+			`[{"entries":[{"target":"1.2.3.4/32","policy":"ALLOW"}]}]`,
+			[]config.Filter{{Network: "1.2.3.4/32", Policy: config.PolicyAllowAccess}},
+		),
+		Entry("json with fqdns", config.FilterListFormatJSON,
+			`[{"fqdn":"pool.example.com","policy":"BLOCK_ACCESS"},{"network":"1.2.3.4/32","policy":"BLOCK_ACCESS"}]`,
+			[]config.Filter{
+				{FQDN: "pool.example.com", Policy: config.PolicyBlockAccess},
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+			},
+		),
+		Entry("json with port- and protocol-scoped entries", config.FilterListFormatJSON,
+			`[{"network":"0.0.0.0/0","policy":"BLOCK_ACCESS","protocol":"TCP","ports":[{"port":25},{"port":8000,"endPort":8080}]}]`,
+			[]config.Filter{{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 25}, {Port: 8000, EndPort: new(int32(8080))}}}},
+		),
+		Entry("json v2 with port- and protocol-scoped entries", config.FilterListFormatJSON,
+			`[{"entries":[{"target":"0.0.0.0/0","policy":"BLOCK","protocol":"UDP","ports":[{"port":53}]}]}]`,
+			[]config.Filter{{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolUDP, Ports: []config.PortRange{{Port: 53}}}},
+		),
+		Entry("json v2 with fqdn target", config.FilterListFormatJSON,
+			`[{"entries":[{"target":"Paste.Example.com","policy":"BLOCK"},{"target":"10.0.0.1","policy":"ALLOW"}]}]`,
+			[]config.Filter{
+				{FQDN: "paste.example.com", Policy: config.PolicyBlockAccess},
+				{Network: "10.0.0.1", Policy: config.PolicyAllowAccess},
+			},
+		),
+		Entry("text with comments and additional fields", config.FilterListFormatText,
+			"; Spamhaus DROP List\n1.10.16.0/20 ; SBL256894\n\n# single address\n5.6.7.8\n2001:db8::/32\tcomment\n",
+			[]config.Filter{
+				{Network: "1.10.16.0/20", Policy: config.PolicyBlockAccess},
+				{Network: "5.6.7.8/32", Policy: config.PolicyBlockAccess},
+				{Network: "2001:db8::/32", Policy: config.PolicyBlockAccess},
+			},
+		),
+		Entry("text with fqdns", config.FilterListFormatText,
+			"pool.Mining.example.\n1.2.3.4\n",
+			[]config.Filter{
+				{FQDN: "pool.mining.example", Policy: config.PolicyBlockAccess},
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+			},
+		),
+		Entry("empty text", config.FilterListFormatText, "# nothing\n", nil),
+		Entry("csv with policy and tag columns", config.FilterListFormatCSV,
+			"# comment\nnetwork,policy,threat,severity\n1.2.3.4/32,BLOCK,malware|botnet,high\n5.6.7.8,ALLOW_ACCESS,,\n::1/128,,,low\n",
+			[]config.Filter{
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+					{Name: "threat", Values: []string{"malware", "botnet"}},
+					{Name: "severity", Values: []string{"high"}},
+				}},
+				{Network: "5.6.7.8/32", Policy: config.PolicyAllowAccess},
+				{Network: "::1/128", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+					{Name: "severity", Values: []string{"low"}},
+				}},
+			},
+		),
+		Entry("csv with target column only", config.FilterListFormatCSV,
+			"target\n10.0.0.0/8\npaste.example.com\n",
+			[]config.Filter{
+				{Network: "10.0.0.0/8", Policy: config.PolicyBlockAccess},
+				{FQDN: "paste.example.com", Policy: config.PolicyBlockAccess},
+			},
+		),
+		Entry("stix bundle", config.FilterListFormatSTIX, `{
+  "type": "bundle",
+  "id": "bundle--1",
+  "objects": [
+    {"type": "identity", "id": "identity--1", "name": "feed"},
+    {"type": "indicator", "id": "indicator--1", "pattern_type": "stix", "indicator_types": ["malicious-activity"], "labels": ["c2"],
+     "pattern": "[ipv4-addr:value = '198.51.100.1'] OR [ipv4-addr:value = '203.0.113.0/24' OR ipv6-addr:value = '2001:db8::/64']"},
+    {"type": "indicator", "id": "indicator--2", "pattern_type": "stix", "revoked": true,
+     "pattern": "[ipv4-addr:value = '198.51.100.2']"},
+    {"type": "indicator", "id": "indicator--3", "pattern_type": "stix", "valid_until": "2000-01-01T00:00:00Z",
+     "pattern": "[ipv4-addr:value = '198.51.100.3']"},
+    {"type": "indicator", "id": "indicator--4", "pattern_type": "stix",
+     "pattern": "[domain-name:value = 'example.com']"},
+    {"type": "indicator", "id": "indicator--5", "pattern_type": "stix",
+     "pattern": "[ipv4-addr:value = '198.51.100.5'] AND [network-traffic:dst_port = 443]"},
+    {"type": "indicator", "id": "indicator--6", "pattern_type": "snort",
+     "pattern": "alert ip 198.51.100.6 any -> any any"},
+    {"type": "indicator", "id": "indicator--7", "pattern_type": "stix", "valid_until": "2999-01-01T00:00:00Z",
+     "pattern": "[ipv4-addr:value = '198.51.100.7']"}
+  ]
+}`,
+			[]config.Filter{
+				{Network: "198.51.100.1/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+					{Name: "indicator_types", Values: []string{"malicious-activity"}},
+					{Name: "labels", Values: []string{"c2"}},
+				}},
+				{Network: "203.0.113.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+					{Name: "indicator_types", Values: []string{"malicious-activity"}},
+					{Name: "labels", Values: []string{"c2"}},
+				}},
+				{Network: "2001:db8::/64", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+					{Name: "indicator_types", Values: []string{"malicious-activity"}},
+					{Name: "labels", Values: []string{"c2"}},
+				}},
+				{FQDN: "example.com", Policy: config.PolicyBlockAccess},
+				{Network: "198.51.100.7/32", Policy: config.PolicyBlockAccess},
+			},
+		),
+		Entry("taxii envelope", config.FilterListFormatSTIX,
+			`{"more": false, "objects": [{"type": "indicator", "pattern": "[ipv6-addr:value = '2001:db8::1']"}]}`,
+			[]config.Filter{{Network: "2001:db8::1/128", Policy: config.PolicyBlockAccess}},
+		),
+	)
+
+	DescribeTable("#parseFilterListWithFormat errors", func(format config.FilterListFormat, data string, expectedError string) {
+		_, err := parseFilterListWithFormat([]byte(data), format)
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("unsupported format", config.FilterListFormat("xml"), "<list/>", `unsupported filter list format "xml"`),
+		Entry("invalid network in text", config.FilterListFormatText, "1.2.3.4/32\nfoo\n", `line 2: invalid network "foo"`),
+		Entry("missing network column in csv", config.FilterListFormatCSV, "policy,tag\nBLOCK,foo\n", `missing column "network"`),
+		Entry("invalid policy in csv", config.FilterListFormatCSV, "network,policy\n1.2.3.4,DENY\n", "line 2: unknown policy value: DENY"),
+		Entry("invalid fqdn in text", config.FilterListFormatText, "foo_bar.example.com\n", `line 1: invalid network "foo_bar.example.com"`),
+		Entry("invalid stix json", config.FilterListFormatSTIX, "[", "failed to parse STIX objects"),
+	)
+})
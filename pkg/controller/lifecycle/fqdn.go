@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/fqdn"
+)
+
+const (
+	// fqdnRefreshInterval is the interval in which the FQDN cache checks for expired resolutions.
+	fqdnRefreshInterval = 15 * time.Second
+	// resolvConfPath is the path of the resolv.conf file providing the default nameservers.
+	resolvConfPath = "/etc/resolv.conf"
+)
+
+// newFQDNCache creates the cache for resolving FQDN filter entries with the given configuration.
+func newFQDNCache(fqdnResolution *config.FQDNResolution) (*fqdn.Cache, error) {
+	var (
+		resolver     *fqdn.DNSResolver
+		maxAddresses = fqdn.DefaultMaxAddressesPerName
+		minTTL       = fqdn.DefaultMinTTL
+		maxTTL       = fqdn.DefaultMaxTTL
+		minChange    = fqdn.DefaultMinChangeInterval
+		err          error
+	)
+	if fqdnResolution == nil {
+		fqdnResolution = &config.FQDNResolution{}
+	}
+	if len(fqdnResolution.Nameservers) > 0 {
+		resolver, err = fqdn.NewDNSResolver(fqdnResolution.Nameservers)
+	} else {
+		resolver, err = fqdn.NewDNSResolverFromResolvConf(resolvConfPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if fqdnResolution.MaxAddressesPerName != nil {
+		maxAddresses = int(*fqdnResolution.MaxAddressesPerName)
+	}
+	if fqdnResolution.MinTTL != nil {
+		minTTL = fqdnResolution.MinTTL.Duration
+	}
+	if fqdnResolution.MaxTTL != nil {
+		maxTTL = fqdnResolution.MaxTTL.Duration
+	}
+	if fqdnResolution.MinChangeInterval != nil {
+		if fqdnResolution.MinChangeInterval.Duration < 0 {
+			return nil, fmt.Errorf("minChangeInterval must not be negative")
+		}
+		minChange = fqdnResolution.MinChangeInterval.Duration
+	}
+	return fqdn.NewCache(resolver, clock.RealClock{}, maxAddresses, minTTL, maxTTL, minChange), nil
+}
+
+// resolveFQDNEntries replaces the FQDN entries of the filter list by network entries for each of their resolved
+// addresses. The network entries keep the policy, scope and tags of the FQDN entry. Entries of names which could not be
+// resolved (yet) are dropped.
+func resolveFQDNEntries(ctx context.Context, cache *fqdn.Cache, filterList []config.Filter, logger logr.Logger) []config.Filter {
+	names := filterFQDNs(filterList)
+	if len(names) == 0 {
+		return filterList
+	}
+	if cache == nil {
+		logger.Info("FQDN resolution not available, ignoring FQDN entries", "entries", len(names))
+		return filterNetworkEntries(filterList)
+	}
+
+	addrs := cache.Lookup(ctx, names)
+	for name, err := range cache.Errors(names) {
+		logger.Info("Failed to resolve FQDN of filter list, using last known addresses", "fqdn", name, "error", err.Error())
+	}
+
+	result := make([]config.Filter, 0, len(filterList))
+	var resolved, unresolved int
+	for _, filter := range filterList {
+		if filter.FQDN == "" {
+			result = append(result, filter)
+			continue
+		}
+		if len(addrs[filter.FQDN]) == 0 {
+			unresolved++
+			continue
+		}
+		resolved++
+		for _, addr := range addrs[filter.FQDN] {
+			result = append(result, config.Filter{
+				Network:  netip.PrefixFrom(addr, addr.BitLen()).String(),
+				Policy:   filter.Policy,
+				Protocol: filter.Protocol,
+				Ports:    filter.Ports,
+				Tags:     filter.Tags,
+			})
+		}
+	}
+	logger.Info("FQDN entries resolved", "resolved", resolved, "unresolved", unresolved)
+	return result
+}
+
+// filterFQDNs returns the FQDNs of the FQDN entries of the filter list.
+func filterFQDNs(filterList []config.Filter) []string {
+	var names []string
+	for _, filter := range filterList {
+		if filter.FQDN != "" {
+			names = append(names, filter.FQDN)
+		}
+	}
+	return names
+}
+
+// filterNetworkEntries returns the filter list without FQDN entries.
+func filterNetworkEntries(filterList []config.Filter) []config.Filter {
+	result := make([]config.Filter, 0, len(filterList))
+	for _, filter := range filterList {
+		if filter.FQDN == "" {
+			result = append(result, filter)
+		}
+	}
+	return result
+}
+
+// fqdnReferences keeps the FQDNs referenced by the filter lists and protected endpoints of the Extensions by namespace,
+// so that changed addresses are only rolled out to the Extensions referencing them.
+type fqdnReferences struct {
+	lock        sync.Mutex
+	byNamespace map[string]sets.Set[string]
+}
+
+func newFQDNReferences() *fqdnReferences {
+	return &fqdnReferences{byNamespace: map[string]sets.Set[string]{}}
+}
+
+// add records the FQDNs as referenced by the Extension in the given namespace.
+func (r *fqdnReferences) add(namespace string, names ...string) {
+	if len(names) == 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	referenced, ok := r.byNamespace[namespace]
+	if !ok {
+		referenced = sets.New[string]()
+		r.byNamespace[namespace] = referenced
+	}
+	for _, name := range names {
+		referenced.Insert(fqdn.NormalizeName(name))
+	}
+}
+
+// delete forgets the FQDNs referenced by the Extension in the given namespace. It is called before the filter lists of
+// the Extension are rendered again and when the Extension is deleted.
+func (r *fqdnReferences) delete(namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.byNamespace, namespace)
+}
+
+// namespaces returns the namespaces of the Extensions referencing any of the given normalized FQDNs.
+func (r *fqdnReferences) namespaces(names []string) sets.Set[string] {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	result := sets.New[string]()
+	for namespace, referenced := range r.byNamespace {
+		if referenced.HasAny(names...) {
+			result.Insert(namespace)
+		}
+	}
+	return result
+}
+
+// runFQDNRefresh refreshes the FQDN resolutions until the context is cancelled and rolls out changed addresses to the
+// Extensions referencing the FQDNs.
+func (a *actuator) runFQDNRefresh(ctx context.Context, rollout *filterListRollout) error {
+	a.fqdnCache.Run(ctx, fqdnRefreshInterval, func(_ context.Context, changed []string) {
+		rollout.notifyFQDNChanged(a.fqdnReferences.namespaces(changed))
+	})
+	return nil
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/fqdn"
+)
+
+var _ = Describe("FQDN", func() {
+	var (
+		ctx        = context.Background()
+		tags       = []config.Tag{{Name: "threat", Values: []string{"mining"}}}
+		filterList []config.Filter
+		cache      *fqdn.Cache
+	)
+
+	BeforeEach(func() {
+		filterList = []config.Filter{
+			{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+			{FQDN: "pool.example.com", Policy: config.PolicyBlockAccess, Tags: tags},
+			{FQDN: "unknown.example.com", Policy: config.PolicyBlockAccess},
+			{FQDN: "cdn.example.com", Policy: config.PolicyAllowAccess},
+		}
+		resolver := fqdn.NewStaticResolver(map[string][]netip.Addr{
+			"pool.example.com": {netip.MustParseAddr("198.51.100.2"), netip.MustParseAddr("2001:db8::1"), netip.MustParseAddr("198.51.100.1")},
+			"cdn.example.com":  {netip.MustParseAddr("::ffff:198.51.100.1")},
+		}, time.Minute)
+		cache = fqdn.NewCache(resolver, clock.RealClock{}, 16, time.Minute, time.Hour, 0)
+	})
+
+	Describe("#resolveFQDNEntries", func() {
+		It("should replace FQDN entries by entries for their addresses", func() {
+			Expect(resolveFQDNEntries(ctx, cache, filterList, logr.Discard())).To(Equal([]config.Filter{
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+				{Network: "198.51.100.1/32", Policy: config.PolicyBlockAccess, Tags: tags},
+				{Network: "198.51.100.2/32", Policy: config.PolicyBlockAccess, Tags: tags},
+				{Network: "2001:db8::1/128", Policy: config.PolicyBlockAccess, Tags: tags},
+				{Network: "198.51.100.1/32", Policy: config.PolicyAllowAccess},
+			}))
+		})
+
+		It("should drop FQDN entries without cache", func() {
+			Expect(resolveFQDNEntries(ctx, nil, filterList, logr.Discard())).To(Equal([]config.Filter{
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+			}))
+		})
+
+		It("should generate the filter values of the resolved entries", func() {
+			ipv4List, ipv6List, err := generateEgressFilterValues(resolveFQDNEntries(ctx, cache, filterList, logr.Discard()), logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ipv4List).To(Equal([]string{"1.2.3.4/32", "198.51.100.2/32"}))
+			Expect(ipv6List).To(Equal([]string{"2001:db8::1/128"}))
+		})
+	})
+
+	Describe("#newFQDNCache", func() {
+		It("should use the configured nameservers", func() {
+			cache, err := newFQDNCache(&config.FQDNResolution{Nameservers: []string{"10.0.0.10:53"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cache).NotTo(BeNil())
+		})
+
+		It("should fail for invalid nameservers", func() {
+			_, err := newFQDNCache(&config.FQDNResolution{Nameservers: []string{"10.0.0.10"}})
+			Expect(err).To(MatchError(ContainSubstring("invalid nameserver")))
+		})
+
+		It("should fail for a negative minimum change interval", func() {
+			_, err := newFQDNCache(&config.FQDNResolution{Nameservers: []string{"10.0.0.10:53"}, MinChangeInterval: &metav1.Duration{Duration: -time.Second}})
+			Expect(err).To(MatchError(ContainSubstring("minChangeInterval must not be negative")))
+		})
+	})
+
+	Describe("#fqdnReferences", func() {
+		var references *fqdnReferences
+
+		BeforeEach(func() {
+			references = newFQDNReferences()
+			references.add("shoot--foo--bar", filterFQDNs(filterList)...)
+			references.add("shoot--foo--baz", "Pool.Example.com.")
+			references.add("shoot--foo--qux")
+		})
+
+		It("should return the namespaces referencing the names", func() {
+			Expect(references.namespaces([]string{"pool.example.com"})).To(Equal(sets.New("shoot--foo--bar", "shoot--foo--baz")))
+			Expect(references.namespaces([]string{"cdn.example.com", "other.example.com"})).To(Equal(sets.New("shoot--foo--bar")))
+			Expect(references.namespaces([]string{"other.example.com"})).To(BeEmpty())
+		})
+
+		It("should forget the names of deleted namespaces", func() {
+			references.delete("shoot--foo--bar")
+			Expect(references.namespaces([]string{"pool.example.com", "cdn.example.com"})).To(Equal(sets.New("shoot--foo--baz")))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// lockedFilterEntries returns the blocked entries locked by the extension configuration, i.e. its locked networks and
+// the unscoped blocked entries of its filter list matching any of the locked tags.
+func lockedFilterEntries(lockedEntries *config.LockedEntries, filterList []config.Filter) []config.Filter {
+	if lockedEntries == nil {
+		return nil
+	}
+
+	var result []config.Filter
+	for _, network := range lockedEntries.Networks {
+		result = append(result, config.Filter{Network: network, Policy: config.PolicyBlockAccess})
+	}
+	for _, filter := range filterList {
+		if filter.Policy != config.PolicyBlockAccess || filter.Protocol != "" {
+			continue
+		}
+		if slices.ContainsFunc(lockedEntries.Tags, func(requirement config.TagRequirement) bool {
+			return tagRequirementMatches(filter, requirement)
+		}) {
+			result = append(result, filter)
+		}
+	}
+	return result
+}
+
+// lockNetworks adds the networks of the locked entries to the enforced IPv4/IPv6 lists, so that neither allowed
+// networks of the shoot nor its tag filters, merge strategy or enforcement mode can unblock them. The locked entries
+// are expected to be resolved, locked networks overlapping private or reserved ranges are ignored like other blocked
+// networks. If status is not nil, the number of locked entries is recorded in it.
+func lockNetworks(secretData map[string][]byte, lockedEntries []config.Filter, logger logr.Logger, status *config.EgressFilterStatus) map[string][]byte {
+	if len(lockedEntries) == 0 {
+		return secretData
+	}
+
+	var locked cidrset.Builder
+	for _, entry := range lockedEntries {
+		if entry.FQDN != "" {
+			continue
+		}
+		prefix, err := parsePrefix(entry.Network)
+		if err != nil {
+			logger.Error(err, "Error parsing locked CIDR, ignoring it", "offending CIDR", entry.Network)
+			continue
+		}
+		if privateNet, ok := overlappingPrivateRange(prefix); ok {
+			logger.Info("Identified overlapping locked CIDR, ignoring it", "offending CIDR", prefix.String(), "reserved range", privateNet.String())
+			continue
+		}
+		locked.AddPrefix(prefix)
+	}
+	if status != nil {
+		status.LockedEntries = len(lockedEntries)
+	}
+
+	var blocked cidrset.Builder
+	for _, key := range []string{constants.KeyIPV4List, constants.KeyIPV6List} {
+		for _, prefix := range prefixListFromPlainYamlList(string(secretData[key])) {
+			blocked.AddPrefix(prefix)
+		}
+	}
+	lockedSet := locked.Set()
+	var unblocked cidrset.Builder
+	unblocked.AddSet(lockedSet)
+	unblocked.RemoveSet(blocked.Set())
+	if count := len(unblocked.Set().Prefixes()); count > 0 {
+		logger.Info("Blocking locked networks unblocked by the filter lists", "networks", count)
+	}
+
+	blocked.AddSet(lockedSet)
+	ipv4List, ipv6List := prefixListToStringLists(blocked.Set().Prefixes())
+	result := maps.Clone(secretData)
+	result[constants.KeyIPV4List] = []byte(convertToPlainYamlList(ipv4List))
+	result[constants.KeyIPV6List] = []byte(convertToPlainYamlList(ipv6List))
+	return result
+}
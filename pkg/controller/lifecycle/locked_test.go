@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Locked entries", func() {
+	var (
+		malware = config.Filter{Network: "203.0.113.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "category", Values: []string{"malware"}}}}
+		adware  = config.Filter{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "category", Values: []string{"adware"}}}}
+		scoped  = config.Filter{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Tags: []config.Tag{{Name: "category", Values: []string{"malware"}}}}
+		allowed = config.Filter{Network: "192.0.2.0/24", Policy: config.PolicyAllowAccess, Tags: []config.Tag{{Name: "category", Values: []string{"malware"}}}}
+	)
+
+	DescribeTable("#lockedFilterEntries", func(lockedEntries *config.LockedEntries, expected []config.Filter) {
+		Expect(lockedFilterEntries(lockedEntries, []config.Filter{malware, adware, scoped, allowed})).To(Equal(expected))
+	},
+		Entry("no locked entries", nil, nil),
+		Entry("locked networks", &config.LockedEntries{Networks: []string{"192.0.2.0/25"}},
+			[]config.Filter{{Network: "192.0.2.0/25", Policy: config.PolicyBlockAccess}}),
+		Entry("locked tags", &config.LockedEntries{Tags: []config.TagRequirement{{Name: "category", Values: []string{"malware"}}}},
+			[]config.Filter{malware}),
+		Entry("locked networks and tags", &config.LockedEntries{
+			Networks: []string{"192.0.2.0/25"},
+			Tags:     []config.TagRequirement{{Name: "category", Operator: config.TagOperatorNotIn, Values: []string{"malware"}}},
+		}, []config.Filter{{Network: "192.0.2.0/25", Policy: config.PolicyBlockAccess}, adware}),
+	)
+
+	Describe("#lockNetworks", func() {
+		It("should not change the filter lists without locked entries", func() {
+			secretData := map[string][]byte{constants.KeyIPV4List: []byte("[]"), constants.KeyIPV6List: []byte("[]")}
+			Expect(lockNetworks(secretData, nil, logr.Discard(), &config.EgressFilterStatus{})).To(Equal(secretData))
+		})
+
+		It("should add the locked networks to the enforced filter lists", func() {
+			status := &config.EgressFilterStatus{}
+			secretData := map[string][]byte{
+				constants.KeyIPV4List:      []byte(convertToPlainYamlList([]string{"203.0.113.0/25"})),
+				constants.KeyIPV6List:      []byte("[]"),
+				constants.KeyIPV4AuditList: []byte(convertToPlainYamlList([]string{"198.51.100.0/24"})),
+			}
+			result := lockNetworks(secretData, []config.Filter{
+				{Network: "203.0.113.0/24", Policy: config.PolicyBlockAccess},
+				{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess},
+				{Network: "2001:db8::/32", Policy: config.PolicyBlockAccess},
+				{Network: "10.0.0.0/8", Policy: config.PolicyBlockAccess},
+				{FQDN: "malware.example.com", Policy: config.PolicyBlockAccess},
+			}, logr.Discard(), status)
+
+			Expect(plainYamlListEntries(result[constants.KeyIPV4List])).To(Equal([]string{"198.51.100.0/24", "203.0.113.0/24"}))
+			Expect(plainYamlListEntries(result[constants.KeyIPV6List])).To(Equal([]string{"2001:db8::/32"}))
+			Expect(result[constants.KeyIPV4AuditList]).To(Equal(secretData[constants.KeyIPV4AuditList]))
+			Expect(status.LockedEntries).To(Equal(5))
+			Expect(plainYamlListEntries(secretData[constants.KeyIPV4List])).To(Equal([]string{"203.0.113.0/25"}))
+		})
+
+		It("should not unblock locked networks with port- or protocol-scoped allowed networks", func() {
+			filterList := []config.Filter{
+				{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP},
+				{Network: "203.0.113.0/28", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP, Ports: []config.PortRange{{Port: 443}}},
+				{Network: "198.51.100.0/28", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP},
+			}
+			ipv4List, ipv6List, err := generateEgressFilterValues(filterList, logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			secretData := map[string][]byte{
+				constants.KeyIPV4List: []byte(convertToPlainYamlList(ipv4List)),
+				constants.KeyIPV6List: []byte(convertToPlainYamlList(ipv6List)),
+				constants.KeyPortList: []byte(convertToPlainYamlList(generatePortFilterList(filterList, logr.Discard()))),
+			}
+			lockedEntries := lockedFilterEntries(&config.LockedEntries{Networks: []string{"203.0.113.0/24", "198.51.100.0/24"}}, nil)
+
+			result := lockNetworks(secretData, lockedEntries, logr.Discard(), nil)
+			Expect(plainYamlListEntries(result[constants.KeyIPV4List])).To(Equal([]string{"198.51.100.0/24", "203.0.113.0/24"}))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"slices"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// mergeStrategiesByPermissiveness are the merge strategies ordered from the least to the most permissive one.
+var mergeStrategiesByPermissiveness = []config.MergeStrategy{
+	config.MergeStrategyAppendBlockOnly,
+	config.MergeStrategyMerge,
+	config.MergeStrategyReplace,
+}
+
+// sourceFilterList contains the entries read from a project or shoot filter list source.
+type sourceFilterList struct {
+	source   config.FilterListSource
+	strategy config.MergeStrategy
+	entries  []config.Filter
+}
+
+// mergeStrategyLimit returns the most permissive merge strategy of the filter list source allowed by the extension
+// configuration.
+func mergeStrategyLimit(serviceConfig *config.EgressFilter, source config.FilterListSource) config.MergeStrategy {
+	var limit config.MergeStrategy
+	if serviceConfig != nil && serviceConfig.MergeStrategyLimits != nil {
+		switch source {
+		case config.FilterListSourceProject:
+			limit = serviceConfig.MergeStrategyLimits.Project
+		case config.FilterListSourceShoot:
+			limit = serviceConfig.MergeStrategyLimits.Shoot
+		}
+	}
+	if limit == "" {
+		return config.MergeStrategyReplace
+	}
+	return limit
+}
+
+// restrictMergeStrategy returns the merge strategy of a filter list source, i.e. the configured one, defaulting to
+// `replace`, unless it is more permissive than the limit, in which case the limit is returned.
+func restrictMergeStrategy(strategy, limit config.MergeStrategy) config.MergeStrategy {
+	if strategy == "" {
+		strategy = config.MergeStrategyReplace
+	}
+	if slices.Index(mergeStrategiesByPermissiveness, strategy) > slices.Index(mergeStrategiesByPermissiveness, limit) {
+		return limit
+	}
+	return strategy
+}
+
+// restrictStaticFilterList restricts the static filter list of the shoot configuration to the merge strategy limit of
+// shoot filter list sources, as it is provided by the shoot owner as well. The static entries are always added to the
+// ones of the sources like with merge strategy `merge`, so only the limit `appendBlockOnly` removes entries.
+func restrictStaticFilterList(serviceConfig *config.EgressFilter, staticFilterList []config.Filter, logger logr.Logger) []config.Filter {
+	strategy := restrictMergeStrategy(config.MergeStrategyMerge, mergeStrategyLimit(serviceConfig, config.FilterListSourceShoot))
+	return mergeFilterLists(nil, sourceFilterList{source: config.FilterListSourceStatic, strategy: strategy, entries: staticFilterList}, logger)
+}
+
+// restrictShootTagFilters restricts the tag filters of the shoot configuration to the merge strategy limit of shoot
+// filter list sources, as they are provided by the shoot owner as well. It returns the tag filters applied to the
+// entries of all sources and the ones additionally applied to the entries of the shoot filter list source only. With the
+// limit `appendBlockOnly`, the tag filters of the shoot must neither drop, override nor audit the entries of the other
+// sources, so they are only applied to the entries of the shoot filter list source, whose entries not blocking access
+// are dropped afterwards.
+func restrictShootTagFilters(serviceConfig *config.EgressFilter, tagFilters, shootTagFilters []config.TagFilter) (all, shootSourceOnly []config.TagFilter) {
+	if mergeStrategyLimit(serviceConfig, config.FilterListSourceShoot) == config.MergeStrategyAppendBlockOnly {
+		return tagFilters, shootTagFilters
+	}
+	return slices.Concat(tagFilters, shootTagFilters), nil
+}
+
+// replacesLowerPriorities returns true if any of the filter list sources replaces the ones of lower priority.
+func replacesLowerPriorities(sources []sourceFilterList) bool {
+	return slices.ContainsFunc(sources, func(source sourceFilterList) bool {
+		return source.strategy == config.MergeStrategyReplace
+	})
+}
+
+// combineFilterLists merges the entries of the filter list sources, ordered from the highest to the lowest priority,
+// into the downloaded filter list. The downloaded filter list is only retrieved if no source replaces it.
+func combineFilterLists(sources []sourceFilterList, downloaded func() []config.Filter, logger logr.Logger) []config.Filter {
+	var result []config.Filter
+	if !replacesLowerPriorities(sources) {
+		result = downloaded()
+	}
+	for _, source := range slices.Backward(sources) {
+		result = mergeFilterLists(result, source, logger)
+	}
+	return result
+}
+
+// mergeFilterLists combines the entries of a filter list source with the filter list of lower priority according
+// to the merge strategy of the source. The entries of the source are appended, so that they take precedence over the
+// ones of lower priority for the same network with policy evaluation `longestPrefixMatch`.
+func mergeFilterLists(lower []config.Filter, source sourceFilterList, logger logr.Logger) []config.Filter {
+	switch source.strategy {
+	case config.MergeStrategyReplace:
+		return source.entries
+	case config.MergeStrategyAppendBlockOnly:
+		blocked := slices.DeleteFunc(slices.Clone(source.entries), func(filter config.Filter) bool {
+			return filter.Policy != config.PolicyBlockAccess
+		})
+		if ignored := len(source.entries) - len(blocked); ignored > 0 {
+			logger.Info("Ignoring entries not blocking access with merge strategy appendBlockOnly", "source", source.source, "entries", ignored)
+		}
+		return slices.Concat(lower, blocked)
+	default:
+		return slices.Concat(lower, source.entries)
+	}
+}
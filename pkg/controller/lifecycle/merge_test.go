@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"slices"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Merge strategies", func() {
+	var (
+		downloadedBlock = config.Filter{Network: "192.0.2.0/24", Policy: config.PolicyBlockAccess}
+		downloadedAllow = config.Filter{Network: "192.0.2.0/28", Policy: config.PolicyAllowAccess}
+		projectBlock    = config.Filter{Network: "198.51.100.0/24", Policy: config.PolicyBlockAccess}
+		projectAllow    = config.Filter{Network: "192.0.2.16/28", Policy: config.PolicyAllowAccess}
+		shootBlock      = config.Filter{Network: "203.0.113.0/24", Policy: config.PolicyBlockAccess}
+		shootAllow      = config.Filter{Network: "192.0.2.32/28", Policy: config.PolicyAllowAccess}
+
+		downloaded = []config.Filter{downloadedBlock, downloadedAllow}
+
+		project = func(strategy config.MergeStrategy) sourceFilterList {
+			return sourceFilterList{source: config.FilterListSourceProject, strategy: strategy, entries: []config.Filter{projectBlock, projectAllow}}
+		}
+		shoot = func(strategy config.MergeStrategy) sourceFilterList {
+			return sourceFilterList{source: config.FilterListSourceShoot, strategy: strategy, entries: []config.Filter{shootBlock, shootAllow}}
+		}
+
+		operatorTagFilter = config.TagFilter{Name: "threat", Values: []string{"mining"}}
+		shootTagFilter    = config.TagFilter{Name: "threat", Values: []string{"paste"}, Action: config.TagFilterActionExclude}
+		excludeDownloaded = config.TagFilter{Name: "origin", Values: []string{"downloaded"}, Action: config.TagFilterActionExclude}
+		includeShoot      = config.TagFilter{Name: "origin", Values: []string{"shoot"}, Action: config.TagFilterActionInclude}
+		allowAll          = config.TagFilter{Name: "origin", Values: []string{"downloaded", "shoot"}, Policy: new(config.PolicyAllowAccess)}
+
+		// tagged returns the entries with the origin tag, or without tags if the origin is empty.
+		tagged = func(entries []config.Filter, origin string) []config.Filter {
+			result := slices.Clone(entries)
+			for i := range result {
+				result[i].Tags = nil
+				if origin != "" {
+					result[i].Tags = []config.Tag{{Name: "origin", Values: []string{origin}}}
+				}
+			}
+			return result
+		}
+		allowed = func(filter config.Filter) config.Filter {
+			filter.Policy = config.PolicyAllowAccess
+			return filter
+		}
+	)
+
+	DescribeTable("#mergeStrategyLimit", func(serviceConfig *config.EgressFilter, source config.FilterListSource, expected config.MergeStrategy) {
+		Expect(mergeStrategyLimit(serviceConfig, source)).To(Equal(expected))
+	},
+		Entry("without extension configuration", nil, config.FilterListSourceShoot, config.MergeStrategyReplace),
+		Entry("without limits", &config.EgressFilter{}, config.FilterListSourceProject, config.MergeStrategyReplace),
+		Entry("limit of the project", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Project: config.MergeStrategyMerge, Shoot: config.MergeStrategyAppendBlockOnly}}, config.FilterListSourceProject, config.MergeStrategyMerge),
+		Entry("limit of the shoot", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Project: config.MergeStrategyMerge, Shoot: config.MergeStrategyAppendBlockOnly}}, config.FilterListSourceShoot, config.MergeStrategyAppendBlockOnly),
+		Entry("without limit of the source", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Project: config.MergeStrategyMerge}}, config.FilterListSourceShoot, config.MergeStrategyReplace),
+	)
+
+	DescribeTable("#restrictMergeStrategy", func(strategy, limit, expected config.MergeStrategy) {
+		Expect(restrictMergeStrategy(strategy, limit)).To(Equal(expected))
+	},
+		Entry("default with limit replace", config.MergeStrategy(""), config.MergeStrategyReplace, config.MergeStrategyReplace),
+		Entry("default with limit merge", config.MergeStrategy(""), config.MergeStrategyMerge, config.MergeStrategyMerge),
+		Entry("default with limit appendBlockOnly", config.MergeStrategy(""), config.MergeStrategyAppendBlockOnly, config.MergeStrategyAppendBlockOnly),
+		Entry("replace with limit replace", config.MergeStrategyReplace, config.MergeStrategyReplace, config.MergeStrategyReplace),
+		Entry("replace with limit merge", config.MergeStrategyReplace, config.MergeStrategyMerge, config.MergeStrategyMerge),
+		Entry("replace with limit appendBlockOnly", config.MergeStrategyReplace, config.MergeStrategyAppendBlockOnly, config.MergeStrategyAppendBlockOnly),
+		Entry("merge with limit replace", config.MergeStrategyMerge, config.MergeStrategyReplace, config.MergeStrategyMerge),
+		Entry("merge with limit merge", config.MergeStrategyMerge, config.MergeStrategyMerge, config.MergeStrategyMerge),
+		Entry("merge with limit appendBlockOnly", config.MergeStrategyMerge, config.MergeStrategyAppendBlockOnly, config.MergeStrategyAppendBlockOnly),
+		Entry("appendBlockOnly with limit replace", config.MergeStrategyAppendBlockOnly, config.MergeStrategyReplace, config.MergeStrategyAppendBlockOnly),
+		Entry("appendBlockOnly with limit merge", config.MergeStrategyAppendBlockOnly, config.MergeStrategyMerge, config.MergeStrategyAppendBlockOnly),
+		Entry("appendBlockOnly with limit appendBlockOnly", config.MergeStrategyAppendBlockOnly, config.MergeStrategyAppendBlockOnly, config.MergeStrategyAppendBlockOnly),
+	)
+
+	DescribeTable("#combineFilterLists", func(sources []sourceFilterList, expected []config.Filter, expectDownload bool) {
+		downloadRetrieved := false
+		Expect(combineFilterLists(sources, func() []config.Filter {
+			downloadRetrieved = true
+			return downloaded
+		}, logr.Discard())).To(Equal(expected))
+		Expect(downloadRetrieved).To(Equal(expectDownload))
+	},
+		Entry("no sources", nil,
+			[]config.Filter{downloadedBlock, downloadedAllow}, true),
+		Entry("project with replace", []sourceFilterList{project(config.MergeStrategyReplace)},
+			[]config.Filter{projectBlock, projectAllow}, false),
+		Entry("project with merge", []sourceFilterList{project(config.MergeStrategyMerge)},
+			[]config.Filter{downloadedBlock, downloadedAllow, projectBlock, projectAllow}, true),
+		Entry("project with appendBlockOnly", []sourceFilterList{project(config.MergeStrategyAppendBlockOnly)},
+			[]config.Filter{downloadedBlock, downloadedAllow, projectBlock}, true),
+		Entry("shoot with replace", []sourceFilterList{shoot(config.MergeStrategyReplace)},
+			[]config.Filter{shootBlock, shootAllow}, false),
+		Entry("shoot with merge", []sourceFilterList{shoot(config.MergeStrategyMerge)},
+			[]config.Filter{downloadedBlock, downloadedAllow, shootBlock, shootAllow}, true),
+		Entry("shoot with appendBlockOnly", []sourceFilterList{shoot(config.MergeStrategyAppendBlockOnly)},
+			[]config.Filter{downloadedBlock, downloadedAllow, shootBlock}, true),
+		Entry("shoot with merge over project with replace", []sourceFilterList{shoot(config.MergeStrategyMerge), project(config.MergeStrategyReplace)},
+			[]config.Filter{projectBlock, projectAllow, shootBlock, shootAllow}, false),
+		Entry("shoot with appendBlockOnly over project with merge", []sourceFilterList{shoot(config.MergeStrategyAppendBlockOnly), project(config.MergeStrategyMerge)},
+			[]config.Filter{downloadedBlock, downloadedAllow, projectBlock, projectAllow, shootBlock}, true),
+		Entry("shoot with merge over project with appendBlockOnly", []sourceFilterList{shoot(config.MergeStrategyMerge), project(config.MergeStrategyAppendBlockOnly)},
+			[]config.Filter{downloadedBlock, downloadedAllow, projectBlock, shootBlock, shootAllow}, true),
+	)
+
+	DescribeTable("#restrictStaticFilterList", func(serviceConfig *config.EgressFilter, expected []config.Filter) {
+		staticFilterList := []config.Filter{shootBlock, shootAllow}
+		Expect(restrictStaticFilterList(serviceConfig, staticFilterList, logr.Discard())).To(Equal(expected))
+		Expect(staticFilterList).To(Equal([]config.Filter{shootBlock, shootAllow}))
+	},
+		Entry("without limits", &config.EgressFilter{}, []config.Filter{shootBlock, shootAllow}),
+		Entry("shoot limit merge", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Shoot: config.MergeStrategyMerge}}, []config.Filter{shootBlock, shootAllow}),
+		Entry("shoot limit appendBlockOnly", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Shoot: config.MergeStrategyAppendBlockOnly}}, []config.Filter{shootBlock}),
+		Entry("project limit appendBlockOnly", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Project: config.MergeStrategyAppendBlockOnly}}, []config.Filter{shootBlock, shootAllow}),
+	)
+
+	DescribeTable("#restrictShootTagFilters", func(serviceConfig *config.EgressFilter, expectedAll, expectedShootSourceOnly []config.TagFilter) {
+		all, shootSourceOnly := restrictShootTagFilters(serviceConfig, []config.TagFilter{operatorTagFilter}, []config.TagFilter{shootTagFilter})
+		Expect(all).To(Equal(expectedAll))
+		Expect(shootSourceOnly).To(Equal(expectedShootSourceOnly))
+	},
+		Entry("without extension configuration", nil, []config.TagFilter{operatorTagFilter, shootTagFilter}, nil),
+		Entry("without limits", &config.EgressFilter{}, []config.TagFilter{operatorTagFilter, shootTagFilter}, nil),
+		Entry("shoot limit merge", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Shoot: config.MergeStrategyMerge}}, []config.TagFilter{operatorTagFilter, shootTagFilter}, nil),
+		Entry("shoot limit appendBlockOnly", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Shoot: config.MergeStrategyAppendBlockOnly}}, []config.TagFilter{operatorTagFilter}, []config.TagFilter{shootTagFilter}),
+		Entry("project limit appendBlockOnly", &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Project: config.MergeStrategyAppendBlockOnly}}, []config.TagFilter{operatorTagFilter, shootTagFilter}, nil),
+	)
+
+	DescribeTable("tag filters of the shoot with the limits of shoot filter list sources", func(limit config.MergeStrategy, shootTagFilters []config.TagFilter, expected []config.Filter) {
+		var (
+			serviceConfig                 = &config.EgressFilter{MergeStrategyLimits: &config.MergeStrategyLimits{Shoot: limit}}
+			all, shootSourceOnly          = restrictShootTagFilters(serviceConfig, nil, shootTagFilters)
+			source                        = shoot(restrictMergeStrategy(config.MergeStrategyMerge, mergeStrategyLimit(serviceConfig, config.FilterListSourceShoot)))
+			taggedDownloaded, taggedShoot = tagged(downloaded, "downloaded"), tagged(source.entries, "shoot")
+		)
+		// the tag filters are applied like by readAndRestrictFilterListSecretData
+		source.entries = filterByTags(taggedShoot, slices.Concat(all, shootSourceOnly), logr.Discard())
+		combined := combineFilterLists([]sourceFilterList{source}, func() []config.Filter {
+			return filterByTags(taggedDownloaded, all, logr.Discard())
+		}, logr.Discard())
+		Expect(tagged(combined, "")).To(Equal(expected))
+	},
+		Entry("exclude with limit merge", config.MergeStrategyMerge, []config.TagFilter{excludeDownloaded},
+			[]config.Filter{shootBlock, shootAllow}),
+		Entry("exclude with limit appendBlockOnly", config.MergeStrategyAppendBlockOnly, []config.TagFilter{excludeDownloaded},
+			[]config.Filter{downloadedBlock, downloadedAllow, shootBlock}),
+		Entry("include with limit merge", config.MergeStrategyMerge, []config.TagFilter{includeShoot},
+			[]config.Filter{shootBlock, shootAllow}),
+		Entry("include with limit appendBlockOnly", config.MergeStrategyAppendBlockOnly, []config.TagFilter{includeShoot},
+			[]config.Filter{downloadedBlock, downloadedAllow, shootBlock}),
+		Entry("override with limit merge", config.MergeStrategyMerge, []config.TagFilter{allowAll},
+			[]config.Filter{allowed(downloadedBlock), downloadedAllow, allowed(shootBlock), shootAllow}),
+		Entry("override with limit appendBlockOnly", config.MergeStrategyAppendBlockOnly, []config.TagFilter{allowAll},
+			[]config.Filter{downloadedBlock, downloadedAllow}),
+	)
+
+	It("should keep the entries of the source for merge strategy appendBlockOnly unchanged", func() {
+		source := shoot(config.MergeStrategyAppendBlockOnly)
+		Expect(mergeFilterLists(nil, source, logr.Discard())).To(Equal([]config.Filter{shootBlock}))
+		Expect(source.entries).To(Equal([]config.Filter{shootBlock, shootAllow}))
+	})
+})
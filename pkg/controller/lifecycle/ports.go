@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/validation"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+)
+
+// allPorts is rendered in the port list for protocol-scoped entries without ports.
+const allPorts = "*"
+
+// portInterval is an inclusive range of destination ports.
+type portInterval struct {
+	first, last int32
+}
+
+// portScope is the protocol and the destination ports of a port- or protocol-scoped filter entry.
+// The ports are sorted and merged, no ports means all ports of the protocol.
+type portScope struct {
+	protocol config.Protocol
+	ports    []portInterval
+}
+
+// newPortScope returns the scope of the filter entry.
+func newPortScope(filter config.Filter) portScope {
+	ports := make([]portInterval, 0, len(filter.Ports))
+	for _, portRange := range filter.Ports {
+		interval := portInterval{first: portRange.Port, last: portRange.Port}
+		if portRange.EndPort != nil {
+			interval.last = *portRange.EndPort
+		}
+		ports = append(ports, interval)
+	}
+	slices.SortFunc(ports, func(a, b portInterval) int { return cmp.Compare(a.first, b.first) })
+
+	var merged []portInterval
+	for _, interval := range ports {
+		if n := len(merged); n > 0 && interval.first <= merged[n-1].last+1 {
+			merged[n-1].last = max(merged[n-1].last, interval.last)
+			continue
+		}
+		merged = append(merged, interval)
+	}
+	return portScope{protocol: filter.Protocol, ports: merged}
+}
+
+// key returns the scope as rendered in the port list, e.g. `tcp 25,8000-8080` or `udp *`.
+func (s portScope) key() string {
+	if len(s.ports) == 0 {
+		return strings.ToLower(string(s.protocol)) + " " + allPorts
+	}
+	ports := make([]string, 0, len(s.ports))
+	for _, interval := range s.ports {
+		if interval.first == interval.last {
+			ports = append(ports, strconv.Itoa(int(interval.first)))
+		} else {
+			ports = append(ports, fmt.Sprintf("%d-%d", interval.first, interval.last))
+		}
+	}
+	return strings.ToLower(string(s.protocol)) + " " + strings.Join(ports, ",")
+}
+
+// covers returns true if all ports of the other scope are part of this scope.
+func (s portScope) covers(other portScope) bool {
+	if s.protocol != other.protocol {
+		return false
+	}
+	if len(s.ports) == 0 {
+		return true
+	}
+	if len(other.ports) == 0 {
+		return false
+	}
+	for _, interval := range other.ports {
+		if !slices.ContainsFunc(s.ports, func(i portInterval) bool { return i.first <= interval.first && interval.last <= i.last }) {
+			return false
+		}
+	}
+	return true
+}
+
+// scopedPrefixes are the networks of a port scope.
+type scopedPrefixes struct {
+	scope    portScope
+	prefixes cidrset.Builder
+}
+
+// generatePortFilterList generates the port- and protocol-scoped rules of the filter list. Each rule is rendered as
+// `<protocol> <ports> <network>`, e.g. `tcp 25,465 0.0.0.0/5`, where the ports are `*` for all ports of the protocol.
+// Blocked networks are reduced by allowed networks without scope or with a scope covering the blocked ports.
+// In contrast to entries without scope, private or reserved ranges are carved out of scoped entries instead of
+// dropping them, so that e.g. `0.0.0.0/0` can be blocked for a single port.
+func generatePortFilterList(entries []config.Filter, logger logr.Logger) []string {
+	var (
+		blocked         = map[string]*scopedPrefixes{}
+		allowed         = map[string]*scopedPrefixes{}
+		allowedUnscoped cidrset.Builder
+		private         cidrset.Builder
+	)
+	for _, prefix := range append(privateIPv4Ranges, privateIPv6Ranges...) {
+		private.AddPrefix(prefix)
+	}
+
+	for _, entry := range entries {
+		if entry.FQDN != "" || (entry.Protocol == "" && entry.Policy != config.PolicyAllowAccess) {
+			continue
+		}
+		if errs := validation.ValidateFilterScope(&entry, field.NewPath("filter")); len(errs) > 0 {
+			logger.Error(errs.ToAggregate(), "Error parsing ports from filter list, ignoring it", "network", entry.Network)
+			continue
+		}
+		prefix, err := parsePrefix(entry.Network)
+		if err != nil {
+			logger.Error(err, "Error parsing CIDR from filter list, ignoring it", "offending CIDR", entry.Network)
+			continue
+		}
+		if entry.Protocol == "" {
+			allowedUnscoped.AddPrefix(prefix)
+			continue
+		}
+
+		scopes := blocked
+		if entry.Policy == config.PolicyAllowAccess {
+			scopes = allowed
+		}
+		scope := newPortScope(entry)
+		scoped, ok := scopes[scope.key()]
+		if !ok {
+			scoped = &scopedPrefixes{scope: scope}
+			scopes[scope.key()] = scoped
+		}
+		scoped.prefixes.AddPrefix(prefix)
+	}
+
+	var result []string
+	for _, key := range slices.Sorted(maps.Keys(blocked)) {
+		scoped := blocked[key]
+		scoped.prefixes.RemoveSet(private.Set())
+		scoped.prefixes.RemoveSet(allowedUnscoped.Set())
+		for _, allow := range allowed {
+			if allow.scope.covers(scoped.scope) {
+				scoped.prefixes.RemoveSet(allow.prefixes.Set())
+			}
+		}
+		for _, prefix := range scoped.prefixes.Set().Prefixes() {
+			result = append(result, key+" "+prefix.String())
+		}
+	}
+	if len(result) > 0 {
+		logger.Info("port- and protocol-scoped filter list generated", "scopes", len(blocked), "rules", len(result))
+	}
+	return result
+}
+
+// removeFromPortList removes the addresses of the set from the networks of a rendered port list.
+func removeFromPortList(data []byte, set *cidrset.Set) []byte {
+	var (
+		keys   []string
+		scopes = map[string]*cidrset.Builder{}
+	)
+	for _, rule := range plainYamlListEntries(data) {
+		i := strings.LastIndex(rule, " ")
+		if i < 0 {
+			continue
+		}
+		prefix, err := parsePrefix(rule[i+1:])
+		if err != nil {
+			continue
+		}
+		key := rule[:i]
+		if _, ok := scopes[key]; !ok {
+			keys = append(keys, key)
+			scopes[key] = &cidrset.Builder{}
+		}
+		scopes[key].AddPrefix(prefix)
+	}
+
+	var result []string
+	for _, key := range keys {
+		scopes[key].RemoveSet(set)
+		for _, prefix := range scopes[key].Set().Prefixes() {
+			result = append(result, key+" "+prefix.String())
+		}
+	}
+	return []byte(convertToPlainYamlList(result))
+}
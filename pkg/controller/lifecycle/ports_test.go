@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"net/netip"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+)
+
+var _ = Describe("Port- and protocol-scoped filters", func() {
+	ports := func(ranges ...[2]int32) []config.PortRange {
+		var result []config.PortRange
+		for _, r := range ranges {
+			portRange := config.PortRange{Port: r[0]}
+			if r[1] != r[0] {
+				portRange.EndPort = new(r[1])
+			}
+			result = append(result, portRange)
+		}
+		return result
+	}
+
+	DescribeTable("#portScope.key", func(filter config.Filter, expected string) {
+		Expect(newPortScope(filter).key()).To(Equal(expected))
+	},
+		Entry("all ports", config.Filter{Protocol: config.ProtocolUDP}, "udp *"),
+		Entry("single port", config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{25, 25})}, "tcp 25"),
+		Entry("sorted and merged ports", config.Filter{Protocol: config.ProtocolSCTP, Ports: ports([2]int32{8000, 8080}, [2]int32{25, 25}, [2]int32{8081, 8081}, [2]int32{8010, 8020})}, "sctp 25,8000-8081"),
+	)
+
+	DescribeTable("#portScope.covers", func(scope, other config.Filter, expected bool) {
+		Expect(newPortScope(scope).covers(newPortScope(other))).To(Equal(expected))
+	},
+		Entry("all ports cover single port", config.Filter{Protocol: config.ProtocolTCP}, config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{25, 25})}, true),
+		Entry("different protocol", config.Filter{Protocol: config.ProtocolUDP}, config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{25, 25})}, false),
+		Entry("range covers ports", config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{20, 30})}, config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{25, 25}, [2]int32{21, 22})}, true),
+		Entry("range does not cover all ports", config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{20, 30})}, config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{25, 31})}, false),
+		Entry("ports do not cover all ports", config.Filter{Protocol: config.ProtocolTCP, Ports: ports([2]int32{1, 65535})}, config.Filter{Protocol: config.ProtocolTCP}, false),
+	)
+
+	Describe("#generatePortFilterList", func() {
+		It("should return no rules without scoped entries", func() {
+			Expect(generatePortFilterList([]config.Filter{
+				{Network: "1.2.3.4/32", Policy: config.PolicyBlockAccess},
+				{Network: "1.2.3.0/24", Policy: config.PolicyAllowAccess},
+			}, logr.Discard())).To(BeEmpty())
+		})
+
+		It("should render the rules with carve-outs of allowed and private networks", func() {
+			Expect(generatePortFilterList([]config.Filter{
+				{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP, Ports: ports([2]int32{25, 25})},
+				{Network: "0.0.0.0/0", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolUDP, Ports: ports([2]int32{53, 53})},
+				{Network: "2001:db8::/32", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolUDP, Ports: ports([2]int32{53, 53})},
+				{Network: "1.2.3.0/24", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolSCTP},
+				// carves out of all scopes
+				{Network: "128.0.0.0/1", Policy: config.PolicyAllowAccess},
+				// carves out of udp 53 only
+				{Network: "64.0.0.0/2", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolUDP, Ports: ports([2]int32{53, 53})},
+				{Network: "2001:db8:1::/48", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolUDP},
+				// does not cover tcp 25
+				{Network: "32.0.0.0/3", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP, Ports: ports([2]int32{26, 30})},
+				// not scoped
+				{Network: "5.6.7.8/32", Policy: config.PolicyBlockAccess},
+				// invalid entries
+				{Network: "invalid", Policy: config.PolicyBlockAccess, Protocol: config.ProtocolTCP},
+				{Network: "9.9.9.9/32", Policy: config.PolicyBlockAccess, Protocol: "ICMP"},
+			}, logr.Discard())).To(Equal([]string{
+				"sctp * 1.2.3.0/24",
+				"tcp 25 0.0.0.0/5",
+				"tcp 25 8.0.0.0/7",
+				"tcp 25 11.0.0.0/8",
+				"tcp 25 12.0.0.0/6",
+				"tcp 25 16.0.0.0/4",
+				"tcp 25 32.0.0.0/3",
+				"tcp 25 64.0.0.0/3",
+				"tcp 25 96.0.0.0/6",
+				"tcp 25 100.0.0.0/10",
+				"tcp 25 100.128.0.0/9",
+				"tcp 25 101.0.0.0/8",
+				"tcp 25 102.0.0.0/7",
+				"tcp 25 104.0.0.0/5",
+				"tcp 25 112.0.0.0/5",
+				"tcp 25 120.0.0.0/6",
+				"tcp 25 124.0.0.0/7",
+				"tcp 25 126.0.0.0/8",
+				"udp 53 0.0.0.0/5",
+				"udp 53 8.0.0.0/7",
+				"udp 53 11.0.0.0/8",
+				"udp 53 12.0.0.0/6",
+				"udp 53 16.0.0.0/4",
+				"udp 53 32.0.0.0/3",
+				"udp 53 2001:db8::/48",
+				"udp 53 2001:db8:2::/47",
+				"udp 53 2001:db8:4::/46",
+				"udp 53 2001:db8:8::/45",
+				"udp 53 2001:db8:10::/44",
+				"udp 53 2001:db8:20::/43",
+				"udp 53 2001:db8:40::/42",
+				"udp 53 2001:db8:80::/41",
+				"udp 53 2001:db8:100::/40",
+				"udp 53 2001:db8:200::/39",
+				"udp 53 2001:db8:400::/38",
+				"udp 53 2001:db8:800::/37",
+				"udp 53 2001:db8:1000::/36",
+				"udp 53 2001:db8:2000::/35",
+				"udp 53 2001:db8:4000::/34",
+				"udp 53 2001:db8:8000::/33",
+			}))
+		})
+	})
+
+	Describe("#removeFromPortList", func() {
+		It("should remove the addresses from the networks of all scopes", func() {
+			var set cidrset.Builder
+			set.Add(netip.MustParseAddr("1.2.3.4"))
+			Expect(string(removeFromPortList([]byte("- tcp 25 1.2.3.4/31\n- udp * 1.2.3.0/30\n- udp * 2001:db8::/32\n"), set.Set()))).To(Equal(
+				"- tcp 25 1.2.3.5/32\n- udp * 1.2.3.0/30\n- udp * 2001:db8::/32\n"))
+		})
+	})
+})
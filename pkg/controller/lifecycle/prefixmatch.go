@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"cmp"
+	"maps"
+	"net/netip"
+	"slices"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+)
+
+// policyEvaluation returns the policy evaluation of the shoot configuration if set, otherwise the one of the
+// extension configuration.
+func policyEvaluation(serviceConfig, shootConfig *config.EgressFilter) config.PolicyEvaluation {
+	if shootConfig != nil && shootConfig.PolicyEvaluation != "" {
+		return shootConfig.PolicyEvaluation
+	}
+	if serviceConfig != nil && serviceConfig.PolicyEvaluation != "" {
+		return serviceConfig.PolicyEvaluation
+	}
+	return config.PolicyEvaluationAllowPrecedence
+}
+
+// generateLongestPrefixMatchValuesWithStatus generates the IPv4/IPv6 lists of the given mode with policy evaluation
+// `longestPrefixMatch`, i.e. every address has the policy of the most specific entry containing it. Of several entries
+// for the same network the last one applies, so the entries have to be ordered by the priority of their source, see
+// readAndRestrictFilterListSecretData. In mode `blockList` the addresses with policy `BLOCK_ACCESS` are blocked, in
+// mode `allowList` all public addresses except the ones with policy `ALLOW_ACCESS`.
+// If status is not nil, dropped private networks, carve-outs by allowed networks and ignored scoped entries are
+// recorded in it.
+func generateLongestPrefixMatchValuesWithStatus(entries []config.Filter, mode config.FilterMode, logger logr.Logger, status *config.EgressFilterStatus) ([]string, []string, error) {
+	// FQDN entries are expected to be resolved into network entries before, see resolveFQDNEntries.
+	// Port- and protocol-scoped entries are handled separately, see generatePortFilterList.
+	policies := map[netip.Prefix]config.Policy{}
+	var scoped int
+	for _, entry := range entries {
+		if entry.FQDN != "" || (entry.Policy != config.PolicyBlockAccess && entry.Policy != config.PolicyAllowAccess) {
+			continue
+		}
+		if entry.Protocol != "" {
+			if mode == config.FilterModeAllowList && entry.Policy == config.PolicyAllowAccess {
+				scoped++
+			}
+			continue
+		}
+		prefix, err := parsePrefix(entry.Network)
+		if err != nil {
+			logger.Error(err, "Error parsing CIDR from filter list, ignoring it", "offending CIDR", entry.Network)
+			continue
+		}
+		if mode == config.FilterModeBlockList && entry.Policy == config.PolicyBlockAccess {
+			if privateNet, ok := overlappingPrivateRange(prefix); ok {
+				logger.Info("Identified overlapping CIDR in filter list, ignoring it", "offending CIDR", prefix.String(), "reserved range", privateNet.String())
+				recordDroppedPrivateEntry(status, prefix.String())
+				continue
+			}
+		}
+		policies[prefix] = entry.Policy
+	}
+	if scoped > 0 {
+		logger.Info("Ignoring port- and protocol-scoped allowed networks in mode allowList", "entries", scoped)
+		if status != nil {
+			status.PortScopedEntriesIgnored = true
+		}
+	}
+
+	// The addresses of the matching policy are added, the ones of the other policy removed
+	matching := config.PolicyBlockAccess
+	if mode == config.FilterModeAllowList {
+		matching = config.PolicyAllowAccess
+	}
+	matched := longestPrefixMatch(policies, matching)
+
+	var blocked *cidrset.Builder
+	if mode == config.FilterModeAllowList {
+		blocked = blockedPublicRanges()
+		carveOutAllowedNetworks(blocked, matched, logger, status)
+	} else {
+		// The blocked networks are carved out where more specific entries allow them
+		var carved cidrset.Builder
+		blocked = &cidrset.Builder{}
+		for prefix, policy := range policies {
+			if policy == config.PolicyBlockAccess {
+				blocked.AddPrefix(prefix)
+			}
+		}
+		carved.AddSet(blocked.Set())
+		carved.RemoveSet(matched)
+		carveOutAllowedNetworks(blocked, carved.Set(), logger, status)
+	}
+
+	ipv4List, ipv6List := prefixListToStringLists(blocked.Set().Prefixes())
+	return ipv4List, ipv6List, nil
+}
+
+// longestPrefixMatch returns the addresses whose most specific prefix has the given policy. The prefixes are applied
+// from the least to the most specific one, so that more specific prefixes override less specific ones. Prefixes of
+// the same length do not overlap, so the additions and removals of one length are applied in one batch each.
+func longestPrefixMatch(policies map[netip.Prefix]config.Policy, policy config.Policy) *cidrset.Set {
+	// Additions before removals
+	rank := func(prefix netip.Prefix) int {
+		if policies[prefix] == policy {
+			return 0
+		}
+		return 1
+	}
+	prefixes := slices.SortedFunc(maps.Keys(policies), func(a, b netip.Prefix) int {
+		return cmp.Or(cmp.Compare(a.Bits(), b.Bits()), cmp.Compare(rank(a), rank(b)))
+	})
+
+	var result cidrset.Builder
+	for _, prefix := range prefixes {
+		if policies[prefix] == policy {
+			result.AddPrefix(prefix)
+		} else {
+			result.RemovePrefix(prefix)
+		}
+	}
+	return result.Set()
+}
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"net/netip"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+)
+
+var _ = Describe("Longest prefix match", func() {
+	var (
+		block = func(network string) config.Filter {
+			return config.Filter{Network: network, Policy: config.PolicyBlockAccess}
+		}
+		allow = func(network string) config.Filter {
+			return config.Filter{Network: network, Policy: config.PolicyAllowAccess}
+		}
+	)
+
+	DescribeTable("#policyEvaluation", func(serviceConfig, shootConfig *config.EgressFilter, expected config.PolicyEvaluation) {
+		Expect(policyEvaluation(serviceConfig, shootConfig)).To(Equal(expected))
+	},
+		Entry("default", nil, nil, config.PolicyEvaluationAllowPrecedence),
+		Entry("extension configuration", &config.EgressFilter{PolicyEvaluation: config.PolicyEvaluationLongestPrefixMatch}, &config.EgressFilter{}, config.PolicyEvaluationLongestPrefixMatch),
+		Entry("shoot configuration", &config.EgressFilter{PolicyEvaluation: config.PolicyEvaluationLongestPrefixMatch}, &config.EgressFilter{PolicyEvaluation: config.PolicyEvaluationAllowPrecedence}, config.PolicyEvaluationAllowPrecedence),
+	)
+
+	DescribeTable("#generateLongestPrefixMatchValuesWithStatus in mode blockList", func(entries []config.Filter, expectedIPv4, expectedIPv6 []string, expectedCarveOuts int) {
+		status := &config.EgressFilterStatus{}
+		ipv4List, ipv6List, err := generateLongestPrefixMatchValuesWithStatus(entries, config.FilterModeBlockList, logr.Discard(), status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipv4List).To(Equal(expectedIPv4))
+		Expect(ipv6List).To(Equal(expectedIPv6))
+		Expect(status.IPv4.AllowCarveOuts + status.IPv6.AllowCarveOuts).To(Equal(expectedCarveOuts))
+	},
+		Entry("no entries", nil, []string{}, []string{}, 0),
+		Entry("blocked network within an allowed network",
+			[]config.Filter{allow("0.0.0.0/0"), block("203.0.113.7/32")},
+			[]string{"203.0.113.7/32"}, []string{}, 0),
+		Entry("allowed network within a blocked network",
+			[]config.Filter{block("203.0.113.0/24"), allow("203.0.113.0/25")},
+			[]string{"203.0.113.128/25"}, []string{}, 1),
+		Entry("nested chain",
+			[]config.Filter{
+				block("198.51.100.0/24"),
+				allow("198.51.100.0/26"),
+				block("198.51.100.0/28"),
+				allow("198.51.100.0/30"),
+			},
+			[]string{"198.51.100.4/30", "198.51.100.8/29", "198.51.100.64/26", "198.51.100.128/25"}, []string{}, 1),
+		Entry("nested chain in any order",
+			[]config.Filter{
+				allow("198.51.100.0/30"),
+				block("198.51.100.0/28"),
+				allow("198.51.100.0/26"),
+				block("198.51.100.0/24"),
+			},
+			[]string{"198.51.100.4/30", "198.51.100.8/29", "198.51.100.64/26", "198.51.100.128/25"}, []string{}, 1),
+		Entry("same network with the later entry taking precedence",
+			[]config.Filter{block("192.0.2.0/24"), allow("192.0.2.0/24"), allow("2001:db8::/32"), block("2001:db8::/32")},
+			[]string{}, []string{"2001:db8::/32"}, 0),
+		Entry("ipv6",
+			[]config.Filter{allow("2001:db8::/32"), block("2001:db8:1::/48"), block("2001:db9::/32")},
+			[]string{}, []string{"2001:db8:1::/48", "2001:db9::/32"}, 0),
+		Entry("private and scoped entries",
+			[]config.Filter{block("10.0.0.0/8"), block("192.0.2.0/24"), {Network: "192.0.2.0/25", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP}},
+			[]string{"192.0.2.0/24"}, []string{}, 0),
+	)
+
+	It("should block all public networks except the most specific allowed ones in mode allowList", func() {
+		status := &config.EgressFilterStatus{}
+		ipv4List, ipv6List, err := generateLongestPrefixMatchValuesWithStatus([]config.Filter{
+			allow("192.0.2.0/24"),
+			block("192.0.2.128/25"),
+			allow("192.0.2.192/26"),
+			{Network: "198.51.100.0/24", Policy: config.PolicyAllowAccess, Protocol: config.ProtocolTCP},
+		}, config.FilterModeAllowList, logr.Discard(), status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipv6List).To(Equal([]string{"2000::/3"}))
+
+		var builder cidrset.Builder
+		for _, cidr := range ipv4List {
+			builder.AddPrefix(netip.MustParsePrefix(cidr))
+		}
+		blocked := builder.Set()
+		Expect(blocked.OverlapsPrefix(netip.MustParsePrefix("192.0.2.0/25"))).To(BeFalse())
+		Expect(blocked.ContainsPrefix(netip.MustParsePrefix("192.0.2.128/26"))).To(BeTrue())
+		Expect(blocked.OverlapsPrefix(netip.MustParsePrefix("192.0.2.192/26"))).To(BeFalse())
+		Expect(blocked.ContainsPrefix(netip.MustParsePrefix("198.51.100.0/24"))).To(BeTrue())
+		Expect(status.PortScopedEntriesIgnored).To(BeTrue())
+	})
+})
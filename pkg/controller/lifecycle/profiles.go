@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// applierProfile contains the filter lists of a filter profile and the nodes applying them.
+type applierProfile struct {
+	name               string
+	blackholingEnabled bool
+	// nodeRequirements are the requirements of the nodes selected by the profile.
+	nodeRequirements []corev1.NodeSelectorRequirement
+	secretData       map[string][]byte
+}
+
+// applierNodes selects the nodes of an egress filter applier DaemonSet. The zero value selects all nodes.
+type applierNodes struct {
+	// workerGroup is the name of the worker group of the nodes.
+	workerGroup string
+	// profile is the name of the filter profile of the nodes.
+	profile string
+	// nodeSelectorTerms are the node affinity terms of the nodes of the filter profile or of the nodes which do not
+	// belong to any filter profile.
+	nodeSelectorTerms []corev1.NodeSelectorTerm
+}
+
+// applierResourceName returns the name of the DaemonSet and filter list Secret of the egress filter applier for the
+// nodes of a worker group or filter profile. The worker group or profile name is appended to the application name, so
+// that the existing DaemonSets of worker groups keep their names. Only names exceeding the length of a DNS label are
+// shortened with a hash.
+func applierResourceName(name string) string {
+	if result := fmt.Sprintf("%s-%s", constants.ApplicationName, name); len(result) <= validation.DNS1123LabelMaxLength {
+		return result
+	}
+	return fmt.Sprintf("%s-%s", constants.ApplicationName, utils.ComputeSHA256Hex([]byte(name))[:10])
+}
+
+// profileNodeRequirements returns the requirements of the nodes selected by the filter profile, which all have to
+// match. Match labels are sorted by their key.
+func profileNodeRequirements(profile config.FilterProfile) []corev1.NodeSelectorRequirement {
+	var result []corev1.NodeSelectorRequirement
+	if len(profile.WorkerPools) > 0 {
+		result = append(result, corev1.NodeSelectorRequirement{
+			Key:      v1beta1constants.LabelWorkerPool,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   profile.WorkerPools,
+		})
+	}
+	if profile.NodeSelector != nil {
+		for _, key := range slices.Sorted(maps.Keys(profile.NodeSelector.MatchLabels)) {
+			result = append(result, corev1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{profile.NodeSelector.MatchLabels[key]},
+			})
+		}
+		for _, expression := range profile.NodeSelector.MatchExpressions {
+			result = append(result, corev1.NodeSelectorRequirement{
+				Key:      expression.Key,
+				Operator: corev1.NodeSelectorOperator(expression.Operator),
+				Values:   expression.Values,
+			})
+		}
+	}
+	return result
+}
+
+// negateNodeRequirement returns the requirement matching the nodes not matched by the given one.
+func negateNodeRequirement(requirement corev1.NodeSelectorRequirement) corev1.NodeSelectorRequirement {
+	switch requirement.Operator {
+	case corev1.NodeSelectorOpIn:
+		requirement.Operator = corev1.NodeSelectorOpNotIn
+	case corev1.NodeSelectorOpNotIn:
+		requirement.Operator = corev1.NodeSelectorOpIn
+	case corev1.NodeSelectorOpExists:
+		requirement.Operator = corev1.NodeSelectorOpDoesNotExist
+	case corev1.NodeSelectorOpDoesNotExist:
+		requirement.Operator = corev1.NodeSelectorOpExists
+	}
+	return requirement
+}
+
+// nodeSelectorTerms returns the node affinity terms of the nodes matching all included requirements, but not all
+// requirements of any of the excluded ones. Node affinity terms are ORed, so every excluded profile is removed by
+// adding the negation of one of its requirements to each term. It returns a single term without requirements if all
+// nodes match, and no terms if no nodes match.
+func nodeSelectorTerms(include []corev1.NodeSelectorRequirement, exclude [][]corev1.NodeSelectorRequirement) [][]corev1.NodeSelectorRequirement {
+	terms := [][]corev1.NodeSelectorRequirement{include}
+	for _, requirements := range exclude {
+		var next [][]corev1.NodeSelectorRequirement
+		for _, term := range terms {
+			for _, requirement := range requirements {
+				next = append(next, append(slices.Clone(term), negateNodeRequirement(requirement)))
+			}
+		}
+		terms = next
+	}
+	return terms
+}
+
+// profileApplierNodes returns the nodes of the egress filter applier DaemonSets of the filter profiles and the ones of
+// the DaemonSet of all other nodes. A node selected by several profiles uses the first one. Profiles without nodes
+// are omitted, as are the other nodes if the profiles select all nodes.
+func profileApplierNodes(profiles []applierProfile) (map[string]applierNodes, *applierNodes) {
+	var (
+		result   = map[string]applierNodes{}
+		previous [][]corev1.NodeSelectorRequirement
+	)
+	for _, profile := range profiles {
+		if terms := nodeSelectorTerms(profile.nodeRequirements, previous); len(terms) > 0 {
+			result[profile.name] = applierNodes{profile: profile.name, nodeSelectorTerms: toNodeSelectorTerms(terms)}
+		}
+		previous = append(previous, profile.nodeRequirements)
+	}
+
+	terms := nodeSelectorTerms(nil, previous)
+	if len(terms) == 0 {
+		return result, nil
+	}
+	return result, &applierNodes{nodeSelectorTerms: toNodeSelectorTerms(terms)}
+}
+
+// toNodeSelectorTerms returns the node affinity terms of the given requirements. A single term without requirements
+// matches all nodes and is returned as no terms, as an empty node affinity term matches no nodes.
+func toNodeSelectorTerms(terms [][]corev1.NodeSelectorRequirement) []corev1.NodeSelectorTerm {
+	if len(terms) == 1 && len(terms[0]) == 0 {
+		return nil
+	}
+	result := make([]corev1.NodeSelectorTerm, 0, len(terms))
+	for _, term := range terms {
+		result = append(result, corev1.NodeSelectorTerm{MatchExpressions: term})
+	}
+	return result
+}
+
+// profileStatus returns the status of the filter lists rendered for the filter profile.
+func profileStatus(name string, secretData map[string][]byte) config.ProfileStatus {
+	return config.ProfileStatus{
+		Name:        name,
+		Checksum:    utils.ComputeSecretChecksum(secretData),
+		IPv4Entries: len(plainYamlListEntries(secretData[constants.KeyIPV4List])),
+		IPv6Entries: len(plainYamlListEntries(secretData[constants.KeyIPV6List])),
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Filter profiles", func() {
+	var (
+		poolA = corev1.NodeSelectorRequirement{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}
+		gpu   = corev1.NodeSelectorRequirement{Key: "gpu", Operator: corev1.NodeSelectorOpExists}
+	)
+
+	Describe("#applierResourceName", func() {
+		It("should keep the names of existing worker group DaemonSets", func() {
+			Expect(applierResourceName("worker-a")).To(Equal("egress-filter-applier-worker-a"))
+			Expect(applierResourceName("gpu")).To(Equal("egress-filter-applier-gpu"))
+		})
+
+		It("should derive short and stable names for long names", func() {
+			name := applierResourceName("a-very-long-worker-pool-name-exceeding-the-length-limits")
+			Expect(name).To(HavePrefix("egress-filter-applier-"))
+			Expect(name).To(HaveLen(len("egress-filter-applier-") + 10))
+			Expect(applierResourceName("a-very-long-worker-pool-name-exceeding-the-length-limits")).To(Equal(name))
+			Expect(applierResourceName("other")).NotTo(Equal(name))
+		})
+	})
+
+	Describe("#profileNodeRequirements", func() {
+		It("should combine the worker pools and the node selector", func() {
+			Expect(profileNodeRequirements(config.FilterProfile{
+				WorkerPools: []string{"a"},
+				NodeSelector: &metav1.LabelSelector{
+					MatchLabels:      map[string]string{"zone": "z1", "arch": "arm64"},
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "gpu", Operator: metav1.LabelSelectorOpExists}},
+				},
+			})).To(Equal([]corev1.NodeSelectorRequirement{
+				poolA,
+				{Key: "arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+				{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"z1"}},
+				gpu,
+			}))
+		})
+	})
+
+	Describe("#profileApplierNodes", func() {
+		It("should assign the nodes selected by several profiles to the first one", func() {
+			profiles, others := profileApplierNodes([]applierProfile{
+				{name: "pool-a", nodeRequirements: []corev1.NodeSelectorRequirement{poolA}},
+				{name: "gpu", nodeRequirements: []corev1.NodeSelectorRequirement{gpu}},
+			})
+			Expect(profiles).To(Equal(map[string]applierNodes{
+				"pool-a": {profile: "pool-a", nodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{poolA}},
+				}},
+				"gpu": {profile: "gpu", nodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{gpu, {Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"a"}}}},
+				}},
+			}))
+			Expect(others).To(Equal(&applierNodes{nodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"a"}},
+					{Key: "gpu", Operator: corev1.NodeSelectorOpDoesNotExist},
+				}},
+			}}))
+		})
+
+		It("should exclude the nodes of previous profiles requirement by requirement", func() {
+			profiles, others := profileApplierNodes([]applierProfile{
+				{name: "gpu-pool-a", nodeRequirements: []corev1.NodeSelectorRequirement{poolA, gpu}},
+			})
+			Expect(profiles["gpu-pool-a"].nodeSelectorTerms).To(HaveLen(1))
+			Expect(others).To(Equal(&applierNodes{nodeSelectorTerms: []corev1.NodeSelectorTerm{
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "worker.gardener.cloud/pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"a"}}}},
+				{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gpu", Operator: corev1.NodeSelectorOpDoesNotExist}}},
+			}}))
+		})
+
+		It("should omit profiles and other nodes without nodes", func() {
+			profiles, others := profileApplierNodes([]applierProfile{
+				{name: "all"},
+				{name: "pool-a", nodeRequirements: []corev1.NodeSelectorRequirement{poolA}},
+			})
+			Expect(profiles).To(Equal(map[string]applierNodes{"all": {profile: "all"}}))
+			Expect(others).To(BeNil())
+		})
+	})
+
+	Describe("#profileStatus", func() {
+		It("should report the checksum and the number of entries", func() {
+			secretData := map[string][]byte{
+				constants.KeyIPV4List: []byte("- 192.0.2.0/24\n- 198.51.100.0/24\n"),
+				constants.KeyIPV6List: []byte("- 2001:db8::/32\n"),
+			}
+			status := profileStatus("restricted", secretData)
+			Expect(status.Name).To(Equal("restricted"))
+			Expect(status.Checksum).NotTo(BeEmpty())
+			Expect(status.IPv4Entries).To(Equal(2))
+			Expect(status.IPv6Entries).To(Equal(1))
+		})
+	})
+})
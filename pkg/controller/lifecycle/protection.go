@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"maps"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/cidrset"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/fqdn"
+)
+
+// Kinds of protected endpoints as reported in the status.
+const (
+	protectedEndpointAPIServer     = "apiServer"
+	protectedEndpointShootNodes    = "shootNodes"
+	protectedEndpointShootPods     = "shootPods"
+	protectedEndpointShootServices = "shootServices"
+	protectedEndpointSeedNodes     = "seedNodes"
+	protectedEndpointSeedPods      = "seedPods"
+	protectedEndpointSeedServices  = "seedServices"
+	protectedEndpointSeedIngress   = "seedIngress"
+	protectedEndpointRegistry      = "registry"
+)
+
+// protectedEndpoint is an endpoint which must stay reachable to keep the cluster operable.
+type protectedEndpoint struct {
+	// name describes the endpoint in the status, e.g. `apiServer api.shoot.example.com`.
+	name string
+	// fqdn is the host name of the endpoint, if it still needs to be resolved.
+	fqdn string
+	// prefixes are the networks of the endpoint.
+	prefixes []netip.Prefix
+}
+
+// newProtectedEndpoint returns the protected endpoint of the given kind for a network CIDR, IP address or host name.
+// It returns false if the value is none of them.
+func newProtectedEndpoint(kind, value string) (protectedEndpoint, bool) {
+	endpoint := protectedEndpoint{name: kind + " " + value}
+	if prefix, err := parsePrefix(value); err == nil {
+		endpoint.prefixes = []netip.Prefix{prefix}
+	} else if addr, err := netip.ParseAddr(value); err == nil {
+		addr = addr.Unmap()
+		endpoint.prefixes = []netip.Prefix{netip.PrefixFrom(addr, addr.BitLen())}
+	} else if isFQDN(value) {
+		endpoint.fqdn = normalizeFQDN(value)
+	} else {
+		return protectedEndpoint{}, false
+	}
+	return endpoint, true
+}
+
+// newProtectedEndpoints returns the protected endpoints of the given kind, skipping invalid values.
+func newProtectedEndpoints(kind string, values ...string) []protectedEndpoint {
+	var result []protectedEndpoint
+	for _, value := range values {
+		if endpoint, ok := newProtectedEndpoint(kind, strings.TrimSpace(value)); ok {
+			result = append(result, endpoint)
+		}
+	}
+	return result
+}
+
+// protectedEndpointFQDNs returns the host names of the protected endpoints.
+func protectedEndpointFQDNs(endpoints []protectedEndpoint) []string {
+	var names []string
+	for _, endpoint := range endpoints {
+		if endpoint.fqdn != "" {
+			names = append(names, endpoint.fqdn)
+		}
+	}
+	return names
+}
+
+// resolveProtectedEndpoints resolves the host names of the protected endpoints. Endpoints whose names could not be
+// resolved (yet) are dropped.
+func resolveProtectedEndpoints(ctx context.Context, cache *fqdn.Cache, endpoints []protectedEndpoint, logger logr.Logger) []protectedEndpoint {
+	names := protectedEndpointFQDNs(endpoints)
+	if len(names) == 0 {
+		return endpoints
+	}
+
+	var addrs map[string][]netip.Addr
+	if cache != nil {
+		addrs = cache.Lookup(ctx, names)
+	}
+	result := make([]protectedEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if endpoint.fqdn == "" {
+			result = append(result, endpoint)
+			continue
+		}
+		if len(addrs[endpoint.fqdn]) == 0 {
+			logger.Info("Cannot resolve protected endpoint, it may be blocked by the filter list", "endpoint", endpoint.name)
+			continue
+		}
+		for _, addr := range addrs[endpoint.fqdn] {
+			endpoint.prefixes = append(endpoint.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+		result = append(result, endpoint)
+	}
+	return result
+}
+
+// protectConnectivity removes the networks of the protected endpoints from the rendered filter lists.
+// If status is not nil, every blocked network or port-scoped rule which was split or removed is recorded in it.
+func protectConnectivity(secretData map[string][]byte, endpoints []protectedEndpoint, logger logr.Logger, status *config.EgressFilterStatus) map[string][]byte {
+	if len(endpoints) == 0 {
+		return secretData
+	}
+
+	var protected cidrset.Builder
+	sets := make([]*cidrset.Set, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		var builder cidrset.Builder
+		for _, prefix := range endpoint.prefixes {
+			builder.AddPrefix(prefix)
+			protected.AddPrefix(prefix)
+		}
+		sets = append(sets, builder.Set())
+	}
+	protectedSet := protected.Set()
+
+	recordCarveOuts := func(network string, prefix netip.Prefix) {
+		for i, set := range sets {
+			if !set.OverlapsPrefix(prefix) {
+				continue
+			}
+			logger.Info("Carving out protected endpoint from filter list", "network", network, "endpoint", endpoints[i].name)
+			if status != nil {
+				status.ConnectivityCarveOuts = append(status.ConnectivityCarveOuts, config.ConnectivityCarveOut{Network: network, Endpoint: endpoints[i].name})
+			}
+		}
+	}
+
+	result := make(map[string][]byte, len(secretData))
+	for _, key := range slices.Sorted(maps.Keys(secretData)) {
+		value := secretData[key]
+		switch key {
+		case constants.KeyIPV4List, constants.KeyIPV6List, constants.KeyIPV4AuditList, constants.KeyIPV6AuditList:
+			var list cidrset.Builder
+			for _, prefix := range prefixListFromPlainYamlList(string(value)) {
+				recordCarveOuts(prefix.String(), prefix)
+				list.AddPrefix(prefix)
+			}
+			list.RemoveSet(protectedSet)
+			ipv4List, ipv6List := prefixListToStringLists(list.Set().Prefixes())
+			value = []byte(convertToPlainYamlList(append(ipv4List, ipv6List...)))
+		case constants.KeyPortList, constants.KeyPortAuditList:
+			for _, rule := range plainYamlListEntries(value) {
+				if i := strings.LastIndex(rule, " "); i >= 0 {
+					if prefix, err := parsePrefix(rule[i+1:]); err == nil {
+						recordCarveOuts(rule, prefix)
+					}
+				}
+			}
+			value = removeFromPortList(value, protectedSet)
+		}
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/fqdn"
+)
+
+var _ = Describe("Connectivity protection", func() {
+	DescribeTable("#newProtectedEndpoints", func(values []string, expected []protectedEndpoint) {
+		Expect(newProtectedEndpoints(protectedEndpointAPIServer, values...)).To(Equal(expected))
+	},
+		Entry("none", nil, nil),
+		Entry("networks, addresses and host names",
+			[]string{"10.250.0.0/16", "203.0.113.10", "::ffff:203.0.113.11", "API.Shoot.example.com", "", "invalid"},
+			[]protectedEndpoint{
+				{name: "apiServer 10.250.0.0/16", prefixes: []netip.Prefix{netip.MustParsePrefix("10.250.0.0/16")}},
+				{name: "apiServer 203.0.113.10", prefixes: []netip.Prefix{netip.MustParsePrefix("203.0.113.10/32")}},
+				{name: "apiServer ::ffff:203.0.113.11", prefixes: []netip.Prefix{netip.MustParsePrefix("203.0.113.11/32")}},
+				{name: "apiServer API.Shoot.example.com", fqdn: "api.shoot.example.com"},
+			},
+		),
+	)
+
+	Describe("#resolveProtectedEndpoints", func() {
+		var endpoints []protectedEndpoint
+
+		BeforeEach(func() {
+			endpoints = append(
+				newProtectedEndpoints(protectedEndpointAPIServer, "api.shoot.example.com", "unknown.example.com"),
+				newProtectedEndpoints(protectedEndpointShootNodes, "10.250.0.0/16")...,
+			)
+		})
+
+		It("should resolve the host names and drop unresolved endpoints", func() {
+			cache := fqdn.NewCache(fqdn.NewStaticResolver(map[string][]netip.Addr{
+				"api.shoot.example.com": {netip.MustParseAddr("203.0.113.10"), netip.MustParseAddr("2001:db8::10")},
+			}, time.Minute), clock.RealClock{}, 16, time.Minute, time.Hour, 0)
+
+			Expect(resolveProtectedEndpoints(context.Background(), cache, endpoints, logr.Discard())).To(Equal([]protectedEndpoint{
+				{name: "apiServer api.shoot.example.com", fqdn: "api.shoot.example.com", prefixes: []netip.Prefix{netip.MustParsePrefix("203.0.113.10/32"), netip.MustParsePrefix("2001:db8::10/128")}},
+				{name: "shootNodes 10.250.0.0/16", prefixes: []netip.Prefix{netip.MustParsePrefix("10.250.0.0/16")}},
+			}))
+		})
+
+		It("should drop host names without cache", func() {
+			Expect(resolveProtectedEndpoints(context.Background(), nil, endpoints, logr.Discard())).To(Equal([]protectedEndpoint{
+				{name: "shootNodes 10.250.0.0/16", prefixes: []netip.Prefix{netip.MustParsePrefix("10.250.0.0/16")}},
+			}))
+		})
+	})
+
+	Describe("#protectConnectivity", func() {
+		var (
+			secretData map[string][]byte
+			endpoints  []protectedEndpoint
+		)
+
+		BeforeEach(func() {
+			secretData = map[string][]byte{
+				constants.KeyIPV4List: []byte("- 203.0.113.0/24\n- 198.51.100.0/24\n"),
+				constants.KeyIPV6List: []byte("- 2001:db8::/126\n"),
+				constants.KeyPortList: []byte("- tcp 25 203.0.113.8/29\n- udp * 198.51.100.0/24\n"),
+			}
+			endpoints = []protectedEndpoint{
+				{name: "apiServer api.shoot.example.com", prefixes: []netip.Prefix{netip.MustParsePrefix("203.0.113.10/32"), netip.MustParsePrefix("2001:db8::1/128")}},
+				{name: "registry registry.example.com", prefixes: []netip.Prefix{netip.MustParsePrefix("203.0.113.11/32")}},
+			}
+		})
+
+		It("should carve out the protected endpoints and record the carve-outs", func() {
+			status := &config.EgressFilterStatus{}
+			Expect(protectConnectivity(secretData, endpoints, logr.Discard(), status)).To(Equal(map[string][]byte{
+				constants.KeyIPV4List: []byte("- 198.51.100.0/24\n- 203.0.113.0/29\n- 203.0.113.8/31\n- 203.0.113.12/30\n- 203.0.113.16/28\n- 203.0.113.32/27\n- 203.0.113.64/26\n- 203.0.113.128/25\n"),
+				constants.KeyIPV6List: []byte("- 2001:db8::/128\n- 2001:db8::2/127\n"),
+				constants.KeyPortList: []byte("- tcp 25 203.0.113.8/31\n- tcp 25 203.0.113.12/30\n- udp * 198.51.100.0/24\n"),
+			}))
+			Expect(status.ConnectivityCarveOuts).To(Equal([]config.ConnectivityCarveOut{
+				{Network: "203.0.113.0/24", Endpoint: "apiServer api.shoot.example.com"},
+				{Network: "203.0.113.0/24", Endpoint: "registry registry.example.com"},
+				{Network: "2001:db8::/126", Endpoint: "apiServer api.shoot.example.com"},
+				{Network: "tcp 25 203.0.113.8/29", Endpoint: "apiServer api.shoot.example.com"},
+				{Network: "tcp 25 203.0.113.8/29", Endpoint: "registry registry.example.com"},
+			}))
+		})
+
+		It("should keep the filter lists without protected endpoints", func() {
+			status := &config.EgressFilterStatus{}
+			Expect(protectConnectivity(secretData, nil, logr.Discard(), status)).To(Equal(secretData))
+			Expect(status.ConnectivityCarveOuts).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+const (
+	defaultRolloutExtensionsPerMinute = 20
+	defaultRolloutMaxJitter           = 10 * time.Second
+)
+
+// filterListRollout triggers the reconciliation of all Extensions when the downloaded filter list changes and of the
+// Extensions referencing FQDN entries when their addresses change. The reconciliations are spread over time, so that
+// the egress filter appliers of all shoots are not updated at once.
+type filterListRollout struct {
+	client           client.Client
+	notifier         filterListChangeNotifier
+	ready            <-chan struct{}
+	extensionClasses []extensionsv1alpha1.ExtensionClass
+	interval         time.Duration
+	maxJitter        time.Duration
+	logger           logr.Logger
+
+	// rolledOut is the checksum of the filter list of the latest rollout.
+	rolledOut string
+	// fqdnChanged receives a value when the addresses of FQDN entries changed.
+	fqdnChanged chan struct{}
+	// fqdnLock guards fqdnNamespaces.
+	fqdnLock sync.Mutex
+	// fqdnNamespaces are the namespaces of the Extensions whose changed FQDN addresses are not rolled out yet.
+	fqdnNamespaces sets.Set[string]
+}
+
+// newFilterListRollout returns the rollout of the filter list changes of the notifier and of the FQDN changes. The
+// filter list changes are not rolled out if the notifier is nil or the rollout is disabled. The rollout starts once
+// the ready channel is closed, i.e. the initial filter list is available.
+func newFilterListRollout(c client.Client, notifier filterListChangeNotifier, ready <-chan struct{}, extensionClasses []extensionsv1alpha1.ExtensionClass,
+	rolloutConfig *config.FilterListRollout, logger logr.Logger) (*filterListRollout, error) {
+	var (
+		extensionsPerMinute int32 = defaultRolloutExtensionsPerMinute
+		maxJitter                 = defaultRolloutMaxJitter
+	)
+	if rolloutConfig != nil {
+		if rolloutConfig.Disabled {
+			notifier = nil
+		}
+		if rolloutConfig.ExtensionsPerMinute != nil {
+			extensionsPerMinute = *rolloutConfig.ExtensionsPerMinute
+		}
+		if rolloutConfig.MaxJitter != nil {
+			maxJitter = rolloutConfig.MaxJitter.Duration
+		}
+	}
+	if extensionsPerMinute <= 0 {
+		return nil, fmt.Errorf("egressFilter.filterListRollout.extensionsPerMinute must be positive")
+	}
+	if maxJitter < 0 {
+		return nil, fmt.Errorf("egressFilter.filterListRollout.maxJitter must not be negative")
+	}
+
+	return &filterListRollout{
+		client:           c,
+		notifier:         notifier,
+		ready:            ready,
+		extensionClasses: extensionClasses,
+		interval:         time.Minute / time.Duration(extensionsPerMinute),
+		maxJitter:        maxJitter,
+		logger:           logger.WithName("filter-list-rollout"),
+		fqdnChanged:      make(chan struct{}, 1),
+	}, nil
+}
+
+// notifyFQDNChanged requests the rollout of changed addresses of FQDN entries to the Extensions in the given namespaces
+// without blocking. The namespaces notified during a rollout are rolled out together afterwards.
+func (r *filterListRollout) notifyFQDNChanged(namespaces sets.Set[string]) {
+	if namespaces.Len() == 0 {
+		return
+	}
+
+	r.fqdnLock.Lock()
+	if r.fqdnNamespaces == nil {
+		r.fqdnNamespaces = sets.New[string]()
+	}
+	r.fqdnNamespaces.Insert(namespaces.UnsortedList()...)
+	r.fqdnLock.Unlock()
+
+	notifyChanged(r.fqdnChanged)
+}
+
+// takeFQDNNamespaces returns the namespaces notified since the last call.
+func (r *filterListRollout) takeFQDNNamespaces() sets.Set[string] {
+	r.fqdnLock.Lock()
+	defer r.fqdnLock.Unlock()
+
+	namespaces := r.fqdnNamespaces
+	r.fqdnNamespaces = nil
+	return namespaces
+}
+
+// Start implements manager.Runnable, it rolls out the changes until the manager is stopped. Changes during a rollout
+// are rolled out afterwards. The filter list at the start is considered as rolled out, so that a restart or a change
+// of the leader does not roll it out again; the shoots pick up changes missed meanwhile with their next reconciliation.
+func (r *filterListRollout) Start(ctx context.Context) error {
+	if r.ready != nil {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.ready:
+		}
+	}
+
+	var filterListChanged <-chan struct{}
+	if r.notifier != nil {
+		filterListChanged = r.notifier.FilterListChanged()
+		r.rolledOut = r.notifier.FilterListChecksum()
+	}
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-filterListChanged:
+			err = r.rollout(ctx)
+		case <-r.fqdnChanged:
+			namespaces := r.takeFQDNNamespaces()
+			r.logger.Info("FQDN resolutions changed, rolling out", "namespaces", namespaces.Len())
+			err = r.triggerReconciliations(ctx, r.logger.WithValues("cause", "fqdnResolutions"), namespaces.Has)
+		}
+		if err != nil && ctx.Err() == nil {
+			r.logger.Error(err, "Failed to roll out change")
+		}
+	}
+}
+
+// rollout rolls out the current filter list unless it has been rolled out already.
+func (r *filterListRollout) rollout(ctx context.Context) error {
+	checksum := r.notifier.FilterListChecksum()
+	if checksum == r.rolledOut {
+		return nil
+	}
+
+	r.logger.Info("Filter list changed, rolling out", "checksum", checksum)
+	err := r.triggerReconciliations(ctx, r.logger.WithValues("cause", "filterList", "checksum", checksum), nil)
+	if ctx.Err() == nil {
+		r.rolledOut = checksum
+	}
+	return err
+}
+
+// triggerReconciliations triggers the reconciliation of the Extensions in the namespaces accepted by the given
+// function, or of all Extensions if it is nil, according to the rollout schedule.
+func (r *filterListRollout) triggerReconciliations(ctx context.Context, logger logr.Logger, inNamespace func(string) bool) error {
+	extensions, err := listExtensions(ctx, r.client, r.extensionClasses)
+	if err != nil {
+		return err
+	}
+	if inNamespace != nil {
+		extensions = slices.DeleteFunc(extensions, func(ex *extensionsv1alpha1.Extension) bool {
+			return !inNamespace(ex.Namespace)
+		})
+	}
+	schedule := rolloutSchedule(len(extensions), r.interval, r.maxJitter, randomJitter)
+	logger.Info("Triggering reconciliations", "extensions", len(extensions))
+
+	var (
+		start     = time.Now()
+		triggered int
+		errs      []error
+	)
+	for _, step := range schedule {
+		if err := sleepUntil(ctx, start.Add(step.delay)); err != nil {
+			return err
+		}
+
+		// the Extension is read again, as it may have changed since the start of the rollout
+		ex := &extensionsv1alpha1.Extension{}
+		if err := r.client.Get(ctx, client.ObjectKeyFromObject(extensions[step.index]), ex); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		ok, err := triggerExtensionReconciliation(ctx, r.client, ex)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if ok {
+			triggered++
+		}
+	}
+	logger.Info("Rolled out", "extensions", triggered, "duration", time.Since(start).Round(time.Second))
+	return errors.Join(errs...)
+}
+
+// rolloutStep is the delay of the reconciliation of an Extension relative to the start of the rollout.
+type rolloutStep struct {
+	index int
+	delay time.Duration
+}
+
+// rolloutSchedule returns the steps of the rollout of the given number of Extensions ordered by their delays. The
+// Extensions are triggered at the given interval, each delayed by a jitter up to maxJitter.
+func rolloutSchedule(count int, interval, maxJitter time.Duration, jitter func(time.Duration) time.Duration) []rolloutStep {
+	schedule := make([]rolloutStep, 0, count)
+	for i := range count {
+		schedule = append(schedule, rolloutStep{index: i, delay: time.Duration(i)*interval + jitter(maxJitter)})
+	}
+	slices.SortStableFunc(schedule, func(a, b rolloutStep) int {
+		return cmp.Compare(a.delay, b.delay)
+	})
+	return schedule
+}
+
+// randomJitter returns a random duration up to maxJitter.
+func randomJitter(maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+	return rand.N(maxJitter + 1) // #nosec G404 -- the jitter does not need a cryptographically secure random number
+}
+
+// sleepUntil waits until the given time or the context is cancelled.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
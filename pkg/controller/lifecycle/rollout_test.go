@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kubernetesclient "github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+type fakeChangeNotifier struct {
+	lock     sync.Mutex
+	checksum string
+	reads    int
+	changed  chan struct{}
+}
+
+func (n *fakeChangeNotifier) FilterListChecksum() string {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.reads++
+	return n.checksum
+}
+
+func (n *fakeChangeNotifier) setChecksum(checksum string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.checksum = checksum
+}
+
+func (n *fakeChangeNotifier) checksumReads() int {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.reads
+}
+
+func (n *fakeChangeNotifier) FilterListChanged() <-chan struct{} {
+	return n.changed
+}
+
+var _ = Describe("Filter list rollout", func() {
+	Describe("#newFilterListRollout", func() {
+		It("should use the default rate and jitter", func() {
+			r, err := newFilterListRollout(nil, nil, nil, nil, nil, logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.interval).To(Equal(3 * time.Second))
+			Expect(r.maxJitter).To(Equal(10 * time.Second))
+		})
+
+		It("should use the configured rate and jitter", func() {
+			r, err := newFilterListRollout(nil, nil, nil, nil, &config.FilterListRollout{
+				ExtensionsPerMinute: new(int32(120)),
+				MaxJitter:           &metav1.Duration{Duration: time.Second},
+			}, logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.interval).To(Equal(500 * time.Millisecond))
+			Expect(r.maxJitter).To(Equal(time.Second))
+		})
+
+		It("should not roll out filter list changes if the rollout is disabled", func() {
+			r, err := newFilterListRollout(nil, &fakeChangeNotifier{}, nil, nil, &config.FilterListRollout{Disabled: true}, logr.Discard())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.notifier).To(BeNil())
+		})
+
+		It("should reject an invalid configuration", func() {
+			_, err := newFilterListRollout(nil, nil, nil, nil, &config.FilterListRollout{ExtensionsPerMinute: new(int32(0))}, logr.Discard())
+			Expect(err).To(MatchError(ContainSubstring("extensionsPerMinute must be positive")))
+			_, err = newFilterListRollout(nil, nil, nil, nil, &config.FilterListRollout{MaxJitter: &metav1.Duration{Duration: -time.Second}}, logr.Discard())
+			Expect(err).To(MatchError(ContainSubstring("maxJitter must not be negative")))
+		})
+	})
+
+	Describe("#rolloutSchedule", func() {
+		It("should spread the Extensions at the interval", func() {
+			Expect(rolloutSchedule(3, time.Second, 0, randomJitter)).To(Equal([]rolloutStep{
+				{index: 0, delay: 0},
+				{index: 1, delay: time.Second},
+				{index: 2, delay: 2 * time.Second},
+			}))
+		})
+
+		It("should add the jitter and order the steps by their delays", func() {
+			jitters := []time.Duration{1500 * time.Millisecond, 0, 200 * time.Millisecond}
+			jitter := func(maxJitter time.Duration) time.Duration {
+				Expect(maxJitter).To(Equal(2 * time.Second))
+				j := jitters[0]
+				jitters = jitters[1:]
+				return j
+			}
+			Expect(rolloutSchedule(3, time.Second, 2*time.Second, jitter)).To(Equal([]rolloutStep{
+				{index: 1, delay: time.Second},
+				{index: 0, delay: 1500 * time.Millisecond},
+				{index: 2, delay: 2200 * time.Millisecond},
+			}))
+		})
+
+		It("should limit the random jitter", func() {
+			for range 100 {
+				Expect(randomJitter(time.Second)).To(And(BeNumerically(">=", 0), BeNumerically("<=", time.Second)))
+			}
+			Expect(randomJitter(0)).To(BeZero())
+		})
+	})
+
+	Describe("#rollout", func() {
+		var (
+			ctx      = context.Background()
+			c        client.Client
+			notifier *fakeChangeNotifier
+			r        *filterListRollout
+
+			extension = func(name string, class *extensionsv1alpha1.ExtensionClass) *extensionsv1alpha1.Extension {
+				return &extensionsv1alpha1.Extension{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shoot--foo--" + name},
+					Spec: extensionsv1alpha1.ExtensionSpec{
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: constants.ExtensionType, Class: class},
+					},
+				}
+			}
+			annotated = func(name string) bool {
+				ex := &extensionsv1alpha1.Extension{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: "shoot--foo--" + name}, ex)).To(Succeed())
+				return ex.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile
+			}
+		)
+
+		BeforeEach(func() {
+			seedClass := extensionsv1alpha1.ExtensionClass("seed")
+			other := extension("other", nil)
+			other.Spec.Type = "other"
+			c = fake.NewClientBuilder().WithScheme(kubernetesclient.SeedScheme).WithObjects(
+				extension("foo", nil),
+				extension("bar", nil),
+				extension("seed", &seedClass),
+				other,
+			).Build()
+			notifier = &fakeChangeNotifier{checksum: "v1", changed: make(chan struct{}, 1)}
+			r = &filterListRollout{
+				client:           c,
+				notifier:         notifier,
+				extensionClasses: []extensionsv1alpha1.ExtensionClass{extensionsv1alpha1.ExtensionClassShoot},
+				logger:           logr.Discard(),
+				fqdnChanged:      make(chan struct{}, 1),
+			}
+		})
+
+		It("should trigger the reconciliation of the Extensions of the handled classes", func() {
+			Expect(r.rollout(ctx)).To(Succeed())
+			Expect(annotated("foo")).To(BeTrue())
+			Expect(annotated("bar")).To(BeTrue())
+			Expect(annotated("seed")).To(BeFalse())
+			Expect(annotated("other")).To(BeFalse())
+			Expect(r.rolledOut).To(Equal("v1"))
+		})
+
+		It("should not roll out the same filter list again", func() {
+			r.rolledOut = "v1"
+			Expect(r.rollout(ctx)).To(Succeed())
+			Expect(annotated("foo")).To(BeFalse())
+		})
+
+		It("should stop the rollout when the context is cancelled", func() {
+			r.interval = time.Hour
+			cancelCtx, cancel := context.WithCancel(ctx)
+			cancel()
+			Expect(r.rollout(cancelCtx)).To(MatchError(context.Canceled))
+			Expect(r.rolledOut).To(BeEmpty())
+		})
+
+		It("should roll out the changes notified while running", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(r.Start(runCtx)).To(Succeed())
+			}()
+
+			// the filter list of the start is read before it changes
+			Eventually(notifier.checksumReads).Should(Equal(1))
+			notifier.setChecksum("v2")
+			notifier.changed <- struct{}{}
+			Eventually(func() bool { return annotated("foo") && annotated("bar") }).Should(BeTrue())
+			cancel()
+			Eventually(done).Should(BeClosed())
+			Expect(r.rolledOut).To(Equal("v2"))
+		})
+
+		It("should not roll out the filter list of the start again", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(r.Start(runCtx)).To(Succeed())
+			}()
+
+			notifier.changed <- struct{}{}
+			Consistently(func() bool { return annotated("foo") || annotated("bar") }).Should(BeFalse())
+			cancel()
+			Eventually(done).Should(BeClosed())
+			Expect(r.rolledOut).To(Equal("v1"))
+		})
+
+		It("should wait for the initial filter list", func() {
+			ready := make(chan struct{})
+			r.ready = ready
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(r.Start(runCtx)).To(Succeed())
+			}()
+
+			Consistently(notifier.checksumReads).Should(BeZero())
+			close(ready)
+			Eventually(notifier.checksumReads).Should(Equal(1))
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should roll out the FQDN changes notified while running", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(r.Start(runCtx)).To(Succeed())
+			}()
+
+			r.notifyFQDNChanged(sets.New("shoot--foo--foo"))
+			r.notifyFQDNChanged(sets.New("shoot--foo--seed"))
+			Eventually(func() bool { return annotated("foo") }).Should(BeTrue())
+			Consistently(func() bool { return annotated("bar") || annotated("seed") }).Should(BeFalse())
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("should not roll out FQDN changes without referencing Extensions", func() {
+			r.notifyFQDNChanged(sets.New[string]())
+			Expect(r.fqdnChanged).To(BeEmpty())
+		})
+
+		It("should roll out FQDN changes without filter list notifier", func() {
+			r.notifier = nil
+			runCtx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(r.Start(runCtx)).To(Succeed())
+			}()
+
+			r.notifyFQDNChanged(sets.New("shoot--foo--foo", "shoot--foo--bar"))
+			Eventually(func() bool { return annotated("foo") && annotated("bar") }).Should(BeTrue())
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+	controllerconfig "github.com/gardener/gardener-extension-shoot-networking-filter/pkg/controller/config"
+)
+
+const (
+	// RuntimeName is the name of the runtime networking filter controller.
+	RuntimeName = "runtime_networking_filter_controller"
+	// RuntimeManagedResourceName is the name of the ManagedResource deploying the egress filter to the runtime cluster.
+	RuntimeManagedResourceName = "networking-filter"
+
+	// runtimeResyncPeriod is the period in which the ManagedResource is reconciled, e.g. to pick up changed
+	// addresses of the protected endpoints.
+	runtimeResyncPeriod = time.Hour
+)
+
+// RuntimeAddOptions are options to apply when adding the runtime networking filter controller to the manager.
+type RuntimeAddOptions struct {
+	// ServiceConfig contains configuration for the policy filter.
+	ServiceConfig controllerconfig.Config
+	// Namespace is the namespace of the ManagedResource.
+	Namespace string
+	// ResourceClass is the class of the ManagedResource.
+	ResourceClass string
+}
+
+// AddRuntimeToManager adds the controller which deploys the egress filter to the runtime cluster to the given manager.
+// The ManagedResource is reconciled on start, whenever it is changed, whenever the downloaded filter list or the FQDN
+// resolutions change and periodically.
+func AddRuntimeToManager(mgr manager.Manager, opts RuntimeAddOptions) error {
+	if opts.ServiceConfig.EgressFilter == nil {
+		return fmt.Errorf("missing egressFilter configuration")
+	}
+
+	a, err := newActuator(mgr, opts.ServiceConfig.Configuration, opts.ServiceConfig.OAuth2Secret, opts.ServiceConfig.OAuth2Secrets, nil)
+	if err != nil {
+		return err
+	}
+
+	r := &runtimeReconciler{
+		actuator:      a,
+		namespace:     opts.Namespace,
+		resourceClass: opts.ResourceClass,
+	}
+
+	// The buffered channel holds at most one pending event, which is enough as there is only one object to reconcile.
+	events := make(chan event.GenericEvent, 1)
+	events <- r.event()
+	if notifier, ok := a.provider.(filterListChangeNotifier); ok {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			forwardFilterListChanges(ctx, notifier, events, r.event(), a.logger)
+			return nil
+		})); err != nil {
+			return err
+		}
+	}
+	if a.fqdnCache != nil {
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			a.fqdnCache.Run(ctx, fqdnRefreshInterval, func(context.Context, []string) {
+				a.logger.Info("FQDN resolutions changed, triggering reconciliation")
+				sendEvent(events, r.event())
+			})
+			return nil
+		})); err != nil {
+			return err
+		}
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named(RuntimeName).
+		For(&resourcesv1alpha1.ManagedResource{}, builder.WithPredicates(
+			predicate.NewPredicateFuncs(func(obj client.Object) bool {
+				return obj.GetNamespace() == r.namespace && obj.GetName() == RuntimeManagedResourceName
+			}),
+			predicate.GenerationChangedPredicate{},
+		)).
+		WatchesRawSource(source.Channel(events, &handler.EnqueueRequestForObject{})).
+		Complete(r)
+}
+
+// forwardFilterListChanges sends the event whenever the filter list of the notifier changes until the context is
+// cancelled.
+func forwardFilterListChanges(ctx context.Context, notifier filterListChangeNotifier, events chan<- event.GenericEvent, e event.GenericEvent, logger logr.Logger) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-notifier.FilterListChanged():
+			logger.Info("Filter list changed, triggering reconciliation", "checksum", notifier.FilterListChecksum())
+			sendEvent(events, e)
+		}
+	}
+}
+
+// sendEvent sends the event without blocking, a pending event already triggers the reconciliation.
+func sendEvent(events chan<- event.GenericEvent, e event.GenericEvent) {
+	select {
+	case events <- e:
+	default:
+	}
+}
+
+type runtimeReconciler struct {
+	actuator      *actuator
+	namespace     string
+	resourceClass string
+}
+
+func (r *runtimeReconciler) event() event.GenericEvent {
+	return event.GenericEvent{Object: &resourcesv1alpha1.ManagedResource{
+		ObjectMeta: metav1.ObjectMeta{Name: RuntimeManagedResourceName, Namespace: r.namespace},
+	}}
+}
+
+// Reconcile generates the filter lists like for the Extensions of the seed and garden runtime clusters and
+// deploys the egress filter with them.
+func (r *runtimeReconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	var (
+		a                  = r.actuator
+		egressFilter       = a.serviceConfig.EgressFilter
+		blackholingEnabled = egressFilter.BlackholingEnabled
+		sleepDuration      = "1h"
+		enforcement        = enforcementMode(egressFilter, nil)
+		evaluation         = policyEvaluation(egressFilter, nil)
+		status             = &config.EgressFilterStatus{Source: config.FilterListSourceNone, Mode: config.FilterModeBlockList, PolicyEvaluation: evaluation, EnforcementMode: enforcement}
+	)
+
+	if err := a.waitForFilterListProvider(ctx); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if egressFilter.SleepDuration != nil {
+		sleepDuration = egressFilter.SleepDuration.Duration.String()
+	}
+
+	// The runtime cluster is never filtered in mode allowList to keep it operable, and its egress filter applier cannot
+	// audit entries, so they are ignored
+	secretData, err := a.readAndRestrictFilterListSecretData(ctx, nil, r.namespace, config.FilterModeBlockList, evaluation, enforcement, nil, egressFilter.TagFilters, nil, nil, nil, status)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	secretData = ignoreAuditedLists(secretData, a.logger, status)
+
+	endpoints, err := a.protectedEndpoints(ctx, nil)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	secretData = protectConnectivity(secretData, resolveProtectedEndpoints(ctx, a.fqdnCache, endpoints, a.logger), a.logger, status)
+
+	if _, ok := secretData[constants.KeyPortList]; ok && !applierPortFilteringSupported() {
+		a.logger.Info("Ignoring port- and protocol-scoped filter entries, the egress filter applier does not support them")
+		delete(secretData, constants.KeyPortList)
+		status.PortScopedEntriesIgnored = true
+	}
+	if _, ok := secretData[constants.KeyPortList]; ok && !isFirewallModeUsed(blackholingEnabled, nil) {
+		a.logger.Info("Ignoring port- and protocol-scoped filter entries, blackhole routes cannot express them")
+		delete(secretData, constants.KeyPortList)
+		status.PortScopedEntriesIgnored = true
+	}
+
+	// The blocked connection exporter is only deployed for Extensions
+	secretData, _ = splitFilterEntries(secretData)
+
+	shootResources, err := GetShootResources(blackholingEnabled, sleepDuration, constants.NamespaceKubeSystem, secretData)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := managedresources.Create(ctx, a.client, r.namespace, RuntimeManagedResourceName, nil, true, r.resourceClass, shootResources, ptr.To(false), nil, nil); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create or update managed resource: %w", err)
+	}
+
+	logStatus(a.logger, status)
+	return reconcile.Result{RequeueAfter: runtimeResyncPeriod}, nil
+}
+
+// logStatus logs the status of the egress filter, as there is no Extension to report it in the runtime cluster.
+func logStatus(logger logr.Logger, status *config.EgressFilterStatus) {
+	logger.Info("Egress filter deployed",
+		"source", status.Source,
+		"droppedPrivateEntries", status.DroppedPrivateEntriesCount,
+		"connectivityCarveOuts", len(status.ConnectivityCarveOuts),
+		"portScopedEntriesIgnored", status.PortScopedEntriesIgnored,
+		"ignoredAuditedEntries", status.IgnoredAuditedEntries,
+	)
+}
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Runtime networking filter", func() {
+	Describe("#forwardFilterListChanges", func() {
+		It("should send the event when the filter list changes", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+
+			var (
+				notifier = &fakeChangeNotifier{checksum: "v1", changed: make(chan struct{}, 1)}
+				events   = make(chan event.GenericEvent, 1)
+				e        = event.GenericEvent{Object: &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "networking-filter"}}}
+				done     = make(chan struct{})
+			)
+			go func() {
+				defer close(done)
+				forwardFilterListChanges(ctx, notifier, events, e, logr.Discard())
+			}()
+
+			notifier.changed <- struct{}{}
+			Eventually(events).Should(Receive(Equal(e)))
+
+			// a pending event already triggers the reconciliation
+			events <- e
+			notifier.changed <- struct{}{}
+			Eventually(notifier.changed).Should(BeEmpty())
+			Consistently(done).ShouldNot(BeClosed())
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+const (
+	// SecretControllerName is the name of the controller triggering the reconciliation of Extensions whose filter list
+	// secrets changed.
+	SecretControllerName = "shoot_networking_filter_secret_controller"
+
+	// projectSecretPrefix is the prefix Gardener adds to the names of the resources of the shoot synced to the seed.
+	projectSecretPrefix = "ref-"
+)
+
+// addSecretController adds a controller which triggers the reconciliation of the Extensions referencing a filter list
+// secret when its data changes. Project filter list secrets are watched in the seed, changes of shoot filter list
+// secrets are received from the shoot clusters as generic events of their Cluster resources. Only the metadata of the
+// project filter list secrets is watched, so that the seed secrets are not cached, see the cache options of the manager.
+func addSecretController(mgr manager.Manager, decoder runtime.Decoder, shootEvents <-chan event.GenericEvent) error {
+	r := &secretReconciler{
+		client:  mgr.GetClient(),
+		decoder: decoder,
+		logger:  log.Log.WithName(SecretControllerName),
+	}
+
+	return builder.ControllerManagedBy(mgr).
+		Named(SecretControllerName).
+		WatchesRawSource(source.Kind[*metav1.PartialObjectMetadata](
+			mgr.GetCache(),
+			secretMetadata(),
+			handler.TypedEnqueueRequestsFromMapFunc(r.mapProjectSecretToExtensions),
+			projectSecretPredicate(),
+		)).
+		WatchesRawSource(source.Channel(shootEvents, handler.EnqueueRequestsFromMapFunc(r.mapClusterToExtensions))).
+		Complete(r)
+}
+
+// secretMetadata returns the metadata only object of a secret.
+func secretMetadata() *metav1.PartialObjectMetadata {
+	secret := &metav1.PartialObjectMetadata{}
+	secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+	return secret
+}
+
+// projectSecretPredicate selects the secrets synced from the resources of the shoots which changed. As only their
+// metadata is watched, changes of the data cannot be told apart from changes of the metadata, the resync of unchanged
+// secrets is skipped though. Secrets of the initial list are skipped, changes missed while the controller was not
+// running are picked up by the resync of the Extensions.
+func projectSecretPredicate() predicate.TypedPredicate[*metav1.PartialObjectMetadata] {
+	return predicate.TypedFuncs[*metav1.PartialObjectMetadata]{
+		CreateFunc: func(e event.TypedCreateEvent[*metav1.PartialObjectMetadata]) bool {
+			return !e.IsInInitialList && strings.HasPrefix(e.Object.Name, projectSecretPrefix)
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[*metav1.PartialObjectMetadata]) bool {
+			return strings.HasPrefix(e.ObjectNew.Name, projectSecretPrefix) && e.ObjectOld.ResourceVersion != e.ObjectNew.ResourceVersion
+		},
+		DeleteFunc: func(e event.TypedDeleteEvent[*metav1.PartialObjectMetadata]) bool {
+			return strings.HasPrefix(e.Object.Name, projectSecretPrefix)
+		},
+		GenericFunc: func(event.TypedGenericEvent[*metav1.PartialObjectMetadata]) bool {
+			return false
+		},
+	}
+}
+
+// secretDataChanged returns whether the data of the secret changed.
+func secretDataChanged(oldSecret, newSecret *corev1.Secret) bool {
+	return !reflect.DeepEqual(oldSecret.Data, newSecret.Data)
+}
+
+type secretReconciler struct {
+	client  client.Client
+	decoder runtime.Decoder
+	logger  logr.Logger
+}
+
+// Reconcile triggers the reconciliation of the Extension.
+func (r *secretReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	ex := &extensionsv1alpha1.Extension{}
+	if err := r.client.Get(ctx, request.NamespacedName, ex); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	triggered, err := triggerExtensionReconciliation(ctx, r.client, ex)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if triggered {
+		r.logger.Info("Filter list secret changed, triggered reconciliation", "extension", request.NamespacedName)
+	}
+	return reconcile.Result{}, nil
+}
+
+// mapProjectSecretToExtensions returns the Extensions in the namespace of the secret referencing it as project filter
+// list source.
+func (r *secretReconciler) mapProjectSecretToExtensions(ctx context.Context, secret *metav1.PartialObjectMetadata) []reconcile.Request {
+	return r.extensionRequests(ctx, secret.Namespace, func(ex *extensionsv1alpha1.Extension) bool {
+		return projectSecretName(r.decoder, ex) == secret.Name
+	})
+}
+
+// mapClusterToExtensions returns the Extensions in the namespace of the shoot of the Cluster.
+func (r *secretReconciler) mapClusterToExtensions(ctx context.Context, cluster client.Object) []reconcile.Request {
+	return r.extensionRequests(ctx, cluster.GetName(), func(*extensionsv1alpha1.Extension) bool {
+		return true
+	})
+}
+
+// extensionRequests returns the requests of the Extensions of this extension type in the namespace matching the filter.
+func (r *secretReconciler) extensionRequests(ctx context.Context, namespace string, filter func(*extensionsv1alpha1.Extension) bool) []reconcile.Request {
+	extensionList := &extensionsv1alpha1.ExtensionList{}
+	if err := r.client.List(ctx, extensionList, client.InNamespace(namespace)); err != nil {
+		r.logger.Error(err, "Failed to list extensions", "namespace", namespace)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range extensionList.Items {
+		ex := &extensionList.Items[i]
+		if ex.Spec.Type == constants.ExtensionType && filter(ex) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ex)})
+		}
+	}
+	return requests
+}
+
+// projectSecretName returns the name of the secret synced to the seed for the project filter list source of the
+// Extension or an empty string if it has none.
+func projectSecretName(decoder runtime.Decoder, ex *extensionsv1alpha1.Extension) string {
+	if ex.Spec.ProviderConfig == nil {
+		return ""
+	}
+	shootConfig := &v1alpha1.Configuration{}
+	if _, _, err := decoder.Decode(ex.Spec.ProviderConfig.Raw, nil, shootConfig); err != nil {
+		return ""
+	}
+	if shootConfig.EgressFilter == nil || shootConfig.EgressFilter.ProjectFilterListSource == nil {
+		return ""
+	}
+	return projectSecretPrefix + shootConfig.EgressFilter.ProjectFilterListSource.Name
+}
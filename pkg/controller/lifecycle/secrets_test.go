@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kubernetesclient "github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Filter list secrets", func() {
+	var (
+		ctx     = context.Background()
+		decoder runtime.Decoder
+
+		extension = func(name, providerConfig string) *extensionsv1alpha1.Extension {
+			ex := &extensionsv1alpha1.Extension{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "shoot--foo--bar"},
+				Spec: extensionsv1alpha1.ExtensionSpec{
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: constants.ExtensionType},
+				},
+			}
+			if providerConfig != "" {
+				ex.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(providerConfig)}
+			}
+			return ex
+		}
+		projectSourceConfig = `{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"Configuration","egressFilter":{"projectFilterListSource":{"name":"filter-list","key":"filterList"}}}`
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		decoder = serializer.NewCodecFactory(scheme).UniversalDecoder()
+	})
+
+	Describe("#projectSecretPredicate", func() {
+		var (
+			predicate = projectSecretPredicate()
+			secret    = &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "ref-filter-list", Namespace: "shoot--foo--bar", ResourceVersion: "1"}}
+			other     = &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "filter-list", Namespace: "shoot--foo--bar", ResourceVersion: "1"}}
+		)
+
+		It("should select created secrets synced from the shoot resources", func() {
+			Expect(predicate.Create(event.TypedCreateEvent[*metav1.PartialObjectMetadata]{Object: secret})).To(BeTrue())
+			Expect(predicate.Create(event.TypedCreateEvent[*metav1.PartialObjectMetadata]{Object: secret, IsInInitialList: true})).To(BeFalse())
+			Expect(predicate.Create(event.TypedCreateEvent[*metav1.PartialObjectMetadata]{Object: other})).To(BeFalse())
+		})
+
+		It("should select changed secrets synced from the shoot resources", func() {
+			changed := secret.DeepCopy()
+			changed.ResourceVersion = "2"
+			otherChanged := other.DeepCopy()
+			otherChanged.ResourceVersion = "2"
+
+			Expect(predicate.Update(event.TypedUpdateEvent[*metav1.PartialObjectMetadata]{ObjectOld: secret, ObjectNew: changed})).To(BeTrue())
+			Expect(predicate.Update(event.TypedUpdateEvent[*metav1.PartialObjectMetadata]{ObjectOld: secret, ObjectNew: secret.DeepCopy()})).To(BeFalse())
+			Expect(predicate.Update(event.TypedUpdateEvent[*metav1.PartialObjectMetadata]{ObjectOld: other, ObjectNew: otherChanged})).To(BeFalse())
+		})
+
+		It("should select deleted secrets synced from the shoot resources", func() {
+			Expect(predicate.Delete(event.TypedDeleteEvent[*metav1.PartialObjectMetadata]{Object: secret})).To(BeTrue())
+			Expect(predicate.Delete(event.TypedDeleteEvent[*metav1.PartialObjectMetadata]{Object: other})).To(BeFalse())
+		})
+	})
+
+	Describe("#projectSecretName", func() {
+		It("should return the name of the synced secret of the project filter list source", func() {
+			Expect(projectSecretName(decoder, extension("filter", projectSourceConfig))).To(Equal("ref-filter-list"))
+		})
+
+		It("should return an empty name without project filter list source", func() {
+			Expect(projectSecretName(decoder, extension("filter", ""))).To(BeEmpty())
+			Expect(projectSecretName(decoder, extension("filter", `{"apiVersion":"shoot-networking-filter.extensions.config.gardener.cloud/v1alpha1","kind":"Configuration"}`))).To(BeEmpty())
+		})
+	})
+
+	Describe("#secretReconciler", func() {
+		var (
+			c client.Client
+			r *secretReconciler
+		)
+
+		BeforeEach(func() {
+			other := extension("other", projectSourceConfig)
+			other.Spec.Type = "other"
+			c = fake.NewClientBuilder().WithScheme(kubernetesclient.SeedScheme).WithObjects(
+				extension("filter", projectSourceConfig),
+				extension("unreferenced", ""),
+				other,
+			).Build()
+			r = &secretReconciler{client: c, decoder: decoder, logger: logr.Discard()}
+		})
+
+		It("should map project secrets to the Extensions referencing them", func() {
+			Expect(r.mapProjectSecretToExtensions(ctx, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "ref-filter-list", Namespace: "shoot--foo--bar"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: client.ObjectKey{Name: "filter", Namespace: "shoot--foo--bar"}},
+			))
+			Expect(r.mapProjectSecretToExtensions(ctx, &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "ref-other", Namespace: "shoot--foo--bar"}})).To(BeEmpty())
+		})
+
+		It("should map Clusters to the Extensions in the namespace of the shoot", func() {
+			Expect(r.mapClusterToExtensions(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: client.ObjectKey{Name: "filter", Namespace: "shoot--foo--bar"}},
+				reconcile.Request{NamespacedName: client.ObjectKey{Name: "unreferenced", Namespace: "shoot--foo--bar"}},
+			))
+		})
+
+		It("should trigger the reconciliation of the Extension", func() {
+			key := client.ObjectKey{Name: "filter", Namespace: "shoot--foo--bar"}
+			Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: key})).To(Equal(reconcile.Result{}))
+
+			ex := &extensionsv1alpha1.Extension{}
+			Expect(c.Get(ctx, key, ex)).To(Succeed())
+			Expect(ex.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		})
+
+		It("should ignore deleted Extensions", func() {
+			Expect(r.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "deleted", Namespace: "shoot--foo--bar"}})).To(Equal(reconcile.Result{}))
+		})
+	})
+})
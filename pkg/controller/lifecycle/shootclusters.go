@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	extensionsconfig "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener/extensions/pkg/util"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+const (
+	// shootClusterSyncTimeout is the maximum time to wait for the cache of a shoot cluster to be synced.
+	shootClusterSyncTimeout = time.Minute
+	// shootClusterMaxAge is the maximum age of a shoot cluster, older ones are restarted on the next reconciliation
+	// to use the current credentials of the shoot.
+	shootClusterMaxAge = time.Hour
+)
+
+// shootClusterCache keeps a cluster for every shoot with a shoot filter list source, whose cache only contains the
+// referenced secret. The secret is watched instead of being read with a new client on every reconciliation, changes
+// of its data are sent as generic events of the Cluster resource of the shoot.
+type shootClusterCache struct {
+	lock     sync.Mutex
+	clusters map[string]*shootCluster
+	events   chan event.GenericEvent
+	logger   logr.Logger
+
+	// pending contains the namespaces of the shoots whose changes have not been sent yet. The changes are coalesced per
+	// namespace and sent by Start, so that the event handlers of the shoot clusters never block.
+	pending sets.Set[string]
+	wakeup  chan struct{}
+}
+
+type shootCluster struct {
+	secret  client.ObjectKey
+	client  client.Client
+	cancel  context.CancelFunc
+	started time.Time
+}
+
+func newShootClusterCache(logger logr.Logger) *shootClusterCache {
+	return &shootClusterCache{
+		clusters: map[string]*shootCluster{},
+		events:   make(chan event.GenericEvent, 100),
+		logger:   logger,
+		pending:  sets.New[string](),
+		wakeup:   make(chan struct{}, 1),
+	}
+}
+
+// Start implements manager.Runnable, it sends the changes of the shoots until the manager is stopped and stops the
+// shoot clusters afterwards.
+func (c *shootClusterCache) Start(ctx context.Context) error {
+	c.sendEvents(ctx)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for namespace, cl := range c.clusters {
+		cl.cancel()
+		delete(c.clusters, namespace)
+	}
+	return nil
+}
+
+// get returns a client reading the secret from the cache of the cluster of the shoot in the given namespace. The
+// cluster is started on first use and restarted if another secret is referenced or it exceeded its maximum age.
+func (c *shootClusterCache) get(ctx context.Context, seedClient client.Client, namespace string, secret client.ObjectKey) (client.Client, error) {
+	c.lock.Lock()
+	if cl, ok := c.clusters[namespace]; ok && cl.secret == secret && time.Since(cl.started) < shootClusterMaxAge {
+		c.lock.Unlock()
+		return cl.client, nil
+	}
+	c.lock.Unlock()
+
+	cl, err := c.start(ctx, seedClient, namespace, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if existing, ok := c.clusters[namespace]; ok {
+		existing.cancel()
+	}
+	c.clusters[namespace] = cl
+	return cl.client, nil
+}
+
+// start starts a cluster for the shoot in the given namespace caching the secret and waits until its cache is synced.
+func (c *shootClusterCache) start(ctx context.Context, seedClient client.Client, namespace string, secret client.ObjectKey) (*shootCluster, error) {
+	restConfig, _, err := util.NewClientForShoot(ctx, seedClient, namespace, client.Options{}, extensionsconfig.RESTOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shoot client: %w", err)
+	}
+
+	logger := c.logger.WithValues("namespace", namespace)
+	cl, err := cluster.New(restConfig, func(opts *cluster.Options) {
+		opts.Logger = logger
+		opts.Cache.ByObject = map[client.Object]cache.ByObject{
+			&corev1.Secret{}: {
+				Namespaces: map[string]cache.Config{secret.Namespace: {}},
+				Field:      fields.OneTermEqualSelector(metav1.ObjectNameField, secret.Name),
+			},
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create shoot cluster: %w", err)
+	}
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		if err := cl.Start(runCtx); err != nil {
+			logger.Error(err, "Shoot cluster stopped")
+		}
+	}()
+
+	syncCtx, cancelSync := context.WithTimeout(ctx, shootClusterSyncTimeout)
+	defer cancelSync()
+	informer, err := cl.GetCache().GetInformer(syncCtx, &corev1.Secret{})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to watch secret %s in shoot cluster: %w", secret, err)
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(_ any, isInInitialList bool) {
+			if !isInInitialList {
+				c.notify(namespace)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldSecret, oldOK := oldObj.(*corev1.Secret)
+			newSecret, newOK := newObj.(*corev1.Secret)
+			if oldOK && newOK && secretDataChanged(oldSecret, newSecret) {
+				c.notify(namespace)
+			}
+		},
+		DeleteFunc: func(any) {
+			c.notify(namespace)
+		},
+	}); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to watch secret %s in shoot cluster: %w", secret, err)
+	}
+
+	return &shootCluster{secret: secret, client: cl.GetClient(), cancel: cancel, started: time.Now()}, nil
+}
+
+// notify records a change of the shoot in the given namespace to be sent by sendEvents without blocking.
+func (c *shootClusterCache) notify(namespace string) {
+	c.logger.Info("Shoot filter list secret changed", "namespace", namespace)
+
+	c.lock.Lock()
+	c.pending.Insert(namespace)
+	c.lock.Unlock()
+
+	select {
+	case c.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// sendEvents sends a generic event of the Cluster resource of every shoot with pending changes until the context is
+// cancelled.
+func (c *shootClusterCache) sendEvents(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.wakeup:
+		}
+
+		c.lock.Lock()
+		namespaces := sets.List(c.pending)
+		c.pending.Clear()
+		c.lock.Unlock()
+
+		for _, namespace := range namespaces {
+			select {
+			case <-ctx.Done():
+				return
+			case c.events <- event.GenericEvent{Object: &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace}}}:
+			}
+		}
+	}
+}
+
+// release stops the cluster of the shoot in the given namespace.
+func (c *shootClusterCache) release(namespace string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if cl, ok := c.clusters[namespace]; ok {
+		cl.cancel()
+		delete(c.clusters, namespace)
+	}
+}
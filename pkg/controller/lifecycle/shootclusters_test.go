@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Shoot clusters", func() {
+	Describe("#notify", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+			c      *shootClusterCache
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			DeferCleanup(func() { cancel() })
+			c = newShootClusterCache(logr.Discard())
+		})
+
+		It("should not block if the events are not received", func() {
+			for range 2 * cap(c.events) {
+				c.notify("shoot--foo--bar")
+			}
+			Expect(c.events).To(BeEmpty())
+		})
+
+		It("should coalesce the changes of a shoot", func() {
+			c.notify("shoot--foo--bar")
+			c.notify("shoot--foo--baz")
+			c.notify("shoot--foo--bar")
+
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				defer close(done)
+				Expect(c.Start(ctx)).To(Succeed())
+			}()
+
+			var namespaces []string
+			for range 2 {
+				var e event.GenericEvent
+				Eventually(c.events).Should(Receive(&e))
+				namespaces = append(namespaces, e.Object.GetName())
+			}
+			Expect(namespaces).To(ConsistOf("shoot--foo--bar", "shoot--foo--baz"))
+			Consistently(c.events).ShouldNot(Receive())
+
+			c.notify("shoot--foo--bar")
+			Eventually(c.events).Should(Receive())
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
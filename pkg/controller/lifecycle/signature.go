@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/metrics"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/signature"
+)
+
+// signatureSuffix is appended to the endpoint or secret key of a filter list to locate its detached signature.
+const signatureSuffix = ".sig"
+
+// signatureError is returned if a filter list is rejected because of a missing or invalid signature.
+type signatureError struct {
+	err error
+}
+
+func (e *signatureError) Error() string {
+	return e.err.Error()
+}
+
+func (e *signatureError) Unwrap() error {
+	return e.err
+}
+
+func isSignatureError(err error) bool {
+	var sigErr *signatureError
+	return errors.As(err, &sigErr)
+}
+
+// signatureVerificationReporter is implemented by filter list providers which verify the signatures of their filter lists.
+type signatureVerificationReporter interface {
+	// signatureVerificationFailures returns the sources whose latest filter list was rejected.
+	signatureVerificationFailures() []config.SignatureVerificationFailure
+}
+
+// NewSignatureVerifier creates a verifier with the trusted keys of the signature verification configuration.
+// It returns nil if signature verification is not configured.
+func NewSignatureVerifier(signatureVerification *config.SignatureVerification) (*signature.Verifier, error) {
+	if signatureVerification == nil {
+		return nil, nil
+	}
+	if len(signatureVerification.TrustedKeys) == 0 {
+		return nil, fmt.Errorf("missing egressFilter.signatureVerification.trustedKeys")
+	}
+	verifier := signature.NewVerifier()
+	for _, key := range signatureVerification.TrustedKeys {
+		if err := verifier.AddKey(key.Name, []byte(key.PublicKey)); err != nil {
+			return nil, fmt.Errorf("invalid egressFilter.signatureVerification.trustedKeys: %w", err)
+		}
+	}
+	return verifier, nil
+}
+
+// verifyFilterListSignature verifies the detached signature of the filter list data of the given source,
+// reports the result in the metrics and returns the name of the trusted key which created the signature.
+func verifyFilterListSignature(verifier *signature.Verifier, source string, data, sig []byte) (string, error) {
+	keyName, err := verifier.Verify(data, sig)
+	switch {
+	case err == nil:
+		metrics.ReportSignatureVerification(source, "valid")
+		return keyName, nil
+	case errors.Is(err, signature.ErrMissingSignature):
+		metrics.ReportSignatureVerification(source, "missing")
+	default:
+		metrics.ReportSignatureVerification(source, "invalid")
+	}
+	return "", &signatureError{fmt.Errorf("filter list of source %q rejected: %w", source, err)}
+}
+
+// recordSignatureVerificationFailure records a filter list rejected because of a missing or invalid signature.
+func recordSignatureVerificationFailure(status *config.EgressFilterStatus, source string, err error) {
+	if status == nil || status.SignatureVerification == nil {
+		return
+	}
+	status.SignatureVerification.Failures = append(status.SignatureVerification.Failures,
+		config.SignatureVerificationFailure{Source: source, Reason: err.Error()})
+}
@@ -0,0 +1,243 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config/v1alpha1"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// maxDroppedPrivateEntries is the maximum number of dropped private networks listed in the status.
+const maxDroppedPrivateEntries = 20
+
+// recordDroppedPrivateEntry records a blocked network dropped because of an overlap with a private or reserved range.
+func recordDroppedPrivateEntry(status *config.EgressFilterStatus, network string) {
+	if status == nil {
+		return
+	}
+	status.DroppedPrivateEntriesCount++
+	if len(status.DroppedPrivateEntries) < maxDroppedPrivateEntries {
+		status.DroppedPrivateEntries = append(status.DroppedPrivateEntries, network)
+	}
+}
+
+// recordLoadBalancerCarveOuts records the number of networks of the original filter lists which were split
+// to keep load balancer IPs reachable.
+func recordLoadBalancerCarveOuts(status *config.EgressFilterStatus, original, filtered map[string][]byte) {
+	if status == nil {
+		return
+	}
+	status.IPv4.LoadBalancerCarveOuts = sets.New(plainYamlListEntries(original[constants.KeyIPV4List])...).
+		Difference(sets.New(plainYamlListEntries(filtered[constants.KeyIPV4List])...)).Len()
+	status.IPv6.LoadBalancerCarveOuts = sets.New(plainYamlListEntries(original[constants.KeyIPV6List])...).
+		Difference(sets.New(plainYamlListEntries(filtered[constants.KeyIPV6List])...)).Len()
+}
+
+// plainYamlListEntries returns the entries of a list rendered by convertToPlainYamlList.
+func plainYamlListEntries(data []byte) []string {
+	var result []string
+	for line := range strings.SplitSeq(string(data), "\n") {
+		if entry, ok := strings.CutPrefix(strings.TrimSpace(line), "- "); ok && entry != "" {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// renderedFilterLists contains the sorted hashes of the entries of rendered filter lists. Only the hashes are kept, as
+// they suffice to count the differences to the next filter lists and need a fraction of the memory of the entries.
+type renderedFilterLists struct {
+	ipv4       []uint64
+	ipv6       []uint64
+	namespaces sets.Set[string]
+}
+
+// renderedFilterListCache keeps the filter lists rendered for the extensions by checksum, so that the difference to the
+// previous reconciliation can be reported. Extensions with identical filter lists share the same entry.
+type renderedFilterListCache struct {
+	lock        sync.Mutex
+	byChecksum  map[string]*renderedFilterLists
+	byNamespace map[string]string
+}
+
+func newRenderedFilterListCache() *renderedFilterListCache {
+	return &renderedFilterListCache{
+		byChecksum:  map[string]*renderedFilterLists{},
+		byNamespace: map[string]string{},
+	}
+}
+
+// get returns the filter lists with the given checksum or nil if they are unknown.
+func (c *renderedFilterListCache) get(checksum string) *renderedFilterLists {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.byChecksum[checksum]
+}
+
+// set stores the filter lists rendered for the extension in the given namespace.
+func (c *renderedFilterListCache) set(namespace, checksum string, ipv4, ipv6 []uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.byNamespace[namespace] == checksum {
+		return
+	}
+	c.release(namespace)
+
+	lists, ok := c.byChecksum[checksum]
+	if !ok {
+		lists = &renderedFilterLists{
+			ipv4:       ipv4,
+			ipv6:       ipv6,
+			namespaces: sets.New[string](),
+		}
+		c.byChecksum[checksum] = lists
+	}
+	lists.namespaces.Insert(namespace)
+	c.byNamespace[namespace] = checksum
+}
+
+// delete removes the filter lists of the extension in the given namespace.
+func (c *renderedFilterListCache) delete(namespace string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.release(namespace)
+}
+
+func (c *renderedFilterListCache) release(namespace string) {
+	checksum, ok := c.byNamespace[namespace]
+	if !ok {
+		return
+	}
+	delete(c.byNamespace, namespace)
+
+	if lists, ok := c.byChecksum[checksum]; ok {
+		lists.namespaces.Delete(namespace)
+		if lists.namespaces.Len() == 0 {
+			delete(c.byChecksum, checksum)
+		}
+	}
+}
+
+// computeFilterListStatistics sets the checksum, the number of entries and the differences to the previous filter lists
+// in the given status and returns the sorted hashes of the entries. The differences are left unset if the previous
+// filter lists are unknown.
+func computeFilterListStatistics(status *config.EgressFilterStatus, secretData map[string][]byte, previousChecksum string, previous *renderedFilterLists) (ipv4, ipv6 []uint64) {
+	ipv4Entries := plainYamlListEntries(secretData[constants.KeyIPV4List])
+	ipv6Entries := plainYamlListEntries(secretData[constants.KeyIPV6List])
+	ipv4, ipv6 = entryHashes(ipv4Entries), entryHashes(ipv6Entries)
+
+	status.Checksum = utils.ComputeSecretChecksum(secretData)
+	status.IPv4.Entries = len(ipv4Entries)
+	status.IPv6.Entries = len(ipv6Entries)
+	status.PortScopedRules = len(plainYamlListEntries(secretData[constants.KeyPortList]))
+	status.Audit = nil
+	if _, ok := secretData[constants.KeyIPV4AuditList]; ok {
+		status.Audit = &config.AuditStatistics{
+			IPv4Entries:     len(plainYamlListEntries(secretData[constants.KeyIPV4AuditList])),
+			IPv6Entries:     len(plainYamlListEntries(secretData[constants.KeyIPV6AuditList])),
+			PortScopedRules: len(plainYamlListEntries(secretData[constants.KeyPortAuditList])),
+		}
+	}
+
+	switch {
+	case previousChecksum == status.Checksum:
+		status.IPv4.Added, status.IPv4.Removed = new(0), new(0)
+		status.IPv6.Added, status.IPv6.Removed = new(0), new(0)
+	case previous != nil:
+		added, removed := hashDifferences(ipv4, previous.ipv4)
+		status.IPv4.Added, status.IPv4.Removed = new(added), new(removed)
+		added, removed = hashDifferences(ipv6, previous.ipv6)
+		status.IPv6.Added, status.IPv6.Removed = new(added), new(removed)
+	}
+
+	return ipv4, ipv6
+}
+
+// entryHashes returns the sorted and deduplicated hashes of the given entries.
+func entryHashes(entries []string) []uint64 {
+	hashes := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(entry))
+		hashes = append(hashes, h.Sum64())
+	}
+	slices.Sort(hashes)
+	return slices.Clip(slices.Compact(hashes))
+}
+
+// hashDifferences returns the number of hashes only contained in current and only contained in previous. Both must be
+// sorted.
+func hashDifferences(current, previous []uint64) (added, removed int) {
+	var i, j int
+	for i < len(current) && j < len(previous) {
+		switch {
+		case current[i] < previous[j]:
+			added++
+			i++
+		case current[i] > previous[j]:
+			removed++
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return added + len(current) - i, removed + len(previous) - j
+}
+
+// updateStatus completes the given status with the statistics of the rendered filter lists and reports it in the
+// providerStatus of the Extension.
+func (a *actuator) updateStatus(ctx context.Context, ex *extensionsv1alpha1.Extension, status *config.EgressFilterStatus, secretData map[string][]byte) error {
+	previousChecksum := a.getPreviousChecksum(ex)
+	ipv4, ipv6 := computeFilterListStatistics(status, secretData, previousChecksum, a.renderedFilterLists.get(previousChecksum))
+	a.renderedFilterLists.set(ex.GetNamespace(), status.Checksum, ipv4, ipv6)
+
+	a.logger.Info("effective filter list", "namespace", ex.GetNamespace(), "source", status.Source, "checksum", status.Checksum,
+		constants.KeyIPV4List, status.IPv4.Entries, constants.KeyIPV6List, status.IPv6.Entries, "droppedPrivateEntries", status.DroppedPrivateEntriesCount)
+
+	providerStatus := &v1alpha1.EgressFilterStatus{}
+	if err := a.scheme.Convert(status, providerStatus, nil); err != nil {
+		return fmt.Errorf("failed to convert provider status: %w", err)
+	}
+	providerStatus.SetGroupVersionKind(v1alpha1.SchemeGroupVersion.WithKind("EgressFilterStatus"))
+
+	patch := client.MergeFrom(ex.DeepCopy())
+	ex.Status.ProviderStatus = &runtime.RawExtension{Object: providerStatus}
+	if err := a.client.Status().Patch(ctx, ex, patch); err != nil {
+		return fmt.Errorf("failed to update provider status: %w", err)
+	}
+	return nil
+}
+
+// getPreviousChecksum returns the checksum of the filter lists reported in the providerStatus of the Extension.
+func (a *actuator) getPreviousChecksum(ex *extensionsv1alpha1.Extension) string {
+	if ex.Status.ProviderStatus == nil || ex.Status.ProviderStatus.Raw == nil {
+		return ""
+	}
+
+	previous := &v1alpha1.EgressFilterStatus{}
+	if _, _, err := a.decoder.Decode(ex.Status.ProviderStatus.Raw, nil, previous); err != nil {
+		a.logger.Info("failed to decode previous provider status, ignoring it", "namespace", ex.GetNamespace(), "err", err)
+		return ""
+	}
+	return previous.Checksum
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"net"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+var _ = Describe("Status", func() {
+	var logger = logr.Discard()
+
+	Describe("#generateEgressFilterValuesWithStatus", func() {
+		It("should record dropped private networks and carve-outs", func() {
+			status := &config.EgressFilterStatus{}
+			filterList := []config.Filter{
+				{Network: "10.0.1.0/16", Policy: config.PolicyBlockAccess},
+				{Network: "fe80::/9", Policy: config.PolicyBlockAccess},
+				{Network: "1.2.3.0/24", Policy: config.PolicyBlockAccess},
+				{Network: "1.2.4.0/24", Policy: config.PolicyBlockAccess},
+				{Network: "2001:db8::/32", Policy: config.PolicyBlockAccess},
+				{Network: "1.2.3.4/32", Policy: config.PolicyAllowAccess},
+				{Network: "1.2.4.0/24", Policy: config.PolicyAllowAccess},
+			}
+
+			ipv4List, ipv6List, err := generateEgressFilterValuesWithStatus(filterList, logger, status)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ipv4List).To(HaveLen(8))
+			Expect(ipv6List).To(ConsistOf("2001:db8::/32"))
+			Expect(status.DroppedPrivateEntries).To(Equal([]string{"10.0.0.0/16", "fe80::/9"}))
+			Expect(status.DroppedPrivateEntriesCount).To(Equal(2))
+			Expect(status.IPv4.AllowCarveOuts).To(Equal(2))
+			Expect(status.IPv6.AllowCarveOuts).To(Equal(0))
+		})
+
+		It("should truncate the list of dropped private networks", func() {
+			status := &config.EgressFilterStatus{}
+			var filterList []config.Filter
+			for range maxDroppedPrivateEntries + 5 {
+				filterList = append(filterList, config.Filter{Network: "192.168.0.0/24", Policy: config.PolicyBlockAccess})
+			}
+
+			_, _, err := generateEgressFilterValuesWithStatus(filterList, logger, status)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.DroppedPrivateEntries).To(HaveLen(maxDroppedPrivateEntries))
+			Expect(status.DroppedPrivateEntriesCount).To(Equal(maxDroppedPrivateEntries + 5))
+		})
+	})
+
+	Describe("#recordLoadBalancerCarveOuts", func() {
+		It("should count the split networks", func() {
+			status := &config.EgressFilterStatus{}
+			original := map[string][]byte{
+				constants.KeyIPV4List: []byte("- 1.2.3.0/24\n- 1.2.4.0/24\n"),
+				constants.KeyIPV6List: []byte("[]"),
+			}
+			filtered, err := filterSecretDataForIPs(logger, original, []net.IP{net.ParseIP("1.2.3.4")})
+			Expect(err).NotTo(HaveOccurred())
+
+			recordLoadBalancerCarveOuts(status, original, filtered)
+			Expect(status.IPv4.LoadBalancerCarveOuts).To(Equal(1))
+			Expect(status.IPv6.LoadBalancerCarveOuts).To(Equal(0))
+		})
+	})
+
+	Describe("#computeFilterListStatistics", func() {
+		var (
+			secretData = map[string][]byte{
+				constants.KeyIPV4List: []byte("- 1.2.3.0/24\n- 1.2.5.0/24\n"),
+				constants.KeyIPV6List: []byte("- 2001:db8::/32\n"),
+			}
+			previousData = map[string][]byte{
+				constants.KeyIPV4List: []byte("- 1.2.3.0/24\n- 1.2.4.0/24\n- 1.2.6.0/24\n"),
+				constants.KeyIPV6List: []byte("- 2001:db8::/32\n"),
+			}
+		)
+
+		It("should leave the differences unset if the previous filter lists are unknown", func() {
+			status := &config.EgressFilterStatus{}
+			ipv4, ipv6 := computeFilterListStatistics(status, secretData, "", nil)
+			Expect(ipv4).To(Equal(entryHashes([]string{"1.2.5.0/24", "1.2.3.0/24"})))
+			Expect(ipv6).To(Equal(entryHashes([]string{"2001:db8::/32"})))
+			Expect(status.Checksum).NotTo(BeEmpty())
+			Expect(status.IPv4.Entries).To(Equal(2))
+			Expect(status.IPv6.Entries).To(Equal(1))
+			Expect(status.IPv4.Added).To(BeNil())
+			Expect(status.IPv4.Removed).To(BeNil())
+		})
+
+		It("should report the statistics of the audited filter lists", func() {
+			status := &config.EgressFilterStatus{}
+			computeFilterListStatistics(status, secretData, "", nil)
+			Expect(status.Audit).To(BeNil())
+
+			auditedData := map[string][]byte{
+				constants.KeyIPV4List:      []byte("[]"),
+				constants.KeyIPV6List:      []byte("[]"),
+				constants.KeyIPV4AuditList: []byte("- 1.2.3.0/24\n- 1.2.5.0/24\n"),
+				constants.KeyIPV6AuditList: []byte("[]"),
+				constants.KeyPortAuditList: []byte("- tcp 443 1.2.3.0/24\n"),
+			}
+			computeFilterListStatistics(status, auditedData, "", nil)
+			Expect(status.IPv4.Entries).To(Equal(0))
+			Expect(status.Audit).To(Equal(&config.AuditStatistics{IPv4Entries: 2, PortScopedRules: 1}))
+		})
+
+		It("should report no differences if the checksum is unchanged", func() {
+			previous := &config.EgressFilterStatus{}
+			computeFilterListStatistics(previous, secretData, "", nil)
+
+			status := &config.EgressFilterStatus{}
+			computeFilterListStatistics(status, secretData, previous.Checksum, nil)
+			Expect(status.IPv4.Added).To(PointTo(Equal(0)))
+			Expect(status.IPv4.Removed).To(PointTo(Equal(0)))
+			Expect(status.IPv6.Added).To(PointTo(Equal(0)))
+			Expect(status.IPv6.Removed).To(PointTo(Equal(0)))
+		})
+
+		It("should report the differences to the previous filter lists", func() {
+			cache := newRenderedFilterListCache()
+			previous := &config.EgressFilterStatus{}
+			ipv4, ipv6 := computeFilterListStatistics(previous, previousData, "", nil)
+			cache.set("shoot--foo--bar", previous.Checksum, ipv4, ipv6)
+
+			status := &config.EgressFilterStatus{}
+			computeFilterListStatistics(status, secretData, previous.Checksum, cache.get(previous.Checksum))
+			Expect(status.IPv4.Added).To(PointTo(Equal(1)))
+			Expect(status.IPv4.Removed).To(PointTo(Equal(2)))
+			Expect(status.IPv6.Added).To(PointTo(Equal(0)))
+			Expect(status.IPv6.Removed).To(PointTo(Equal(0)))
+		})
+	})
+
+	Describe("#hashDifferences", func() {
+		It("should count the hashes only contained in one of the lists", func() {
+			previous := entryHashes([]string{"1.2.3.0/24", "1.2.4.0/24", "1.2.6.0/24", "1.2.7.0/24"})
+			current := entryHashes([]string{"1.2.7.0/24", "1.2.3.0/24", "1.2.5.0/24", "1.2.5.0/24"})
+			Expect(current).To(HaveLen(3))
+
+			added, removed := hashDifferences(current, previous)
+			Expect(added).To(Equal(1))
+			Expect(removed).To(Equal(2))
+
+			added, removed = hashDifferences(nil, previous)
+			Expect(added).To(Equal(0))
+			Expect(removed).To(Equal(4))
+		})
+	})
+
+	Describe("#renderedFilterListCache", func() {
+		It("should share filter lists and release them when no longer used", func() {
+			cache := newRenderedFilterListCache()
+			cache.set("shoot--foo--a", "checksum1", entryHashes([]string{"1.2.3.0/24"}), nil)
+			cache.set("shoot--foo--b", "checksum1", entryHashes([]string{"1.2.3.0/24"}), nil)
+			Expect(cache.get("checksum1")).NotTo(BeNil())
+
+			cache.set("shoot--foo--a", "checksum2", entryHashes([]string{"1.2.4.0/24"}), nil)
+			Expect(cache.get("checksum1")).NotTo(BeNil())
+			Expect(cache.get("checksum2").ipv4).To(Equal(entryHashes([]string{"1.2.4.0/24"})))
+
+			cache.delete("shoot--foo--b")
+			Expect(cache.get("checksum1")).To(BeNil())
+			Expect(cache.get("checksum2")).NotTo(BeNil())
+
+			cache.delete("shoot--foo--a")
+			Expect(cache.get("checksum2")).To(BeNil())
+			Expect(cache.byNamespace).To(BeEmpty())
+		})
+	})
+})
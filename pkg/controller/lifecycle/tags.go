@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"slices"
+
+	"github.com/go-logr/logr"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// filterByTags applies the tag filters to the filter list. Entries are dropped if tag filters with action `include`
+// exist and none of them matches, or if the tag filter deciding on them has the action `exclude`. The policies of
+// the other entries are overridden by the deciding tag filter if it specifies one.
+func filterByTags(filterList []config.Filter, tagFilters []config.TagFilter, logger logr.Logger) []config.Filter {
+	if len(tagFilters) == 0 {
+		return filterList
+	}
+
+	includeOnly := slices.ContainsFunc(tagFilters, func(tagFilter config.TagFilter) bool {
+		return tagFilter.Action == config.TagFilterActionInclude
+	})
+	result := make([]config.Filter, 0, len(filterList))
+	overrideCount, droppedCount := 0, 0
+
+	for _, filter := range filterList {
+		if includeOnly && !slices.ContainsFunc(tagFilters, func(tagFilter config.TagFilter) bool {
+			return tagFilter.Action == config.TagFilterActionInclude && tagFilterMatches(filter, &tagFilter)
+		}) {
+			droppedCount++
+			continue
+		}
+
+		matchingFilter := getMatchingTagFilter(filter, tagFilters)
+		if matchingFilter != nil && matchingFilter.Action == config.TagFilterActionExclude {
+			droppedCount++
+			continue
+		}
+		if matchingFilter != nil && matchingFilter.Policy != nil {
+			// Override policy if tag filter specifies one
+			filter.Policy = *matchingFilter.Policy
+			overrideCount++
+		}
+		// Keep original policy if no matching tag filter or no policy specified
+		result = append(result, filter)
+	}
+
+	logger.Info("applied tag filters", "total", len(filterList), "overridden", overrideCount, "dropped", droppedCount)
+	return result
+}
+
+// getMatchingTagFilter returns the tag filter deciding on the filter entry, i.e. the matching tag filter with the
+// highest priority and of several ones with the same priority the last one. Returns nil if no tag filter matches.
+func getMatchingTagFilter(filter config.Filter, tagFilters []config.TagFilter) *config.TagFilter {
+	var result *config.TagFilter
+	for i := range tagFilters {
+		if tagFilterMatches(filter, &tagFilters[i]) && tagFilterPrecedes(&tagFilters[i], result) {
+			result = &tagFilters[i]
+		}
+	}
+	return result
+}
+
+// tagFilterPrecedes returns true if the tag filter takes precedence over the current one, which is listed before it.
+func tagFilterPrecedes(tagFilter, current *config.TagFilter) bool {
+	return current == nil || tagFilter.Priority >= current.Priority
+}
+
+// tagFilterMatches checks if a filter entry matches a specific tag filter, i.e. all or any of its requirements
+// according to its match.
+func tagFilterMatches(filter config.Filter, tagFilter *config.TagFilter) bool {
+	requirements := tagFilterRequirements(tagFilter)
+	if len(requirements) == 0 {
+		return false
+	}
+	matches := func(requirement config.TagRequirement) bool {
+		return tagRequirementMatches(filter, requirement)
+	}
+	if tagFilter.Match == config.TagMatchAny {
+		return slices.ContainsFunc(requirements, matches)
+	}
+	return !slices.ContainsFunc(requirements, func(requirement config.TagRequirement) bool {
+		return !matches(requirement)
+	})
+}
+
+// tagFilterRequirements returns the requirement of the name and values of the tag filter, if set, followed by its
+// further requirements.
+func tagFilterRequirements(tagFilter *config.TagFilter) []config.TagRequirement {
+	if tagFilter.Name == "" {
+		return tagFilter.Requirements
+	}
+	return append([]config.TagRequirement{{Name: tagFilter.Name, Operator: tagFilter.Operator, Values: tagFilter.Values}}, tagFilter.Requirements...)
+}
+
+// tagRequirementMatches checks if the filter entry has the tag of the requirement with any of its values, or for
+// operator `notIn` if it does not.
+func tagRequirementMatches(filter config.Filter, requirement config.TagRequirement) bool {
+	found := slices.ContainsFunc(filter.Tags, func(tag config.Tag) bool {
+		return tag.Name == requirement.Name && slices.ContainsFunc(tag.Values, func(value string) bool {
+			return slices.Contains(requirement.Values, value)
+		})
+	})
+	return found != (requirement.Operator == config.TagOperatorNotIn)
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Tag selectors", func() {
+	var (
+		malwareHigh = config.Filter{Network: "192.0.2.1/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+			{Name: "category", Values: []string{"malware"}},
+			{Name: "confidence", Values: []string{"high"}},
+		}}
+		malwareLow = config.Filter{Network: "192.0.2.2/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+			{Name: "category", Values: []string{"malware"}},
+			{Name: "confidence", Values: []string{"low"}},
+		}}
+		malwareHighInternal = config.Filter{Network: "192.0.2.3/32", Policy: config.PolicyBlockAccess, Tags: []config.Tag{
+			{Name: "category", Values: []string{"malware"}},
+			{Name: "confidence", Values: []string{"high"}},
+			{Name: "region", Values: []string{"internal", "eu"}},
+		}}
+		untagged = config.Filter{Network: "192.0.2.4/32", Policy: config.PolicyBlockAccess}
+
+		malware        = config.TagRequirement{Name: "category", Values: []string{"malware"}}
+		highConfidence = config.TagRequirement{Name: "confidence", Values: []string{"high"}}
+		notInternal    = config.TagRequirement{Name: "region", Operator: config.TagOperatorNotIn, Values: []string{"internal"}}
+	)
+
+	DescribeTable("#tagFilterMatches", func(filter config.Filter, tagFilter config.TagFilter, expected bool) {
+		Expect(tagFilterMatches(filter, &tagFilter)).To(Equal(expected))
+	},
+		Entry("name and values", malwareLow, config.TagFilter{Name: "confidence", Values: []string{"low", "medium"}}, true),
+		Entry("notIn", malwareHighInternal, config.TagFilter{Name: "region", Operator: config.TagOperatorNotIn, Values: []string{"eu"}}, false),
+		Entry("notIn without the tag", malwareHigh, config.TagFilter{Name: "region", Operator: config.TagOperatorNotIn, Values: []string{"eu"}}, true),
+		Entry("notIn without tags", untagged, config.TagFilter{Requirements: []config.TagRequirement{notInternal}}, true),
+		Entry("all requirements", malwareHigh, config.TagFilter{Requirements: []config.TagRequirement{malware, highConfidence, notInternal}}, true),
+		Entry("not all requirements", malwareHighInternal, config.TagFilter{Requirements: []config.TagRequirement{malware, highConfidence, notInternal}}, false),
+		Entry("name, values and requirements", malwareLow, config.TagFilter{Name: "category", Values: []string{"malware"}, Requirements: []config.TagRequirement{highConfidence}}, false),
+		Entry("any requirement", malwareLow, config.TagFilter{Requirements: []config.TagRequirement{highConfidence, malware}, Match: config.TagMatchAny}, true),
+		Entry("no requirement", untagged, config.TagFilter{Requirements: []config.TagRequirement{highConfidence, malware}, Match: config.TagMatchAny}, false),
+	)
+
+	Describe("#filterByTags", func() {
+		filterList := []config.Filter{malwareHigh, malwareLow, malwareHighInternal, untagged}
+
+		It("should only keep included entries", func() {
+			Expect(filterByTags(filterList, []config.TagFilter{
+				{Requirements: []config.TagRequirement{malware, highConfidence, notInternal}, Action: config.TagFilterActionInclude},
+			}, logr.Discard())).To(Equal([]config.Filter{malwareHigh}))
+		})
+
+		It("should drop excluded entries", func() {
+			Expect(filterByTags(filterList, []config.TagFilter{
+				{Name: "confidence", Values: []string{"low"}, Action: config.TagFilterActionExclude},
+			}, logr.Discard())).To(Equal([]config.Filter{malwareHigh, malwareHighInternal, untagged}))
+		})
+
+		It("should combine included and excluded entries", func() {
+			Expect(filterByTags(filterList, []config.TagFilter{
+				{Requirements: []config.TagRequirement{malware, highConfidence}, Action: config.TagFilterActionInclude},
+				{Name: "region", Values: []string{"internal"}, Action: config.TagFilterActionExclude},
+			}, logr.Discard())).To(Equal([]config.Filter{malwareHigh}))
+		})
+
+		It("should let the tag filter with the highest priority decide", func() {
+			allowed := malwareHighInternal
+			allowed.Policy = config.PolicyAllowAccess
+
+			Expect(filterByTags(filterList, []config.TagFilter{
+				{Name: "region", Values: []string{"internal"}, Policy: new(config.PolicyAllowAccess), Priority: 10},
+				{Name: "category", Values: []string{"malware"}, Action: config.TagFilterActionExclude},
+			}, logr.Discard())).To(Equal([]config.Filter{allowed, untagged}))
+		})
+	})
+
+	Describe("#entryEnforcementMode", func() {
+		It("should use the enforcement mode of the tag filter with the highest priority", func() {
+			tagFilters := []config.TagFilter{
+				{Name: "confidence", Values: []string{"high"}, EnforcementMode: config.EnforcementModeEnforce, Priority: 1},
+				{Name: "category", Values: []string{"malware"}, EnforcementMode: config.EnforcementModeAudit},
+			}
+			Expect(entryEnforcementMode(malwareHigh, tagFilters, config.EnforcementModeAudit)).To(Equal(config.EnforcementModeEnforce))
+			Expect(entryEnforcementMode(malwareLow, tagFilters, config.EnforcementModeEnforce)).To(Equal(config.EnforcementModeAudit))
+			Expect(entryEnforcementMode(untagged, tagFilters, config.EnforcementModeEnforce)).To(Equal(config.EnforcementModeEnforce))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/api/extensions/v1alpha1/helper"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/constants"
+)
+
+// listExtensions returns all Extensions of this extension type and the given classes.
+func listExtensions(ctx context.Context, c client.Client, extensionClasses []extensionsv1alpha1.ExtensionClass) ([]*extensionsv1alpha1.Extension, error) {
+	extensionList := &extensionsv1alpha1.ExtensionList{}
+	if err := c.List(ctx, extensionList); err != nil {
+		return nil, fmt.Errorf("failed to list extensions: %w", err)
+	}
+
+	var extensions []*extensionsv1alpha1.Extension
+	for i := range extensionList.Items {
+		ex := &extensionList.Items[i]
+		if ex.Spec.Type == constants.ExtensionType &&
+			slices.Contains(extensionClasses, extensionsv1alpha1helper.GetExtensionClassOrDefault(ex.Spec.Class)) {
+			extensions = append(extensions, ex)
+		}
+	}
+	return extensions, nil
+}
+
+// triggerExtensionReconciliation annotates the Extension with the reconcile operation and returns whether it was
+// annotated. Extensions which are being deleted or already annotated are skipped.
+func triggerExtensionReconciliation(ctx context.Context, c client.Client, ex *extensionsv1alpha1.Extension) (bool, error) {
+	if ex.DeletionTimestamp != nil || ex.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile {
+		return false, nil
+	}
+	patch := client.MergeFrom(ex.DeepCopy())
+	metav1.SetMetaDataAnnotation(&ex.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	if err := c.Patch(ctx, ex, patch); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to trigger reconciliation of extension %s: %w", client.ObjectKeyFromObject(ex), err)
+	}
+	return true, nil
+}
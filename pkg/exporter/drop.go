@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package exporter contains the blocked connection exporter. It runs on each node alongside the egress filter applier,
+// parses the kernel log entries of the connections blocked by the egress filter, maps them to the source pods and the
+// tags of the matched filter entries and exports them as metrics and Events.
+package exporter
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// LogPrefix is the prefix of the kernel log entries of connections blocked by the egress filter.
+const LogPrefix = "Policy-Filter-Dropped:"
+
+// Drop is a connection blocked by the egress filter.
+type Drop struct {
+	// Source is the source address of the connection.
+	Source netip.Addr
+	// Destination is the destination address of the connection.
+	Destination netip.Addr
+	// Protocol is the protocol of the connection, e.g. `TCP`.
+	Protocol string
+	// DestinationPort is the destination port of the connection. It is 0 for protocols without ports.
+	DestinationPort int
+}
+
+// ParseDrop parses a kernel log entry of a connection blocked by the egress filter, e.g.
+//
+//	Policy-Filter-Dropped:IN=cali1 OUT=ens5 SRC=100.64.0.7 DST=1.2.3.4 LEN=60 PROTO=TCP SPT=55012 DPT=443
+//
+// The entry may be prefixed, e.g. with the `<priority>,<sequence>,<timestamp>,<flags>;` header of /dev/kmsg or the
+// timestamp of a log file. It returns false if the line is no such entry.
+func ParseDrop(line string) (Drop, bool) {
+	_, message, found := strings.Cut(line, LogPrefix)
+	if !found {
+		return Drop{}, false
+	}
+
+	var drop Drop
+	for field := range strings.FieldsSeq(message) {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "SRC":
+			drop.Source, _ = netip.ParseAddr(value)
+		case "DST":
+			drop.Destination, _ = netip.ParseAddr(value)
+		case "PROTO":
+			drop.Protocol = value
+		case "DPT":
+			drop.DestinationPort, _ = strconv.Atoi(value)
+		}
+	}
+	if !drop.Source.IsValid() || !drop.Destination.IsValid() {
+		return Drop{}, false
+	}
+	return drop, true
+}
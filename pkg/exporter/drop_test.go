@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseDrop", func() {
+	DescribeTable("should parse log entries of blocked connections",
+		func(line string, expected Drop) {
+			drop, ok := ParseDrop(line)
+			Expect(ok).To(BeTrue())
+			Expect(drop).To(Equal(expected))
+		},
+		Entry("plain entry",
+			"Policy-Filter-Dropped:IN=califb3eb82ef50 OUT=ens5 MAC=ee:ee:ee:ee:ee:ee:8a:7f:1f:f9:a0:ca:08:00 SRC=100.64.0.7 DST=1.2.3.4 LEN=60 TOS=0x00 PREC=0x00 TTL=63 ID=33784 DF PROTO=TCP SPT=55012 DPT=443 WINDOW=65535 RES=0x00 SYN URGP=0 MARK=0x10000",
+			Drop{Source: netip.MustParseAddr("100.64.0.7"), Destination: netip.MustParseAddr("1.2.3.4"), Protocol: "TCP", DestinationPort: 443},
+		),
+		Entry("/dev/kmsg record",
+			"4,18213,5283726180,-;Policy-Filter-Dropped:IN=cali1 OUT=ens5 SRC=100.64.0.7 DST=1.2.3.4 LEN=60 PROTO=UDP SPT=55012 DPT=53 LEN=40",
+			Drop{Source: netip.MustParseAddr("100.64.0.7"), Destination: netip.MustParseAddr("1.2.3.4"), Protocol: "UDP", DestinationPort: 53},
+		),
+		Entry("kernel log file line",
+			"Oct 17 10:00:00 node-1 kernel: [52837.261801] Policy-Filter-Dropped:IN=cali1 OUT=ens5 SRC=2001:db8::7 DST=2001:db8:1::1 LEN=104 PROTO=ICMPv6 TYPE=128 CODE=0",
+			Drop{Source: netip.MustParseAddr("2001:db8::7"), Destination: netip.MustParseAddr("2001:db8:1::1"), Protocol: "ICMPv6"},
+		),
+	)
+
+	DescribeTable("should ignore other lines",
+		func(line string) {
+			_, ok := ParseDrop(line)
+			Expect(ok).To(BeFalse())
+		},
+		Entry("other kernel message", "6,18214,5283726181,-;eth0: link up"),
+		Entry("continuation line", " SUBSYSTEM=net"),
+		Entry("missing destination", "Policy-Filter-Dropped:IN=cali1 OUT=ens5 SRC=100.64.0.7 PROTO=TCP"),
+		Entry("invalid source", "Policy-Filter-Dropped:IN=cali1 OUT=ens5 SRC=foo DST=1.2.3.4 PROTO=TCP"),
+	)
+})
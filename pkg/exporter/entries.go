@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/netip"
+	"slices"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+// FilterEntry is a blocking filter entry with its tags.
+type FilterEntry struct {
+	// Network is the network CIDR of the filter entry.
+	Network netip.Prefix `json:"network"`
+	// Tags contains the tags of the filter entry as `<name>=<value>`.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// EncodeEntries encodes the blocking entries of the filter list for the exporter secret. FQDN entries must be resolved
+// before, they are skipped.
+func EncodeEntries(filterList []config.Filter) ([]byte, error) {
+	var entries []FilterEntry
+	for _, filter := range filterList {
+		if filter.Policy != config.PolicyBlockAccess && filter.Policy != config.PolicyBlock {
+			continue
+		}
+		network, err := netip.ParsePrefix(filter.Network)
+		if err != nil {
+			continue
+		}
+		entry := FilterEntry{Network: network.Masked()}
+		for _, tag := range filter.Tags {
+			for _, value := range tag.Values {
+				entry.Tags = append(entry.Tags, tag.Name+"="+value)
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(entries); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeEntries decodes the entries of the exporter secret.
+func DecodeEntries(data []byte) ([]FilterEntry, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress filter entries: %w", err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress filter entries: %w", err)
+	}
+	var entries []FilterEntry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode filter entries: %w", err)
+	}
+	return entries, nil
+}
+
+// Matcher maps destination addresses to the tags of the most specific filter entry containing them.
+type Matcher struct {
+	// bits contains the prefix lengths of the entries in descending order
+	bits     []int
+	prefixes map[netip.Prefix][]string
+}
+
+// NewMatcher returns a matcher for the given entries. The tags of entries with the same network are merged.
+func NewMatcher(entries []FilterEntry) *Matcher {
+	m := &Matcher{prefixes: map[netip.Prefix][]string{}}
+	for _, entry := range entries {
+		network := entry.Network.Masked()
+		if !network.IsValid() {
+			continue
+		}
+		tags, ok := m.prefixes[network]
+		if !ok && !slices.Contains(m.bits, network.Bits()) {
+			m.bits = append(m.bits, network.Bits())
+		}
+		for _, tag := range entry.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		m.prefixes[network] = tags
+	}
+	slices.Sort(m.bits)
+	slices.Reverse(m.bits)
+	return m
+}
+
+// Match returns the tags of the most specific entry containing the address. It returns false if no entry contains it.
+func (m *Matcher) Match(addr netip.Addr) ([]string, bool) {
+	addr = addr.Unmap()
+	for _, bits := range m.bits {
+		prefix, err := addr.Prefix(bits)
+		if err != nil {
+			// prefix length of the other address family
+			continue
+		}
+		if tags, ok := m.prefixes[prefix]; ok {
+			return tags, true
+		}
+	}
+	return nil, false
+}
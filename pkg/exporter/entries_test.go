@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+)
+
+var _ = Describe("Entries", func() {
+	Describe("#EncodeEntries", func() {
+		It("should encode the blocking entries with their tags", func() {
+			data, err := EncodeEntries([]config.Filter{
+				{Network: "1.2.3.4/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "threat", Values: []string{"botnet", "spam"}}}},
+				{Network: "5.6.7.8/32", Policy: config.PolicyAllowAccess},
+				{Network: "2001:db8::/32", Policy: config.PolicyBlockAccess},
+				{FQDN: "example.com", Policy: config.PolicyBlockAccess},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := DecodeEntries(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal([]FilterEntry{
+				{Network: netip.MustParsePrefix("1.2.3.0/24"), Tags: []string{"threat=botnet", "threat=spam"}},
+				{Network: netip.MustParsePrefix("2001:db8::/32")},
+			}))
+		})
+
+		It("should fail to decode invalid data", func() {
+			_, err := DecodeEntries([]byte("[]"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Matcher", func() {
+		var matcher *Matcher
+
+		BeforeEach(func() {
+			matcher = NewMatcher([]FilterEntry{
+				{Network: netip.MustParsePrefix("10.0.0.0/8"), Tags: []string{"source=a"}},
+				{Network: netip.MustParsePrefix("10.1.0.0/16"), Tags: []string{"source=b"}},
+				{Network: netip.MustParsePrefix("10.1.0.0/16"), Tags: []string{"source=c", "source=b"}},
+				{Network: netip.MustParsePrefix("192.0.2.1/32")},
+				{Network: netip.MustParsePrefix("2001:db8::/32"), Tags: []string{"source=d"}},
+			})
+		})
+
+		DescribeTable("should match the most specific entry",
+			func(addr string, expectedTags []string, expectedMatch bool) {
+				tags, ok := matcher.Match(netip.MustParseAddr(addr))
+				Expect(ok).To(Equal(expectedMatch))
+				Expect(tags).To(Equal(expectedTags))
+			},
+			Entry("less specific entry", "10.2.0.1", []string{"source=a"}, true),
+			Entry("more specific entries with merged tags", "10.1.2.3", []string{"source=b", "source=c"}, true),
+			Entry("entry without tags", "192.0.2.1", []string(nil), true),
+			Entry("IPv4-mapped IPv6 address", "::ffff:10.2.0.1", []string{"source=a"}, true),
+			Entry("IPv6 entry", "2001:db8::1", []string{"source=d"}, true),
+			Entry("no entry", "192.0.2.2", []string(nil), false),
+		)
+	})
+})
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/metrics"
+)
+
+const (
+	// DefaultEventInterval is the default interval in which the top offenders are reported in Events.
+	DefaultEventInterval = 10 * time.Minute
+	// DefaultTopOffenders is the default number of top offenders reported in Events per interval.
+	DefaultTopOffenders = 5
+
+	// EnvNodeName is the environment variable with the name of the node of the exporter.
+	EnvNodeName = "NODE_NAME"
+
+	// ReasonConnectionsBlocked is the reason of the Events reporting the blocked connections of a pod.
+	ReasonConnectionsBlocked = "EgressConnectionsBlocked"
+	// actionConnect is the action of the Events reporting the blocked connections of a pod.
+	actionConnect = "Connect"
+
+	// reloadInterval is the interval in which the filter entries are reloaded if they changed.
+	reloadInterval = time.Minute
+	// maxListedTags is the maximum number of tags listed in an Event.
+	maxListedTags = 5
+)
+
+// Options are the options of the exporter.
+type Options struct {
+	// EntriesPath is the path of the file with the encoded filter entries, see EncodeEntries.
+	EntriesPath string
+	// EventInterval is the interval in which the top offenders are reported in Events.
+	EventInterval time.Duration
+	// TopOffenders is the number of source pods with the most blocked connections reported in Events per interval.
+	TopOffenders int
+}
+
+// Exporter counts the connections blocked by the egress filter per source pod and tag of the matched filter entry
+// and reports the pods with the most blocked connections in Events. Unlike the metrics, the Events are rate-limited
+// to the top offenders per interval to keep the load on the API server low.
+type Exporter struct {
+	logger   logr.Logger
+	resolver PodResolver
+	recorder events.EventRecorder
+	opts     Options
+
+	mu        sync.Mutex
+	entries   []byte
+	matcher   *Matcher
+	offenders map[types.NamespacedName]*offender
+}
+
+// offender is a pod with blocked connections in the current interval.
+type offender struct {
+	pod         *corev1.Pod
+	count       int
+	destination Drop
+	tags        sets.Set[string]
+}
+
+// New returns an exporter resolving the source pods with the given resolver and reporting Events with the recorder.
+func New(logger logr.Logger, resolver PodResolver, recorder events.EventRecorder, opts Options) *Exporter {
+	return &Exporter{
+		logger:    logger,
+		resolver:  resolver,
+		recorder:  recorder,
+		opts:      opts,
+		matcher:   NewMatcher(nil),
+		offenders: map[types.NamespacedName]*offender{},
+	}
+}
+
+// Start reloads the filter entries if they changed and reports the top offenders periodically until the context is
+// cancelled.
+func (e *Exporter) Start(ctx context.Context) error {
+	e.reloadEntries()
+
+	reload := time.NewTicker(reloadInterval)
+	defer reload.Stop()
+	report := time.NewTicker(e.opts.EventInterval)
+	defer report.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-reload.C:
+			e.reloadEntries()
+		case <-report.C:
+			e.ReportOffenders()
+		}
+	}
+}
+
+// reloadEntries loads the filter entries if they changed. The last loaded entries are kept on errors.
+func (e *Exporter) reloadEntries() {
+	data, err := os.ReadFile(e.opts.EntriesPath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			e.logger.Error(err, "Failed to read filter entries", "path", e.opts.EntriesPath)
+		}
+		return
+	}
+
+	e.mu.Lock()
+	unchanged := bytes.Equal(data, e.entries)
+	e.mu.Unlock()
+	if unchanged {
+		return
+	}
+
+	entries, err := DecodeEntries(data)
+	if err != nil {
+		e.logger.Error(err, "Failed to load filter entries", "path", e.opts.EntriesPath)
+		return
+	}
+	matcher := NewMatcher(entries)
+
+	e.mu.Lock()
+	e.entries, e.matcher = data, matcher
+	e.mu.Unlock()
+	e.logger.Info("Filter entries loaded", "entries", len(entries))
+}
+
+// Handle processes a line of the kernel log. Lines of connections not blocked by the egress filter are ignored.
+func (e *Exporter) Handle(ctx context.Context, line string) {
+	drop, ok := ParseDrop(line)
+	if !ok {
+		return
+	}
+
+	pod, err := e.resolver(ctx, drop.Source)
+	if err != nil {
+		e.logger.Error(err, "Failed to resolve source pod", "source", drop.Source)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	tags, _ := e.matcher.Match(drop.Destination)
+	if pod == nil {
+		reportBlockedConnection("", "", tags)
+		return
+	}
+	reportBlockedConnection(pod.Namespace, pod.Name, tags)
+
+	key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	o, ok := e.offenders[key]
+	if !ok {
+		o = &offender{pod: pod, tags: sets.New[string]()}
+		e.offenders[key] = o
+	}
+	o.count++
+	o.destination = drop
+	o.tags.Insert(tags...)
+}
+
+// reportBlockedConnection counts the blocked connection for each tag of the matched filter entry.
+func reportBlockedConnection(namespace, pod string, tags []string) {
+	if len(tags) == 0 {
+		metrics.ReportBlockedConnection(namespace, pod, "")
+		return
+	}
+	for _, tag := range tags {
+		metrics.ReportBlockedConnection(namespace, pod, tag)
+	}
+}
+
+// ReportOffenders reports the pods with the most blocked connections since the last report in Events.
+func (e *Exporter) ReportOffenders() {
+	e.mu.Lock()
+	offenders := make([]*offender, 0, len(e.offenders))
+	for _, o := range e.offenders {
+		offenders = append(offenders, o)
+	}
+	e.offenders = map[types.NamespacedName]*offender{}
+	e.mu.Unlock()
+
+	slices.SortFunc(offenders, func(a, b *offender) int {
+		return cmp.Or(
+			cmp.Compare(b.count, a.count),
+			cmp.Compare(a.pod.Namespace, b.pod.Namespace),
+			cmp.Compare(a.pod.Name, b.pod.Name),
+		)
+	})
+	for _, o := range offenders[:min(e.opts.TopOffenders, len(offenders))] {
+		tags := "none"
+		if o.tags.Len() > 0 {
+			list := sets.List(o.tags)
+			if len(list) > maxListedTags {
+				list = append(list[:maxListedTags], "...")
+			}
+			tags = strings.Join(list, ", ")
+		}
+		e.recorder.Eventf(o.pod, nil, corev1.EventTypeWarning, ReasonConnectionsBlocked, actionConnect,
+			"%d connections blocked by the egress filter in the last %s, last to %s port %d (%s), matched tags: %s",
+			o.count, e.opts.EventInterval, o.destination.Destination, o.destination.DestinationPort, o.destination.Protocol, tags)
+	}
+}
+
+// DeletePod forgets the blocked connections of the given pod once it is deleted.
+func (e *Exporter) DeletePod(namespace, name string) {
+	e.mu.Lock()
+	delete(e.offenders, types.NamespacedName{Namespace: namespace, Name: name})
+	e.mu.Unlock()
+	metrics.DeleteBlockedConnections(namespace, name)
+}
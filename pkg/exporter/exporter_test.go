@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/apis/config"
+	"github.com/gardener/gardener-extension-shoot-networking-filter/pkg/metrics"
+)
+
+func blockedConnections(namespace, pod, tag string) float64 {
+	metric := &dto.Metric{}
+	ExpectWithOffset(1, metrics.BlockedConnections.WithLabelValues(namespace, pod, tag).Write(metric)).To(Succeed())
+	return metric.GetCounter().GetValue()
+}
+
+func newPod(namespace, name, ip string, hostNetwork bool, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.PodSpec{HostNetwork: hostNetwork},
+		Status:     corev1.PodStatus{Phase: phase, PodIPs: []corev1.PodIP{{IP: ip}}},
+	}
+}
+
+func dropLine(src, dst string) string {
+	return "4,18213,5283726180,-;Policy-Filter-Dropped:IN=cali1 OUT=ens5 SRC=" + src + " DST=" + dst + " LEN=60 PROTO=TCP SPT=55012 DPT=443"
+}
+
+var _ = Describe("Exporter", func() {
+	var (
+		ctx      = context.Background()
+		recorder *events.FakeRecorder
+		exporter *Exporter
+	)
+
+	BeforeEach(func() {
+		metrics.BlockedConnections.Reset()
+
+		c := fake.NewClientBuilder().
+			WithIndex(&corev1.Pod{}, podIPField, podIPs).
+			WithObjects(
+				newPod("app", "web", "100.64.0.7", false, corev1.PodRunning),
+				newPod("app", "worker", "100.64.0.8", false, corev1.PodRunning),
+				newPod("app", "completed", "100.64.0.9", false, corev1.PodSucceeded),
+				newPod("kube-system", "node-exporter", "10.250.0.5", true, corev1.PodRunning),
+			).
+			Build()
+
+		data, err := EncodeEntries([]config.Filter{
+			{Network: "1.2.3.0/24", Policy: config.PolicyBlockAccess, Tags: []config.Tag{{Name: "threat", Values: []string{"botnet"}}}},
+			{Network: "5.6.7.8/32", Policy: config.PolicyBlockAccess},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		path := filepath.Join(GinkgoT().TempDir(), "filter-entries")
+		Expect(os.WriteFile(path, data, 0600)).To(Succeed())
+
+		recorder = events.NewFakeRecorder(10)
+		exporter = New(logr.Discard(), NewPodResolver(c), recorder, Options{
+			EntriesPath:   path,
+			EventInterval: 10 * time.Minute,
+			TopOffenders:  1,
+		})
+		exporter.reloadEntries()
+	})
+
+	It("should count blocked connections per source pod and tag", func() {
+		exporter.Handle(ctx, dropLine("100.64.0.7", "1.2.3.4"))
+		exporter.Handle(ctx, dropLine("100.64.0.7", "1.2.3.5"))
+		exporter.Handle(ctx, dropLine("100.64.0.7", "5.6.7.8"))
+		exporter.Handle(ctx, dropLine("100.64.0.9", "1.2.3.4"))
+		exporter.Handle(ctx, dropLine("10.250.0.5", "9.9.9.9"))
+		exporter.Handle(ctx, "6,18214,5283726181,-;eth0: link up")
+
+		Expect(blockedConnections("app", "web", "threat=botnet")).To(Equal(2.0))
+		Expect(blockedConnections("app", "web", "")).To(Equal(1.0))
+		Expect(blockedConnections("", "", "threat=botnet")).To(Equal(1.0))
+		Expect(blockedConnections("", "", "")).To(Equal(1.0))
+	})
+
+	It("should report the top offenders in Events", func() {
+		exporter.Handle(ctx, dropLine("100.64.0.8", "5.6.7.8"))
+		exporter.Handle(ctx, dropLine("100.64.0.7", "1.2.3.4"))
+		exporter.Handle(ctx, dropLine("100.64.0.7", "5.6.7.8"))
+
+		exporter.ReportOffenders()
+		Expect(recorder.Events).To(Receive(Equal("Warning EgressConnectionsBlocked 2 connections blocked by the egress filter in the last 10m0s, last to 5.6.7.8 port 443 (TCP), matched tags: threat=botnet")))
+		Expect(recorder.Events).NotTo(Receive())
+
+		By("starting a new interval")
+		exporter.ReportOffenders()
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should forget deleted pods", func() {
+		exporter.Handle(ctx, dropLine("100.64.0.7", "1.2.3.4"))
+		exporter.DeletePod("app", "web")
+
+		Expect(metrics.BlockedConnections.DeletePartialMatch(map[string]string{"pod": "web"})).To(BeZero())
+		exporter.ReportOffenders()
+		Expect(recorder.Events).NotTo(Receive())
+	})
+})
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// readBufferSize is the size of the read buffer. Each read of /dev/kmsg returns a single record and fails if the
+// record does not fit into the buffer.
+const readBufferSize = 16 * 1024
+
+// Follow reads the lines of the reader and calls handle for each of them until the context is cancelled. It waits for
+// new lines for pollInterval at the end of the reader, which allows following regular files. Records of /dev/kmsg
+// which were overwritten in the ring buffer before they were read are skipped.
+func Follow(ctx context.Context, r io.Reader, pollInterval time.Duration, handle func(line string)) error {
+	var (
+		reader  = bufio.NewReaderSize(r, readBufferSize)
+		partial strings.Builder
+	)
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		line, err := reader.ReadString('\n')
+		partial.WriteString(line)
+		switch {
+		case err == nil:
+			handle(strings.TrimSuffix(partial.String(), "\n"))
+			partial.Reset()
+		case errors.Is(err, io.EOF):
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(pollInterval):
+			}
+		case errors.Is(err, syscall.EPIPE):
+			partial.Reset()
+		default:
+			return err
+		}
+	}
+}
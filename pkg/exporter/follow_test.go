@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// lineRecorder records the lines passed to Follow.
+type lineRecorder struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (r *lineRecorder) handle(line string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines = append(r.lines, line)
+}
+
+func (r *lineRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.lines...)
+}
+
+// errorReader returns an error once before it returns the data of the wrapped reader.
+type errorReader struct {
+	err error
+	io.Reader
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		err := r.err
+		r.err = nil
+		return 0, err
+	}
+	return r.Reader.Read(p)
+}
+
+var _ = Describe("Follow", func() {
+	var (
+		ctx      context.Context
+		cancel   context.CancelFunc
+		recorder *lineRecorder
+		done     chan error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+		recorder = &lineRecorder{}
+		done = make(chan error, 1)
+	})
+
+	It("should follow a file", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "kern.log")
+		Expect(os.WriteFile(path, []byte("first\n"), 0600)).To(Succeed())
+		file, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(file.Close)
+
+		go func() { done <- Follow(ctx, file, 10*time.Millisecond, recorder.handle) }()
+		Eventually(recorder.get).Should(Equal([]string{"first"}))
+
+		writer, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(writer.Close)
+		_, err = writer.WriteString("sec")
+		Expect(err).NotTo(HaveOccurred())
+		Consistently(recorder.get, 50*time.Millisecond).Should(Equal([]string{"first"}))
+		_, err = writer.WriteString("ond\nthird\n")
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(recorder.get).Should(Equal([]string{"first", "second", "third"}))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should skip overwritten records", func() {
+		reader := &errorReader{err: syscall.EPIPE, Reader: strings.NewReader("record\n")}
+
+		go func() { done <- Follow(ctx, reader, 10*time.Millisecond, recorder.handle) }()
+		Eventually(recorder.get).Should(Equal([]string{"record"}))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should return other errors", func() {
+		reader := &errorReader{err: errors.New("fake"), Reader: strings.NewReader("")}
+		Expect(Follow(ctx, reader, 10*time.Millisecond, recorder.handle)).To(MatchError("fake"))
+	})
+})
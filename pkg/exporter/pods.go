@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exporter
+
+import (
+	"context"
+	"net/netip"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podIPField is the field index of the pods by their IP addresses.
+const podIPField = "status.podIPs"
+
+// IndexPodIPs adds the field index of the pods by their IP addresses used by the resolver of NewPodResolver.
+func IndexPodIPs(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &corev1.Pod{}, podIPField, podIPs)
+}
+
+// podIPs returns the IP addresses of the pod. Pods in the host network are not indexed, as their addresses are the
+// ones of the node.
+func podIPs(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.HostNetwork {
+		return nil
+	}
+	var ips []string
+	for _, podIP := range pod.Status.PodIPs {
+		if addr, err := netip.ParseAddr(podIP.IP); err == nil {
+			ips = append(ips, addr.String())
+		}
+	}
+	return ips
+}
+
+// PodResolver returns the pod with the given IP address. It returns nil if there is none.
+type PodResolver func(ctx context.Context, addr netip.Addr) (*corev1.Pod, error)
+
+// NewPodResolver returns a resolver looking up the pods by the field index added by IndexPodIPs. The addresses of
+// terminated pods may already be reused, so they are ignored.
+func NewPodResolver(reader client.Reader) PodResolver {
+	return func(ctx context.Context, addr netip.Addr) (*corev1.Pod, error) {
+		pods := &corev1.PodList{}
+		if err := reader.List(ctx, pods, client.MatchingFields{podIPField: addr.Unmap().String()}); err != nil {
+			return nil, err
+		}
+		for i := range pods.Items {
+			if phase := pods.Items[i].Status.Phase; phase != corev1.PodSucceeded && phase != corev1.PodFailed {
+				return &pods.Items[i], nil
+			}
+		}
+		return nil, nil
+	}
+}
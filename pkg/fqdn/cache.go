@@ -0,0 +1,235 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fqdn
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultMaxAddressesPerName is the default maximum number of addresses used per name.
+	DefaultMaxAddressesPerName = 16
+	// DefaultMinTTL is the default minimum time resolved addresses are cached.
+	DefaultMinTTL = 30 * time.Second
+	// DefaultMaxTTL is the default maximum time resolved addresses are cached.
+	DefaultMaxTTL = time.Hour
+	// DefaultMinChangeInterval is the default minimum time between two changes of the addresses of a name.
+	DefaultMinChangeInterval = 5 * time.Minute
+
+	// unusedExpiry is the time after which names which were not looked up are removed from the cache.
+	unusedExpiry = 3 * time.Hour
+	// concurrency is the maximum number of concurrent resolutions.
+	concurrency = 16
+)
+
+// entry is a cached resolution of a name.
+type entry struct {
+	addrs    []netip.Addr
+	err      error
+	expires  time.Time
+	lastUsed time.Time
+	// changed is the time the addresses changed the last time, zero if the name was never resolved successfully.
+	changed time.Time
+}
+
+// Cache caches the resolved addresses of names until their TTL expires.
+// The TTLs are bounded by a minimum and maximum TTL and the number of addresses per name is capped.
+// The addresses of a name change at most once per minimum change interval, so that rotating DNS answers
+// do not cause a change on every resolution.
+type Cache struct {
+	resolver          Resolver
+	clock             clock.Clock
+	maxAddresses      int
+	minTTL            time.Duration
+	maxTTL            time.Duration
+	minChangeInterval time.Duration
+
+	lock    sync.Mutex
+	entries map[string]*entry
+}
+
+// NewCache creates a cache for the resolutions of the given resolver.
+func NewCache(resolver Resolver, clock clock.Clock, maxAddresses int, minTTL, maxTTL, minChangeInterval time.Duration) *Cache {
+	return &Cache{
+		resolver:          resolver,
+		clock:             clock,
+		maxAddresses:      maxAddresses,
+		minTTL:            minTTL,
+		maxTTL:            max(minTTL, maxTTL),
+		minChangeInterval: minChangeInterval,
+		entries:           map[string]*entry{},
+	}
+}
+
+// Lookup returns the addresses of the names. Names which are not cached yet are resolved first.
+// The map contains no addresses for names which could not be resolved.
+func (c *Cache) Lookup(ctx context.Context, names []string) map[string][]netip.Addr {
+	now := c.clock.Now()
+	var missing []string
+	c.lock.Lock()
+	for _, name := range names {
+		if e, ok := c.entries[NormalizeName(name)]; ok {
+			e.lastUsed = now
+		} else {
+			missing = append(missing, NormalizeName(name))
+		}
+	}
+	c.lock.Unlock()
+
+	c.resolveAll(ctx, slices.Compact(slices.Sorted(slices.Values(missing))))
+
+	result := make(map[string][]netip.Addr, len(names))
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, name := range names {
+		if e, ok := c.entries[NormalizeName(name)]; ok {
+			result[name] = e.addrs
+		}
+	}
+	return result
+}
+
+// Errors returns the errors of the last resolution of the names which could not be resolved.
+func (c *Cache) Errors(names []string) map[string]error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	result := map[string]error{}
+	for _, name := range names {
+		if e, ok := c.entries[NormalizeName(name)]; ok && e.err != nil {
+			result[name] = e.err
+		}
+	}
+	return result
+}
+
+// Refresh resolves the names whose TTL expired and removes names which were not looked up recently.
+// It returns the sorted names whose addresses changed.
+func (c *Cache) Refresh(ctx context.Context) []string {
+	now := c.clock.Now()
+	var expired []string
+	c.lock.Lock()
+	for name, e := range c.entries {
+		switch {
+		case now.Sub(e.lastUsed) > unusedExpiry:
+			delete(c.entries, name)
+		case !now.Before(e.expires):
+			expired = append(expired, name)
+		}
+	}
+	c.lock.Unlock()
+
+	return c.resolveAll(ctx, expired)
+}
+
+// Run refreshes the cache in the given interval until the context is cancelled.
+// onChange is called with the names whose addresses changed, if any.
+func (c *Cache) Run(ctx context.Context, interval time.Duration, onChange func(ctx context.Context, changed []string)) {
+	timer := c.clock.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C():
+			if changed := c.Refresh(ctx); len(changed) > 0 {
+				onChange(ctx, changed)
+			}
+			timer.Reset(interval)
+		}
+	}
+}
+
+// resolveAll resolves the names concurrently and returns the sorted names whose addresses changed.
+func (c *Cache) resolveAll(ctx context.Context, names []string) []string {
+	var (
+		changed = make([]bool, len(names))
+		wg      sync.WaitGroup
+		limit   = make(chan struct{}, concurrency)
+	)
+	for i, name := range names {
+		wg.Go(func() {
+			limit <- struct{}{}
+			defer func() { <-limit }()
+			changed[i] = c.resolve(ctx, name)
+		})
+	}
+	wg.Wait()
+
+	var result []string
+	for i, name := range names {
+		if changed[i] {
+			result = append(result, name)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// resolve resolves the name and updates its cache entry. A failed resolution keeps the previous addresses and
+// is retried after the minimum TTL. A change of the addresses within the minimum change interval after the previous
+// change is deferred, the name is resolved again when the interval has passed. It returns true if the addresses changed.
+func (c *Cache) resolve(ctx context.Context, name string) bool {
+	addrs, ttl, err := c.resolver.Resolve(ctx, name)
+	now := c.clock.Now()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.entries[name]
+	if !ok {
+		e = &entry{lastUsed: now}
+		c.entries[name] = e
+	}
+	if err != nil {
+		e.err = err
+		e.expires = now.Add(c.minTTL)
+		return false
+	}
+
+	addrs = normalizeAddrs(addrs, c.maxAddresses)
+	e.err = nil
+	e.expires = now.Add(min(max(ttl, c.minTTL), c.maxTTL))
+	changed := !slices.Equal(e.addrs, addrs)
+	if !e.changed.IsZero() {
+		if !changed {
+			return false
+		}
+		if next := e.changed.Add(c.minChangeInterval); now.Before(next) {
+			e.expires = minTime(e.expires, next)
+			return false
+		}
+	}
+	e.addrs = addrs
+	e.changed = now
+	return changed
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// normalizeAddrs returns the sorted unique addresses, capped to the maximum number of addresses.
+// Sorting keeps the result stable if a DNS server returns the records in varying order.
+func normalizeAddrs(addrs []netip.Addr, maxAddresses int) []netip.Addr {
+	result := make([]netip.Addr, 0, len(addrs))
+	for _, addr := range addrs {
+		result = append(result, addr.Unmap())
+	}
+	slices.SortFunc(result, netip.Addr.Compare)
+	result = slices.Compact(result)
+	if maxAddresses > 0 && len(result) > maxAddresses {
+		result = result[:maxAddresses]
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fqdn
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("Cache", func() {
+	var (
+		ctx      = context.Background()
+		clock    *testclock.FakeClock
+		resolver *StaticResolver
+		cache    *Cache
+
+		addr1 = netip.MustParseAddr("192.0.2.1")
+		addr2 = netip.MustParseAddr("192.0.2.2")
+		addr3 = netip.MustParseAddr("2001:db8::1")
+	)
+
+	BeforeEach(func() {
+		clock = testclock.NewFakeClock(time.Now())
+		resolver = NewStaticResolver(map[string][]netip.Addr{"pool.example.com": {addr2, addr1, addr1}}, 5*time.Minute)
+		cache = NewCache(resolver, clock, 2, time.Minute, 10*time.Minute, 0)
+	})
+
+	It("should resolve unknown names on lookup", func() {
+		Expect(cache.Lookup(ctx, []string{"Pool.Example.com.", "unknown.example.com"})).To(Equal(map[string][]netip.Addr{
+			"Pool.Example.com.":   {addr1, addr2},
+			"unknown.example.com": {},
+		}))
+	})
+
+	It("should cap the number of addresses per name", func() {
+		resolver.Set("pool.example.com", addr3, addr2, addr1)
+		Expect(cache.Lookup(ctx, []string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", []netip.Addr{addr1, addr2}))
+	})
+
+	It("should only resolve names again after their TTL expired", func() {
+		cache.Lookup(ctx, []string{"pool.example.com"})
+		resolver.Set("pool.example.com", addr1)
+
+		clock.Step(4 * time.Minute)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+		Expect(cache.Lookup(ctx, []string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", []netip.Addr{addr1, addr2}))
+
+		clock.Step(time.Minute)
+		Expect(cache.Refresh(ctx)).To(ConsistOf("pool.example.com"))
+		Expect(cache.Lookup(ctx, []string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", []netip.Addr{addr1}))
+
+		clock.Step(5 * time.Minute)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+	})
+
+	It("should bound the TTL", func() {
+		resolver = NewStaticResolver(map[string][]netip.Addr{"pool.example.com": {addr1}}, time.Second)
+		cache = NewCache(resolver, clock, 2, time.Minute, 10*time.Minute, 0)
+		cache.Lookup(ctx, []string{"pool.example.com"})
+		resolver.Set("pool.example.com", addr2)
+
+		clock.Step(59 * time.Second)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+		clock.Step(time.Second)
+		Expect(cache.Refresh(ctx)).To(ConsistOf("pool.example.com"))
+	})
+
+	It("should defer changes within the minimum change interval", func() {
+		resolver = NewStaticResolver(map[string][]netip.Addr{"pool.example.com": {addr1}}, time.Minute)
+		cache = NewCache(resolver, clock, 2, time.Minute, 10*time.Minute, 5*time.Minute)
+		cache.Lookup(ctx, []string{"pool.example.com"})
+
+		// rotating answers are deferred until the interval passed
+		for _, addr := range []netip.Addr{addr2, addr3, addr2, addr3} {
+			resolver.Set("pool.example.com", addr)
+			clock.Step(time.Minute)
+			Expect(cache.Refresh(ctx)).To(BeEmpty())
+			Expect(cache.Lookup(ctx, []string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", []netip.Addr{addr1}))
+		}
+
+		clock.Step(time.Minute)
+		Expect(cache.Refresh(ctx)).To(ConsistOf("pool.example.com"))
+		Expect(cache.Lookup(ctx, []string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", []netip.Addr{addr3}))
+
+		// the next change is deferred again
+		resolver.Set("pool.example.com", addr2)
+		clock.Step(time.Minute)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+	})
+
+	It("should resolve deferred changes again when the interval passed", func() {
+		resolver = NewStaticResolver(map[string][]netip.Addr{"pool.example.com": {addr1}}, 10*time.Minute)
+		cache = NewCache(resolver, clock, 2, time.Minute, 10*time.Minute, 15*time.Minute)
+		cache.Lookup(ctx, []string{"pool.example.com"})
+		resolver.Set("pool.example.com", addr2)
+
+		clock.Step(10 * time.Minute)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+		clock.Step(5 * time.Minute)
+		Expect(cache.Refresh(ctx)).To(ConsistOf("pool.example.com"))
+	})
+
+	It("should keep the addresses if the resolution fails", func() {
+		cache.Lookup(ctx, []string{"pool.example.com"})
+		resolver.SetError(errors.New("timeout"))
+
+		clock.Step(5 * time.Minute)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+		Expect(cache.Lookup(ctx, []string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", []netip.Addr{addr1, addr2}))
+		Expect(cache.Errors([]string{"pool.example.com"})).To(HaveKeyWithValue("pool.example.com", MatchError("timeout")))
+
+		resolver.SetError(nil)
+		clock.Step(time.Minute)
+		Expect(cache.Refresh(ctx)).To(BeEmpty())
+		Expect(cache.Errors([]string{"pool.example.com"})).To(BeEmpty())
+	})
+
+	It("should remove names which are not used anymore", func() {
+		cache.Lookup(ctx, []string{"pool.example.com"})
+		clock.Step(unusedExpiry + time.Second)
+		cache.Refresh(ctx)
+		Expect(cache.entries).To(BeEmpty())
+	})
+
+	It("should report the names whose addresses changed", func() {
+		resolver.Set("other.example.com", addr3)
+		cache.Lookup(ctx, []string{"pool.example.com", "other.example.com"})
+		resolver.Set("Pool.Example.com", addr3)
+
+		clock.Step(5 * time.Minute)
+		Expect(cache.Refresh(ctx)).To(Equal([]string{"pool.example.com"}))
+	})
+
+	It("should call onChange if addresses changed", func() {
+		cache.Lookup(ctx, []string{"pool.example.com"})
+		resolver.Set("pool.example.com", addr3)
+
+		changed := make(chan []string, 1)
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go cache.Run(runCtx, time.Minute, func(_ context.Context, names []string) { changed <- names })
+
+		Eventually(clock.HasWaiters).Should(BeTrue())
+		clock.Step(5 * time.Minute)
+		Eventually(changed).Should(Receive(Equal([]string{"pool.example.com"})))
+	})
+})
@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fqdn resolves fully qualified domain names into IP addresses honoring the TTLs of the DNS records
+// and caches the resolutions, so that filter entries naming FQDNs can be converted into networks.
+package fqdn
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// defaultQueryTimeout is the timeout of a single DNS query.
+	defaultQueryTimeout = 5 * time.Second
+	// maxMessageSize is the maximum size of a DNS message.
+	maxMessageSize = 65535
+)
+
+// Resolver resolves FQDNs into IP addresses.
+type Resolver interface {
+	// Resolve returns the addresses of the A and AAAA records of the name and the TTL of the resolution.
+	// A name without records is not an error, it returns no addresses and the TTL of the negative answer.
+	Resolve(ctx context.Context, name string) ([]netip.Addr, time.Duration, error)
+}
+
+// DNSResolver queries DNS servers for the A and AAAA records of names.
+type DNSResolver struct {
+	nameservers []string
+	timeout     time.Duration
+}
+
+var _ Resolver = &DNSResolver{}
+
+// NewDNSResolver creates a resolver querying the given nameservers (`host:port`) in order.
+func NewDNSResolver(nameservers []string) (*DNSResolver, error) {
+	if len(nameservers) == 0 {
+		return nil, fmt.Errorf("no nameservers")
+	}
+	for _, nameserver := range nameservers {
+		if _, _, err := net.SplitHostPort(nameserver); err != nil {
+			return nil, fmt.Errorf("invalid nameserver %q: %w", nameserver, err)
+		}
+	}
+	return &DNSResolver{nameservers: nameservers, timeout: defaultQueryTimeout}, nil
+}
+
+// NewDNSResolverFromResolvConf creates a resolver querying the nameservers of the given resolv.conf file.
+func NewDNSResolverFromResolvConf(path string) (*DNSResolver, error) {
+	f, err := os.Open(path) // #nosec G304 -- path is not user controlled
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var nameservers []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			nameservers = append(nameservers, net.JoinHostPort(fields[1], "53"))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewDNSResolver(nameservers)
+}
+
+// Resolve queries the A and AAAA records of the name. The TTL is the minimum TTL of the records.
+func (r *DNSResolver) Resolve(ctx context.Context, name string) ([]netip.Addr, time.Duration, error) {
+	var result []netip.Addr
+	var ttl time.Duration
+	for i, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		addrs, qttl, err := r.resolveType(ctx, name, qtype)
+		if err != nil {
+			return nil, 0, err
+		}
+		result = append(result, addrs...)
+		if i == 0 || qttl < ttl {
+			ttl = qttl
+		}
+	}
+	return result, ttl, nil
+}
+
+// resolveType queries the records of the given type from the nameservers until one of them answers.
+func (r *DNSResolver) resolveType(ctx context.Context, name string, qtype dnsmessage.Type) ([]netip.Addr, time.Duration, error) {
+	qname, err := dnsmessage.NewName(strings.TrimSuffix(name, ".") + ".")
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid name %q: %w", name, err)
+	}
+	question := dnsmessage.Question{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}
+
+	var errs []error
+	for _, nameserver := range r.nameservers {
+		addrs, ttl, err := r.query(ctx, nameserver, question)
+		if err == nil {
+			return addrs, ttl, nil
+		}
+		errs = append(errs, fmt.Errorf("nameserver %s: %w", nameserver, err))
+	}
+	return nil, 0, fmt.Errorf("resolving %s record of %q failed: %w", strings.TrimPrefix(qtype.String(), "Type"), name, errors.Join(errs...))
+}
+
+// query sends the question to the nameserver via UDP and retries via TCP if the answer is truncated.
+func (r *DNSResolver) query(ctx context.Context, nameserver string, question dnsmessage.Question) ([]netip.Addr, time.Duration, error) {
+	id := uint16(rand.N(1 << 16)) // #nosec G404 -- the message id does not need to be cryptographically secure
+	request, err := (&dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{question},
+	}).Pack()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	response, err := r.exchange(ctx, "udp", nameserver, request)
+	if err != nil {
+		return nil, 0, err
+	}
+	var header dnsmessage.Header
+	var parser dnsmessage.Parser
+	if header, err = parser.Start(response); err != nil {
+		return nil, 0, err
+	}
+	if header.Truncated {
+		if response, err = r.exchange(ctx, "tcp", nameserver, request); err != nil {
+			return nil, 0, err
+		}
+		if header, err = parser.Start(response); err != nil {
+			return nil, 0, err
+		}
+	}
+	if header.ID != id || !header.Response {
+		return nil, 0, fmt.Errorf("unexpected response")
+	}
+	return parseResponse(&parser, header, question.Type)
+}
+
+// exchange sends the request to the nameserver and returns the response.
+func (r *DNSResolver) exchange(ctx context.Context, network, nameserver string, request []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, network, nameserver)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+	}
+
+	if network == "udp" {
+		if _, err := conn.Write(request); err != nil {
+			return nil, err
+		}
+		response := make([]byte, maxMessageSize)
+		n, err := conn.Read(response)
+		if err != nil {
+			return nil, err
+		}
+		return response[:n], nil
+	}
+
+	// DNS over TCP prefixes the messages with their length
+	message := binary.BigEndian.AppendUint16(make([]byte, 0, 2+len(request)), uint16(len(request))) // #nosec G115 -- the request only contains a single question
+	if _, err := conn.Write(append(message, request...)); err != nil {
+		return nil, err
+	}
+	var length [2]byte
+	if _, err := io.ReadFull(conn, length[:]); err != nil {
+		return nil, err
+	}
+	response := make([]byte, binary.BigEndian.Uint16(length[:]))
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// parseResponse returns the addresses of the answer and its TTL. For negative answers, the TTL is taken from
+// the SOA record of the authority section or, if missing, from the remaining answer records (e.g. CNAMEs).
+func parseResponse(parser *dnsmessage.Parser, header dnsmessage.Header, qtype dnsmessage.Type) ([]netip.Addr, time.Duration, error) {
+	switch header.RCode {
+	case dnsmessage.RCodeSuccess, dnsmessage.RCodeNameError:
+	default:
+		return nil, 0, fmt.Errorf("response code %s", header.RCode)
+	}
+	if err := parser.SkipAllQuestions(); err != nil {
+		return nil, 0, err
+	}
+
+	var addrs []netip.Addr
+	ttl := uint32(math.MaxUint32)
+	for {
+		h, err := parser.AnswerHeader()
+		if errors.Is(err, dnsmessage.ErrSectionDone) {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		switch {
+		case h.Type == dnsmessage.TypeA && qtype == dnsmessage.TypeA:
+			r, err := parser.AResource()
+			if err != nil {
+				return nil, 0, err
+			}
+			addrs = append(addrs, netip.AddrFrom4(r.A))
+		case h.Type == dnsmessage.TypeAAAA && qtype == dnsmessage.TypeAAAA:
+			r, err := parser.AAAAResource()
+			if err != nil {
+				return nil, 0, err
+			}
+			addrs = append(addrs, netip.AddrFrom16(r.AAAA))
+		default:
+			if err := parser.SkipAnswer(); err != nil {
+				return nil, 0, err
+			}
+		}
+		ttl = min(ttl, h.TTL)
+	}
+	if len(addrs) > 0 {
+		return addrs, time.Duration(ttl) * time.Second, nil
+	}
+	if ttl == math.MaxUint32 {
+		ttl = 0
+	}
+
+	for {
+		h, err := parser.AuthorityHeader()
+		if errors.Is(err, dnsmessage.ErrSectionDone) {
+			return nil, time.Duration(ttl) * time.Second, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if h.Type != dnsmessage.TypeSOA {
+			if err := parser.SkipAuthority(); err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+		r, err := parser.SOAResource()
+		if err != nil {
+			return nil, 0, err
+		}
+		return nil, time.Duration(min(h.TTL, r.MinTTL)) * time.Second, nil
+	}
+}
+
+// StaticResolver is a Resolver with fixed addresses, e.g. as local stand-in for DNS in tests.
+type StaticResolver struct {
+	lock  sync.RWMutex
+	addrs map[string][]netip.Addr
+	ttl   time.Duration
+	err   error
+}
+
+var _ Resolver = &StaticResolver{}
+
+// NewStaticResolver creates a resolver returning the given addresses per name with the given TTL.
+func NewStaticResolver(addrs map[string][]netip.Addr, ttl time.Duration) *StaticResolver {
+	r := &StaticResolver{addrs: map[string][]netip.Addr{}, ttl: ttl}
+	for name, a := range addrs {
+		r.addrs[NormalizeName(name)] = a
+	}
+	return r
+}
+
+// Set sets the addresses of the name.
+func (r *StaticResolver) Set(name string, addrs ...netip.Addr) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.addrs[NormalizeName(name)] = addrs
+}
+
+// SetError sets an error returned for all names or resets it if nil.
+func (r *StaticResolver) SetError(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.err = err
+}
+
+// Resolve returns the addresses of the name.
+func (r *StaticResolver) Resolve(_ context.Context, name string) ([]netip.Addr, time.Duration, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	if r.err != nil {
+		return nil, 0, r.err
+	}
+	return r.addrs[NormalizeName(name)], r.ttl, nil
+}
+
+// NormalizeName returns the name in lower case without trailing dot.
+func NormalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
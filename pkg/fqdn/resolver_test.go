@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fqdn
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// serveDNS answers DNS queries on a local UDP port with the given records until the test ends.
+func serveDNS(records map[string][]dnsmessage.Resource) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+
+	go func() {
+		defer GinkgoRecover()
+		buf := make([]byte, maxMessageSize)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var request dnsmessage.Message
+			Expect(request.Unpack(buf[:n])).To(Succeed())
+			question := request.Questions[0]
+			response := dnsmessage.Message{
+				Header:    dnsmessage.Header{ID: request.ID, Response: true, RCode: dnsmessage.RCodeSuccess},
+				Questions: request.Questions,
+			}
+			resources, ok := records[question.Name.String()]
+			if !ok {
+				response.RCode = dnsmessage.RCodeNameError
+				response.Authorities = []dnsmessage.Resource{{
+					Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("example.com."), Type: dnsmessage.TypeSOA, Class: dnsmessage.ClassINET, TTL: 600},
+					Body: &dnsmessage.SOAResource{
+						NS: dnsmessage.MustNewName("ns.example.com."), MBox: dnsmessage.MustNewName("admin.example.com."), MinTTL: 120,
+					},
+				}}
+			}
+			for _, resource := range resources {
+				if resource.Header.Type == question.Type || resource.Header.Type == dnsmessage.TypeCNAME {
+					response.Answers = append(response.Answers, resource)
+				}
+			}
+			b, err := response.Pack()
+			Expect(err).NotTo(HaveOccurred())
+			_, _ = conn.WriteTo(b, addr)
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func a(name string, ttl uint32, addr string) dnsmessage.Resource {
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: ttl},
+		Body:   &dnsmessage.AResource{A: netip.MustParseAddr(addr).As4()},
+	}
+}
+
+func aaaa(name string, ttl uint32, addr string) dnsmessage.Resource {
+	return dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName(name), Type: dnsmessage.TypeAAAA, Class: dnsmessage.ClassINET, TTL: ttl},
+		Body:   &dnsmessage.AAAAResource{AAAA: netip.MustParseAddr(addr).As16()},
+	}
+}
+
+var _ = Describe("DNSResolver", func() {
+	var (
+		ctx      = context.Background()
+		resolver *DNSResolver
+	)
+
+	BeforeEach(func() {
+		nameserver := serveDNS(map[string][]dnsmessage.Resource{
+			"pool.example.com.": {
+				a("pool.example.com.", 300, "192.0.2.1"),
+				a("pool.example.com.", 60, "192.0.2.2"),
+				aaaa("pool.example.com.", 600, "2001:db8::1"),
+			},
+			"www.example.com.": {
+				{
+					Header: dnsmessage.ResourceHeader{Name: dnsmessage.MustNewName("www.example.com."), Type: dnsmessage.TypeCNAME, Class: dnsmessage.ClassINET, TTL: 30},
+					Body:   &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("pool.example.com.")},
+				},
+				a("pool.example.com.", 300, "192.0.2.1"),
+			},
+		})
+		var err error
+		resolver, err = NewDNSResolver([]string{nameserver})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should resolve A and AAAA records with the minimum TTL", func() {
+		addrs, ttl, err := resolver.Resolve(ctx, "pool.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addrs).To(ConsistOf(netip.MustParseAddr("192.0.2.1"), netip.MustParseAddr("192.0.2.2"), netip.MustParseAddr("2001:db8::1")))
+		Expect(ttl).To(Equal(60 * time.Second))
+	})
+
+	It("should honor the TTL of CNAME records", func() {
+		addrs, ttl, err := resolver.Resolve(ctx, "www.example.com.")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addrs).To(ConsistOf(netip.MustParseAddr("192.0.2.1")))
+		Expect(ttl).To(Equal(30 * time.Second))
+	})
+
+	It("should return no addresses with the negative TTL for unknown names", func() {
+		addrs, ttl, err := resolver.Resolve(ctx, "unknown.example.com")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addrs).To(BeEmpty())
+		Expect(ttl).To(Equal(120 * time.Second))
+	})
+
+	It("should fail if no nameserver answers", func() {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+		resolver, err = NewDNSResolver([]string{conn.LocalAddr().String()})
+		Expect(err).NotTo(HaveOccurred())
+		resolver.timeout = 50 * time.Millisecond
+
+		_, _, err = resolver.Resolve(ctx, "pool.example.com")
+		Expect(err).To(MatchError(ContainSubstring("resolving A record of \"pool.example.com\" failed")))
+	})
+
+	It("should read the nameservers from resolv.conf", func() {
+		path := filepath.Join(GinkgoT().TempDir(), "resolv.conf")
+		Expect(os.WriteFile(path, []byte("search example.com\nnameserver 10.0.0.10\nnameserver fd00::a\noptions ndots:5\n"), 0600)).To(Succeed())
+
+		resolver, err := NewDNSResolverFromResolvConf(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolver.nameservers).To(Equal([]string{"10.0.0.10:53", "[fd00::a]:53"}))
+	})
+})
@@ -13,6 +13,9 @@ import (
 func init() {
 	metrics.Registry.MustRegister(FilterListSize)
 	metrics.Registry.MustRegister(FilterListDownloads)
+	metrics.Registry.MustRegister(FilterListSourceEntries)
+	metrics.Registry.MustRegister(FilterListSignatureVerifications)
+	metrics.Registry.MustRegister(BlockedConnections)
 }
 
 var (
@@ -21,7 +24,31 @@ var (
 			Name: "shoot_networking_filter_list_downloads",
 			Help: "Total number of download requests executed",
 		},
-		[]string{"success"},
+		[]string{"source", "success"},
+	)
+
+	FilterListSourceEntries = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "shoot_networking_filter_list_source_entries",
+			Help: "Number of entries in the current filter list of a download source",
+		},
+		[]string{"source"},
+	)
+
+	FilterListSignatureVerifications = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "shoot_networking_filter_list_signature_verifications",
+			Help: "Total number of filter list signature verifications",
+		},
+		[]string{"source", "result"},
+	)
+
+	BlockedConnections = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "shoot_networking_filter_blocked_connections_total",
+			Help: "Total number of connections blocked by the egress filter per source pod and tag of the matched filter entry",
+		},
+		[]string{"namespace", "pod", "tag"},
 	)
 
 	FilterListSize = prometheus.NewGaugeVec(
@@ -33,12 +60,35 @@ var (
 	)
 )
 
-// ReportDownload reports a filter list download.
-func ReportDownload(success bool) {
-	FilterListDownloads.WithLabelValues(strconv.FormatBool(success)).Inc()
+// ReportDownload reports a filter list download of the given source.
+// The source is empty for the single filter list configured with `downloaderConfig`.
+func ReportDownload(source string, success bool) {
+	FilterListDownloads.WithLabelValues(source, strconv.FormatBool(success)).Inc()
+}
+
+// ReportSourceEntries reports the number of entries in the current filter list of a download source.
+func ReportSourceEntries(source string, entries int) {
+	FilterListSourceEntries.WithLabelValues(source).Set(float64(entries))
+}
+
+// ReportSignatureVerification reports the result of a filter list signature verification of the given source.
+// The result is one of `valid`, `invalid` or `missing`.
+func ReportSignatureVerification(source, result string) {
+	FilterListSignatureVerifications.WithLabelValues(source, result).Inc()
 }
 
 // ReportFilterListSize reports the size of a filter list.
 func ReportFilterListSize(name string, size int) {
 	FilterListSize.WithLabelValues(name).Set(float64(size))
 }
+
+// ReportBlockedConnection reports a connection of the given source pod blocked by a filter entry with the given tag.
+// The namespace and pod are empty for unknown sources, the tag is empty for filter entries without tags.
+func ReportBlockedConnection(namespace, pod, tag string) {
+	BlockedConnections.WithLabelValues(namespace, pod, tag).Inc()
+}
+
+// DeleteBlockedConnections deletes the blocked connections reported for the given source pod.
+func DeleteBlockedConnections(namespace, pod string) {
+	BlockedConnections.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "pod": pod})
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package signature verifies detached signatures of filter lists with a set of trusted public keys.
+// Supported are ed25519 signatures and ECDSA or RSA (PKCS #1 v1.5) signatures of the SHA-256 digest as created by
+// e.g. `cosign sign-blob`. Signatures may be raw or base64 encoded.
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMissingSignature is returned if the signature is empty.
+	ErrMissingSignature = errors.New("missing signature")
+	// ErrInvalidSignature is returned if the signature cannot be verified with any of the trusted keys.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// trustedKey is a named public key.
+type trustedKey struct {
+	name string
+	key  crypto.PublicKey
+}
+
+// Verifier verifies detached signatures with a set of trusted public keys.
+type Verifier struct {
+	keys []trustedKey
+}
+
+// NewVerifier creates an empty Verifier. Keys are added with AddKey.
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// AddKey adds a PEM encoded public key in PKIX format with the given name to the trusted keys.
+func (v *Verifier) AddKey(name string, publicKeyPEM []byte) error {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return fmt.Errorf("public key %q: no PEM block of type PUBLIC KEY found", name)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("public key %q: %w", name, err)
+	}
+	switch key.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey, *rsa.PublicKey:
+	default:
+		return fmt.Errorf("public key %q: unsupported key type %T", name, key)
+	}
+	v.keys = append(v.keys, trustedKey{name: name, key: key})
+	return nil
+}
+
+// Len returns the number of trusted keys.
+func (v *Verifier) Len() int {
+	return len(v.keys)
+}
+
+// Verify verifies the detached signature of the data and returns the name of the trusted key which created it.
+func (v *Verifier) Verify(data, signature []byte) (string, error) {
+	if len(bytes.TrimSpace(signature)) == 0 {
+		return "", ErrMissingSignature
+	}
+	// Raw signatures must not be trimmed, they may start or end with bytes looking like whitespace
+	if decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(signature))); err == nil {
+		signature = decoded
+	}
+
+	digest := sha256.Sum256(data)
+	for _, k := range v.keys {
+		var ok bool
+		switch key := k.key.(type) {
+		case ed25519.PublicKey:
+			ok = ed25519.Verify(key, data, signature)
+		case *ecdsa.PublicKey:
+			ok = ecdsa.VerifyASN1(key, digest[:], signature)
+		case *rsa.PublicKey:
+			ok = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+		}
+		if ok {
+			return k.name, nil
+		}
+	}
+	return "", ErrInvalidSignature
+}
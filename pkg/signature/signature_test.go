@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func publicKeyPEM(key crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	Expect(err).NotTo(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+var _ = Describe("Verifier", func() {
+	var (
+		data = []byte(`[{"network":"1.2.3.4/32","policy":"BLOCK_ACCESS"}]`)
+
+		ed25519Public  ed25519.PublicKey
+		ed25519Private ed25519.PrivateKey
+		ecdsaPrivate   *ecdsa.PrivateKey
+		rsaPrivate     *rsa.PrivateKey
+		verifier       *Verifier
+	)
+
+	BeforeEach(func() {
+		var err error
+		ed25519Public, ed25519Private, err = ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		ecdsaPrivate, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		rsaPrivate, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier = NewVerifier()
+		Expect(verifier.AddKey("ed25519", publicKeyPEM(ed25519Public))).To(Succeed())
+		Expect(verifier.AddKey("ecdsa", publicKeyPEM(&ecdsaPrivate.PublicKey))).To(Succeed())
+		Expect(verifier.AddKey("rsa", publicKeyPEM(&rsaPrivate.PublicKey))).To(Succeed())
+		Expect(verifier.Len()).To(Equal(3))
+	})
+
+	It("should verify raw ed25519 signatures", func() {
+		name, err := verifier.Verify(data, ed25519.Sign(ed25519Private, data))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("ed25519"))
+	})
+
+	It("should verify raw signatures ending with whitespace bytes", func() {
+		var sig []byte
+		for len(sig) == 0 || !bytes.ContainsAny(sig[len(sig)-1:], " \t\n\r\v\f") {
+			var err error
+			ed25519Public, ed25519Private, err = ed25519.GenerateKey(rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			sig = ed25519.Sign(ed25519Private, data)
+		}
+		verifier = NewVerifier()
+		Expect(verifier.AddKey("ed25519", publicKeyPEM(ed25519Public))).To(Succeed())
+
+		name, err := verifier.Verify(data, sig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("ed25519"))
+	})
+
+	It("should verify base64 encoded ECDSA signatures", func() {
+		digest := sha256.Sum256(data)
+		signature, err := ecdsa.SignASN1(rand.Reader, ecdsaPrivate, digest[:])
+		Expect(err).NotTo(HaveOccurred())
+
+		name, err := verifier.Verify(data, []byte(base64.StdEncoding.EncodeToString(signature)+"\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("ecdsa"))
+	})
+
+	It("should verify RSA signatures", func() {
+		digest := sha256.Sum256(data)
+		signature, err := rsa.SignPKCS1v15(rand.Reader, rsaPrivate, crypto.SHA256, digest[:])
+		Expect(err).NotTo(HaveOccurred())
+
+		name, err := verifier.Verify(data, signature)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(name).To(Equal("rsa"))
+	})
+
+	It("should reject missing signatures", func() {
+		_, err := verifier.Verify(data, []byte(" \n"))
+		Expect(err).To(MatchError(ErrMissingSignature))
+	})
+
+	It("should reject signatures of modified data", func() {
+		signature := ed25519.Sign(ed25519Private, data)
+		_, err := verifier.Verify(append(data, ' '), signature)
+		Expect(err).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("should reject signatures of untrusted keys", func() {
+		_, otherPrivate, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = verifier.Verify(data, ed25519.Sign(otherPrivate, data))
+		Expect(err).To(MatchError(ErrInvalidSignature))
+	})
+
+	It("should reject invalid public keys", func() {
+		Expect(verifier.AddKey("invalid", []byte("foo"))).To(MatchError(ContainSubstring("no PEM block")))
+		Expect(verifier.AddKey("invalid", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("foo")}))).NotTo(Succeed())
+		Expect(verifier.Len()).To(Equal(3))
+	})
+})